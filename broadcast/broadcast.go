@@ -0,0 +1,302 @@
+// Package broadcast sends a message to many chats within the flood limits
+// and accounts the chats which were reached, blocked the bot or failed.
+package broadcast
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Feresey/tgbotapi/tgapi"
+)
+
+// DefaultRate is the number of the messages per second which the bot can send to different chats.
+const DefaultRate = 30
+
+// Recipients is the iterator over the chats.
+// The order must be stable to resume the broadcast from a checkpoint.
+type Recipients interface {
+	// Next returns the next chat. ok is false after the last chat.
+	Next(ctx context.Context) (chatID int64, ok bool, err error)
+}
+
+type sliceRecipients struct {
+	ids []int64
+	pos int
+}
+
+// Slice returns the recipients from the list.
+func Slice(ids ...int64) Recipients {
+	return &sliceRecipients{ids: ids}
+}
+
+func (s *sliceRecipients) Next(context.Context) (int64, bool, error) {
+	if s.pos >= len(s.ids) {
+		return 0, false, nil
+	}
+	s.pos++
+	return s.ids[s.pos-1], true, nil
+}
+
+// MessageFactory creates the message for the chat, e.g.
+//
+//	func(chatID int64) tgapi.Request {
+//		return tgapi.NewSendMessageConfig(tgapi.NewInt(chatID), "Hello!")
+//	}
+type MessageFactory func(chatID int64) tgapi.Request
+
+// Failure is the chat which has not received the message.
+type Failure struct {
+	ChatID int64  `json:"chat_id"`
+	Error  string `json:"error"`
+}
+
+// Report is the progress of the broadcast. It is saved to the checkpoint and returned by Run.
+type Report struct {
+	// Processed is the number of the handled recipients, the resumed broadcast skips them.
+	Processed int `json:"processed"`
+	Sent      int `json:"sent"`
+	// Blocked are the chats which responded with 403 Forbidden:
+	// the user blocked the bot, the bot was kicked, etc.
+	Blocked []int64   `json:"blocked,omitempty"`
+	Failed  []Failure `json:"failed,omitempty"`
+	// Retries is the number of the requests repeated after 429 Too Many Requests.
+	Retries int `json:"retries"`
+
+	Started  time.Time `json:"started"`
+	Finished time.Time `json:"finished"`
+}
+
+// Done reports whether the broadcast was completed.
+func (r *Report) Done() bool { return !r.Finished.IsZero() }
+
+func (r *Report) String() string {
+	res := fmt.Sprintf("processed %d, sent %d, blocked %d, failed %d, retries %d",
+		r.Processed, r.Sent, len(r.Blocked), len(r.Failed), r.Retries)
+	if r.Done() {
+		res += fmt.Sprintf(", took %s", r.Finished.Sub(r.Started).Round(time.Millisecond))
+	}
+	return res
+}
+
+// Checkpoint stores the progress of the broadcast.
+type Checkpoint interface {
+	// Load returns the saved report or nil if there is none.
+	Load() (*Report, error)
+	Save(*Report) error
+}
+
+// Broadcaster sends the messages one by one.
+type Broadcaster struct {
+	api tgapi.BotAPI
+
+	interval        time.Duration
+	retries         int
+	checkpoint      Checkpoint
+	checkpointEvery int
+	onBlocked       func(ctx context.Context, chatID int64)
+	onProgress      func(Report)
+
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// Option is used to customize the Broadcaster.
+type Option func(*Broadcaster)
+
+// Rate sets the number of the messages sent per second. DefaultRate is used by default
+// and if perSecond is not positive.
+func Rate(perSecond int) Option {
+	return func(b *Broadcaster) {
+		if perSecond <= 0 {
+			perSecond = DefaultRate
+		}
+		b.interval = time.Second / time.Duration(perSecond)
+	}
+}
+
+// Retries sets the number of the retries of a message failed with tgapi.ErrTooManyRequests.
+// The broadcaster waits for Error.RetryAfter seconds before each retry. The default is 3.
+func Retries(retries int) Option {
+	return func(b *Broadcaster) {
+		b.retries = retries
+	}
+}
+
+// WithCheckpoint saves the progress every n recipients and when the broadcast is interrupted or done.
+// Run resumes the saved broadcast. Remove the checkpoint to start a new one.
+func WithCheckpoint(checkpoint Checkpoint, n int) Option {
+	return func(b *Broadcaster) {
+		b.checkpoint = checkpoint
+		b.checkpointEvery = n
+	}
+}
+
+// OnBlocked sets the callback for the chats which responded with 403 Forbidden,
+// e.g. to unsubscribe the user.
+func OnBlocked(f func(ctx context.Context, chatID int64)) Option {
+	return func(b *Broadcaster) {
+		b.onBlocked = f
+	}
+}
+
+// OnProgress sets the callback called after every recipient. The report must not be modified.
+func OnProgress(f func(Report)) Option {
+	return func(b *Broadcaster) {
+		b.onProgress = f
+	}
+}
+
+// New creates the Broadcaster sending the messages with api.
+func New(api tgapi.BotAPI, options ...Option) *Broadcaster {
+	b := &Broadcaster{
+		api:      api,
+		interval: time.Second / DefaultRate,
+		retries:  3,
+		now:      time.Now,
+		sleep:    sleep,
+	}
+	for _, option := range options {
+		option(b)
+	}
+	return b
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Run sends the messages to the recipients and returns the report.
+// If the context is canceled, the progress is saved to the checkpoint
+// and the partial report is returned with the error.
+func (b *Broadcaster) Run(ctx context.Context, recipients Recipients, message MessageFactory) (*Report, error) {
+	report, err := b.load()
+	if err != nil {
+		return nil, err
+	}
+	if report.Done() {
+		return report, nil
+	}
+
+	for skipped := 0; skipped < report.Processed; skipped++ {
+		_, ok, err := recipients.Next(ctx)
+		if err != nil {
+			return report, b.interrupt(report, err)
+		}
+		if !ok {
+			break
+		}
+	}
+
+	var next time.Time
+	for {
+		chatID, ok, err := recipients.Next(ctx)
+		if err != nil {
+			return report, b.interrupt(report, err)
+		}
+		if !ok {
+			break
+		}
+
+		if err := b.wait(ctx, &next); err != nil {
+			return report, b.interrupt(report, err)
+		}
+		retries, err := b.send(ctx, message(chatID))
+		report.Retries += retries
+		switch {
+		case err == nil:
+			report.Sent++
+		case errors.Is(err, tgapi.ErrForbidden):
+			report.Blocked = append(report.Blocked, chatID)
+			if b.onBlocked != nil {
+				b.onBlocked(ctx, chatID)
+			}
+		case ctx.Err() != nil:
+			// the chat is not processed, it is sent again after the resume.
+			return report, b.interrupt(report, ctx.Err())
+		default:
+			report.Failed = append(report.Failed, Failure{ChatID: chatID, Error: err.Error()})
+		}
+		report.Processed++
+
+		if b.onProgress != nil {
+			b.onProgress(*report)
+		}
+		if b.checkpoint != nil && b.checkpointEvery > 0 && report.Processed%b.checkpointEvery == 0 {
+			if err := b.checkpoint.Save(report); err != nil {
+				return report, fmt.Errorf("save checkpoint: %w", err)
+			}
+		}
+	}
+
+	report.Finished = b.now()
+	if b.checkpoint != nil {
+		if err := b.checkpoint.Save(report); err != nil {
+			return report, fmt.Errorf("save checkpoint: %w", err)
+		}
+	}
+	return report, nil
+}
+
+func (b *Broadcaster) load() (*Report, error) {
+	if b.checkpoint != nil {
+		report, err := b.checkpoint.Load()
+		if err != nil {
+			return nil, fmt.Errorf("load checkpoint: %w", err)
+		}
+		if report != nil {
+			return report, nil
+		}
+	}
+	return &Report{Started: b.now()}, nil
+}
+
+// interrupt saves the progress and returns the error.
+func (b *Broadcaster) interrupt(report *Report, err error) error {
+	if b.checkpoint == nil {
+		return err
+	}
+	if saveErr := b.checkpoint.Save(report); saveErr != nil {
+		return fmt.Errorf("%v, save checkpoint: %w", err, saveErr)
+	}
+	return err
+}
+
+// wait keeps the interval between the messages.
+func (b *Broadcaster) wait(ctx context.Context, next *time.Time) error {
+	if d := next.Sub(b.now()); d > 0 {
+		if err := b.sleep(ctx, d); err != nil {
+			return err
+		}
+	}
+	*next = b.now().Add(b.interval)
+	return nil
+}
+
+// send sends the message and retries it on flood errors.
+func (b *Broadcaster) send(ctx context.Context, req tgapi.Request) (retries int, err error) {
+	for {
+		err = b.api.Do(tgapi.WithRetries(ctx, retries), req, nil)
+		var apiErr tgapi.Error
+		if retries >= b.retries || !errors.Is(err, tgapi.ErrTooManyRequests) || !errors.As(err, &apiErr) {
+			return retries, err
+		}
+		retries++
+
+		wait := time.Duration(apiErr.GetRetryAfter()) * time.Second
+		if wait <= 0 {
+			wait = time.Second
+		}
+		if err := b.sleep(ctx, wait); err != nil {
+			return retries, err
+		}
+	}
+}
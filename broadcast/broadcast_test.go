@@ -0,0 +1,167 @@
+package broadcast
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Feresey/tgbotapi/tgapi"
+)
+
+func hello(chatID int64) tgapi.Request {
+	return tgapi.NewSendMessageConfig(tgapi.NewInt(chatID), "Hello!")
+}
+
+func chatOf(req tgapi.Request) int64 {
+	return req.(*tgapi.SendMessageConfig).ChatID.Int
+}
+
+func newTestBroadcaster(api tgapi.BotAPI, sleeps *[]time.Duration, options ...Option) *Broadcaster {
+	b := New(api, options...)
+	b.sleep = func(ctx context.Context, d time.Duration) error {
+		*sleeps = append(*sleeps, d)
+		return ctx.Err()
+	}
+	return b
+}
+
+func TestRun(t *testing.T) {
+	retryAfter := int64(7)
+	flooded := false
+	fake := &tgapi.FakeAPI{
+		DoFunc: func(ctx context.Context, req tgapi.Request, result interface{}) error {
+			switch chatOf(req) {
+			case 2:
+				return tgapi.Error{Code: 403, Message: "Forbidden: bot was blocked by the user"}
+			case 3:
+				if !flooded {
+					flooded = true
+					return tgapi.Error{
+						Code:               429,
+						Message:            "Too Many Requests: retry after 7",
+						ResponseParameters: tgapi.ResponseParameters{RetryAfter: &retryAfter},
+					}
+				}
+			case 4:
+				return tgapi.Error{Code: 400, Message: "Bad Request: chat not found"}
+			}
+			return nil
+		},
+	}
+
+	var (
+		sleeps   []time.Duration
+		blocked  []int64
+		progress []int
+	)
+	b := newTestBroadcaster(fake, &sleeps,
+		Rate(10),
+		OnBlocked(func(ctx context.Context, chatID int64) { blocked = append(blocked, chatID) }),
+		OnProgress(func(r Report) { progress = append(progress, r.Processed) }),
+	)
+
+	report, err := b.Run(context.Background(), Slice(1, 2, 3, 4, 5), hello)
+	require.NoError(t, err)
+	require.True(t, report.Done())
+	require.Equal(t, 5, report.Processed)
+	require.Equal(t, 3, report.Sent)
+	require.Equal(t, []int64{2}, report.Blocked)
+	require.Equal(t, []int64{2}, blocked)
+	require.Len(t, report.Failed, 1)
+	require.Equal(t, int64(4), report.Failed[0].ChatID)
+	require.Equal(t, 1, report.Retries)
+	require.Equal(t, []int{1, 2, 3, 4, 5}, progress)
+	require.Contains(t, report.String(), "processed 5, sent 3, blocked 1, failed 1, retries 1, took ")
+
+	require.Contains(t, sleeps, 7*time.Second)
+	for _, d := range sleeps {
+		require.LessOrEqual(t, int64(d), int64(7*time.Second))
+	}
+	require.Len(t, fake.Calls(), 6)
+}
+
+func TestRate(t *testing.T) {
+	require.Equal(t, 100*time.Millisecond, New(nil, Rate(10)).interval)
+	require.Equal(t, time.Second/DefaultRate, New(nil, Rate(0)).interval)
+	require.Equal(t, time.Second/DefaultRate, New(nil, Rate(-5)).interval)
+}
+
+func TestRunRetriesExhausted(t *testing.T) {
+	var attempts []int
+	fake := &tgapi.FakeAPI{
+		DoFunc: func(ctx context.Context, req tgapi.Request, result interface{}) error {
+			attempts = append(attempts, tgapi.RequestRetries(ctx))
+			return tgapi.Error{Code: 429, Message: "Too Many Requests: retry after 0"}
+		},
+	}
+	var sleeps []time.Duration
+	report, err := newTestBroadcaster(fake, &sleeps, Retries(2)).Run(context.Background(), Slice(1), hello)
+	require.NoError(t, err)
+	require.Equal(t, 2, report.Retries)
+	require.Len(t, report.Failed, 1)
+	require.Len(t, fake.Calls(), 3)
+	// the retries are reported to the observer.
+	require.Equal(t, []int{0, 1, 2}, attempts)
+	require.Equal(t, []time.Duration{time.Second, time.Second}, sleeps)
+}
+
+func TestRunResume(t *testing.T) {
+	dir, err := ioutil.TempDir("", "broadcast")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	checkpoint := FileCheckpoint(filepath.Join(dir, "checkpoint.json"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	fake := &tgapi.FakeAPI{
+		DoFunc: func(ctx context.Context, req tgapi.Request, result interface{}) error {
+			if chatOf(req) == 3 {
+				cancel()
+				return ctx.Err()
+			}
+			return nil
+		},
+	}
+	var sleeps []time.Duration
+	report, err := newTestBroadcaster(fake, &sleeps, WithCheckpoint(checkpoint, 10)).
+		Run(ctx, Slice(1, 2, 3, 4, 5), hello)
+	require.True(t, errors.Is(err, context.Canceled))
+	require.Equal(t, 2, report.Processed)
+
+	saved, err := checkpoint.Load()
+	require.NoError(t, err)
+	require.Equal(t, 2, saved.Processed)
+	require.False(t, saved.Done())
+
+	fake = &tgapi.FakeAPI{}
+	report, err = newTestBroadcaster(fake, &sleeps, WithCheckpoint(checkpoint, 10)).
+		Run(context.Background(), Slice(1, 2, 3, 4, 5), hello)
+	require.NoError(t, err)
+	require.Equal(t, 5, report.Processed)
+	require.Equal(t, 5, report.Sent)
+	require.Equal(t, saved.Started.Unix(), report.Started.Unix())
+	var sent []int64
+	for _, call := range fake.Calls() {
+		sent = append(sent, chatOf(call.Args[0].(tgapi.Request)))
+	}
+	require.Equal(t, []int64{3, 4, 5}, sent)
+
+	// the completed broadcast is not repeated.
+	fake.Reset()
+	report, err = newTestBroadcaster(fake, &sleeps, WithCheckpoint(checkpoint, 10)).
+		Run(context.Background(), Slice(1, 2, 3, 4, 5), hello)
+	require.NoError(t, err)
+	require.True(t, report.Done())
+	require.Empty(t, fake.Calls())
+}
+
+func TestFileCheckpointMissing(t *testing.T) {
+	report, err := FileCheckpoint(filepath.Join(os.TempDir(), "missing-broadcast-checkpoint.json")).Load()
+	require.NoError(t, err)
+	require.Nil(t, report)
+}
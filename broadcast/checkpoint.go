@@ -0,0 +1,40 @@
+package broadcast
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+
+	"github.com/Feresey/tgbotapi/internal/atomicfile"
+)
+
+// FileCheckpoint stores the report in the JSON file with the path.
+type FileCheckpoint string
+
+var _ Checkpoint = FileCheckpoint("")
+
+// Load returns nil if the file does not exist.
+func (f FileCheckpoint) Load() (*Report, error) {
+	data, err := ioutil.ReadFile(string(f))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var report Report
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+// Save replaces the file atomically.
+func (f FileCheckpoint) Save(report *Report) error {
+	data, err := json.MarshalIndent(report, "", "\t")
+	if err != nil {
+		return err
+	}
+	return atomicfile.WriteFile(string(f), data)
+}
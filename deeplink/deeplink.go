@@ -0,0 +1,198 @@
+// Package deeplink builds and parses the t.me links of the bot and of the messages:
+//
+//	param, err := deeplink.Encode([]byte("ref:42"))
+//	...
+//	link, err := deeplink.Start("my_bot", param)
+//	...
+//	payload, ok := deeplink.StartPayload(msg) // "/start cmVmOjQy"
+//	data, err := deeplink.Decode(payload)
+package deeplink
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/Feresey/tgbotapi/tgapi"
+)
+
+// MaxParamLength is the maximum length of the start parameter.
+const MaxParamLength = 64
+
+// MaxPayloadSize is the maximum size of the payload fitting the start parameter with Encode.
+const MaxPayloadSize = MaxParamLength / 4 * 3
+
+// Host is the host of the links built by the package.
+const Host = "t.me"
+
+var (
+	// ErrInvalidParam is returned when the start parameter is too long or contains the characters other than A-Z, a-z, 0-9, _ and -.
+	ErrInvalidParam = errors.New("invalid start parameter")
+	// ErrInvalidLink is returned when the link is not a t.me link of the expected kind.
+	ErrInvalidLink = errors.New("invalid link")
+)
+
+// Kind is the kind of the bot link.
+type Kind string
+
+// The kinds of the bot links, the name of the query parameter with the start parameter.
+const (
+	// KindStart opens the private chat with the bot, the parameter is sent with /start.
+	KindStart Kind = "start"
+	// KindStartGroup adds the bot to a group, the parameter is sent with /start to the group.
+	KindStartGroup Kind = "startgroup"
+	// KindStartAttach opens the attachment menu Mini App, the parameter is InitData.StartParam.
+	KindStartAttach Kind = "startattach"
+)
+
+// Encode encodes the payload into the start parameter with the unpadded base64url.
+// The payload larger than MaxPayloadSize does not fit the parameter.
+func Encode(payload []byte) (string, error) {
+	if len(payload) > MaxPayloadSize {
+		return "", fmt.Errorf("%w: payload of %d bytes, max %d", ErrInvalidParam, len(payload), MaxPayloadSize)
+	}
+	return base64.RawURLEncoding.EncodeToString(payload), nil
+}
+
+// Decode decodes the start parameter encoded with Encode.
+func Decode(param string) ([]byte, error) {
+	if err := ValidateParam(param); err != nil {
+		return nil, err
+	}
+	res, err := base64.RawURLEncoding.DecodeString(param)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidParam, err)
+	}
+	return res, nil
+}
+
+// ValidateParam checks that the start parameter is accepted by Telegram.
+func ValidateParam(param string) error {
+	if len(param) > MaxParamLength {
+		return fmt.Errorf("%w: %d characters, max %d", ErrInvalidParam, len(param), MaxParamLength)
+	}
+	for _, c := range param {
+		if !(c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '_' || c == '-') {
+			return fmt.Errorf("%w: unexpected character %q", ErrInvalidParam, c)
+		}
+	}
+	return nil
+}
+
+// BotLink is the link to the bot with the start parameter.
+type BotLink struct {
+	// Bot is the username of the bot without @.
+	Bot   string
+	Kind  Kind
+	Param string
+}
+
+// String returns the link, e.g. https://t.me/my_bot?start=param.
+// The empty parameter of KindStartGroup and KindStartAttach is kept, it is meaningful for them.
+func (l BotLink) String() string {
+	res := "https://" + Host + "/" + strings.TrimPrefix(l.Bot, "@")
+	if l.Param == "" && l.Kind == KindStart {
+		return res
+	}
+	return res + "?" + string(l.Kind) + "=" + l.Param
+}
+
+// Start returns the link opening the private chat with the bot.
+func Start(bot, param string) (string, error) {
+	return link(bot, KindStart, param)
+}
+
+// StartGroup returns the link adding the bot to a group.
+func StartGroup(bot, param string) (string, error) {
+	return link(bot, KindStartGroup, param)
+}
+
+// StartAttach returns the link opening the attachment menu Mini App of the bot.
+func StartAttach(bot, param string) (string, error) {
+	return link(bot, KindStartAttach, param)
+}
+
+func link(bot string, kind Kind, param string) (string, error) {
+	if err := ValidateParam(param); err != nil {
+		return "", err
+	}
+	return BotLink{Bot: bot, Kind: kind, Param: param}.String(), nil
+}
+
+// ParseBotLink parses the link built with Start, StartGroup or StartAttach.
+// The scheme is optional, telegram.me is accepted as well as t.me.
+func ParseBotLink(link string) (*BotLink, error) {
+	u, segments, err := parse(link)
+	if err != nil {
+		return nil, err
+	}
+	if len(segments) != 1 || segments[0] == "c" {
+		return nil, fmt.Errorf("%w: %q is not a bot link", ErrInvalidLink, link)
+	}
+
+	res := &BotLink{Bot: segments[0], Kind: KindStart}
+	query := u.Query()
+	for _, kind := range []Kind{KindStart, KindStartGroup, KindStartAttach} {
+		if values, ok := query[string(kind)]; ok {
+			res.Kind = kind
+			res.Param = values[0]
+			break
+		}
+	}
+	if err := ValidateParam(res.Param); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// parse parses the t.me link and returns its non-empty path segments.
+func parse(link string) (*url.URL, []string, error) {
+	if !strings.Contains(link, "://") {
+		link = "https://" + link
+	}
+	u, err := url.Parse(link)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidLink, err)
+	}
+	switch strings.ToLower(u.Hostname()) {
+	case "t.me", "www.t.me", "telegram.me", "www.telegram.me", "telegram.dog":
+	default:
+		return nil, nil, fmt.Errorf("%w: unexpected host %q", ErrInvalidLink, u.Host)
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return nil, nil, fmt.Errorf("%w: unexpected scheme %q", ErrInvalidLink, u.Scheme)
+	}
+
+	var segments []string
+	for _, segment := range strings.Split(u.Path, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	if len(segments) == 0 {
+		return nil, nil, fmt.Errorf("%w: %q has no path", ErrInvalidLink, link)
+	}
+	return u, segments, nil
+}
+
+// StartPayload returns the argument of the /start command, the start parameter of the link.
+// It is false if the message is not the /start command.
+func StartPayload(msg *tgapi.Message) (string, bool) {
+	if msg == nil || msg.Command() != "start" {
+		return "", false
+	}
+	return strings.TrimSpace(msg.CommandArguments()), true
+}
+
+// StartData decodes the argument of the /start command encoded with Encode.
+// It is false if the message is not the /start command or has no argument.
+func StartData(msg *tgapi.Message) ([]byte, bool, error) {
+	payload, ok := StartPayload(msg)
+	if !ok || payload == "" {
+		return nil, false, nil
+	}
+	res, err := Decode(payload)
+	return res, true, err
+}
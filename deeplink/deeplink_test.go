@@ -0,0 +1,112 @@
+package deeplink
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Feresey/tgbotapi/tgapi"
+)
+
+func TestPayload(t *testing.T) {
+	payload := []byte{0xfb, 0xff, 'r', 'e', 'f'}
+	param, err := Encode(payload)
+	require.NoError(t, err)
+	require.Equal(t, "-_9yZWY", param)
+	decoded, err := Decode(param)
+	require.NoError(t, err)
+	require.Equal(t, payload, decoded)
+
+	param, err = Encode(make([]byte, MaxPayloadSize))
+	require.NoError(t, err)
+	require.Len(t, param, MaxParamLength)
+	_, err = Encode(make([]byte, MaxPayloadSize+1))
+	require.True(t, errors.Is(err, ErrInvalidParam))
+
+	_, err = Decode("a+b")
+	require.True(t, errors.Is(err, ErrInvalidParam))
+	_, err = Decode(strings.Repeat("a", MaxParamLength+1))
+	require.True(t, errors.Is(err, ErrInvalidParam))
+}
+
+func TestBotLink(t *testing.T) {
+	link, err := Start("@my_bot", "ref-42")
+	require.NoError(t, err)
+	require.Equal(t, "https://t.me/my_bot?start=ref-42", link)
+	link, err = StartGroup("my_bot", "")
+	require.NoError(t, err)
+	require.Equal(t, "https://t.me/my_bot?startgroup=", link)
+	_, err = StartAttach("my_bot", "a b")
+	require.True(t, errors.Is(err, ErrInvalidParam))
+
+	parsed, err := ParseBotLink("telegram.me/my_bot?startattach=app")
+	require.NoError(t, err)
+	require.Equal(t, &BotLink{Bot: "my_bot", Kind: KindStartAttach, Param: "app"}, parsed)
+	parsed, err = ParseBotLink("https://t.me/my_bot")
+	require.NoError(t, err)
+	require.Equal(t, &BotLink{Bot: "my_bot", Kind: KindStart}, parsed)
+
+	for _, link := range []string{"https://example.com/my_bot?start=a", "t.me/c/123/4", "t.me/my_bot?start=a+b", "tg://t.me/my_bot"} {
+		_, err = ParseBotLink(link)
+		require.Error(t, err, link)
+	}
+}
+
+func TestStartPayload(t *testing.T) {
+	message := func(text string, length int) *tgapi.Message {
+		var msg tgapi.Message
+		require.NoError(t, json.Unmarshal([]byte(`{"message_id":1,"date":0,"chat":{"id":10,"type":"private"},
+			"text":"`+text+`","entities":[{"type":"bot_command","offset":0,"length":`+strconv.Itoa(length)+`}]}`), &msg))
+		return &msg
+	}
+
+	payload, ok := StartPayload(message("/start@my_bot cmVm", 13))
+	require.True(t, ok)
+	require.Equal(t, "cmVm", payload)
+	data, ok, err := StartData(message("/start cmVm", 6))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "ref", string(data))
+
+	_, ok, err = StartData(message("/start", 6))
+	require.NoError(t, err)
+	require.False(t, ok)
+	_, ok = StartPayload(message("/help cmVm", 5))
+	require.False(t, ok)
+}
+
+func TestMessageLink(t *testing.T) {
+	for _, tc := range []struct {
+		link   string
+		parsed MessageLink
+	}{
+		{"https://t.me/c/1234567890/42", MessageLink{Chat: tgapi.NewInt(-1001234567890), MessageID: 42}},
+		{"https://t.me/c/1234567890/5/42", MessageLink{Chat: tgapi.NewInt(-1001234567890), MessageID: 42, ThreadID: 5}},
+		{"https://t.me/channel/42", MessageLink{Chat: tgapi.NewStr("@channel"), MessageID: 42}},
+	} {
+		link, err := NewMessageLink(tc.parsed.Chat, tc.parsed.MessageID, tc.parsed.ThreadID)
+		require.NoError(t, err)
+		require.Equal(t, tc.link, link)
+		parsed, err := ParseMessageLink(tc.link)
+		require.NoError(t, err)
+		require.Equal(t, &tc.parsed, parsed)
+	}
+
+	parsed, err := ParseMessageLink("t.me/group/42?thread=5")
+	require.NoError(t, err)
+	require.Equal(t, &MessageLink{Chat: tgapi.NewStr("@group"), MessageID: 42, ThreadID: 5}, parsed)
+
+	_, err = NewMessageLink(tgapi.NewInt(-123), 1, 0)
+	require.True(t, errors.Is(err, ErrNoLink))
+	_, err = NewMessageLink(tgapi.NewInt(10), 1, 0)
+	require.True(t, errors.Is(err, ErrNoLink))
+
+	for _, link := range []string{"t.me/channel", "t.me/c/123", "t.me/c/abc/1", "t.me/channel/x", "t.me/c/1/2/3/4"} {
+		_, err = ParseMessageLink(link)
+		require.True(t, errors.Is(err, ErrInvalidLink), link)
+	}
+}
@@ -0,0 +1,123 @@
+package deeplink
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Feresey/tgbotapi/tgapi"
+)
+
+// channelIDPrefix minus the internal ID of the supergroup or the channel used in the links is its chat ID, -100<internal ID>.
+const channelIDPrefix = -1000000000000
+
+// ErrNoLink is returned when the messages of the chat have no links: private chats and basic groups.
+var ErrNoLink = errors.New("chat has no message links")
+
+// MessageLink is the link to the message of a supergroup or a channel.
+type MessageLink struct {
+	// Chat is the chat ID with the -100 prefix or the @username of the public chat.
+	Chat      tgapi.IntStr
+	MessageID int64
+	// ThreadID is the forum topic, zero for the messages outside of the topics.
+	ThreadID int64
+}
+
+// NewMessageLink returns the link to the message of the chat, the zero threadID omits the topic.
+// The chat is either the @username of the public chat or the ID of the supergroup or the channel.
+func NewMessageLink(chat tgapi.IntStr, messageID, threadID int64) (string, error) {
+	return (&MessageLink{Chat: chat, MessageID: messageID, ThreadID: threadID}).Link()
+}
+
+// Link returns the link, e.g. https://t.me/c/1234567890/42 or https://t.me/username/5/42 for the topic.
+func (l *MessageLink) Link() (string, error) {
+	if l.MessageID <= 0 {
+		return "", fmt.Errorf("%w: message ID %d", ErrInvalidLink, l.MessageID)
+	}
+
+	res := "https://" + Host + "/"
+	if strings.HasPrefix(l.Chat.Str, "@") {
+		res += l.Chat.Str[1:]
+	} else {
+		id, ok := InternalID(l.Chat.Int)
+		if !ok {
+			return "", fmt.Errorf("%w: %d", ErrNoLink, l.Chat.Int)
+		}
+		res += "c/" + strconv.FormatInt(id, 10)
+	}
+	if l.ThreadID != 0 {
+		res += "/" + strconv.FormatInt(l.ThreadID, 10)
+	}
+	return res + "/" + strconv.FormatInt(l.MessageID, 10), nil
+}
+
+// ParseMessageLink parses the link to the message, including the ?thread= links to the comments.
+// The scheme is optional, telegram.me is accepted as well as t.me.
+func ParseMessageLink(link string) (*MessageLink, error) {
+	u, segments, err := parse(link)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &MessageLink{}
+	if segments[0] == "c" {
+		if len(segments) < 3 {
+			return nil, fmt.Errorf("%w: %q is not a message link", ErrInvalidLink, link)
+		}
+		id, err := strconv.ParseInt(segments[1], 10, 64)
+		if err != nil || id <= 0 {
+			return nil, fmt.Errorf("%w: chat ID %q", ErrInvalidLink, segments[1])
+		}
+		res.Chat = tgapi.NewInt(ChatID(id))
+		segments = segments[2:]
+	} else {
+		if len(segments) < 2 {
+			return nil, fmt.Errorf("%w: %q is not a message link", ErrInvalidLink, link)
+		}
+		res.Chat = tgapi.NewStr("@" + segments[0])
+		segments = segments[1:]
+	}
+
+	switch len(segments) {
+	case 1:
+	case 2:
+		if res.ThreadID, err = parseID(segments[0]); err != nil {
+			return nil, err
+		}
+		segments = segments[1:]
+	default:
+		return nil, fmt.Errorf("%w: %q is not a message link", ErrInvalidLink, link)
+	}
+	if res.MessageID, err = parseID(segments[0]); err != nil {
+		return nil, err
+	}
+	if thread := u.Query().Get("thread"); thread != "" && res.ThreadID == 0 {
+		if res.ThreadID, err = parseID(thread); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func parseID(raw string) (int64, error) {
+	id, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("%w: ID %q", ErrInvalidLink, raw)
+	}
+	return id, nil
+}
+
+// ChatID returns the chat ID of the Bot API for the internal ID of the supergroup or the channel used in the links.
+func ChatID(internalID int64) int64 {
+	return channelIDPrefix - internalID
+}
+
+// InternalID returns the ID used in the links for the chat ID of the supergroup or the channel.
+// It is false for the other chats.
+func InternalID(chatID int64) (int64, bool) {
+	if chatID >= channelIDPrefix {
+		return 0, false
+	}
+	return channelIDPrefix - chatID, true
+}
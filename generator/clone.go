@@ -0,0 +1,153 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/iancoleman/strcase"
+)
+
+// valueKind describes how the value of the type is cloned and compared.
+type valueKind int
+
+const (
+	// kindPlain values are copied by assignment and compared with ==.
+	kindPlain valueKind = iota
+	// kindStruct is the API type with the generated Clone and Equal methods.
+	kindStruct
+	// kindInterface is the union interface, its value is cloned with reflection.
+	kindInterface
+	// kindSlice is cloned and compared with the generated helpers.
+	kindSlice
+)
+
+func (g *Generator) kindOf(t TypeMapping) valueKind {
+	switch {
+	case t.IsArray():
+		return kindSlice
+	case g.mapping.isInterface(t):
+		return kindInterface
+	}
+	if _, ok := g.schema.Types[string(t)]; ok {
+		return kindStruct
+	}
+	// builtins, enums, IntStr, FileID and True.
+	return kindPlain
+}
+
+func (g *Generator) isPlain(t TypeMapping) bool { return g.kindOf(t) == kindPlain }
+
+// helperName returns the suffix of the clone and equal helpers,
+// e.g. InlineKeyboardButtonSliceSlice for [][]InlineKeyboardButton.
+func helperName(t TypeMapping) string {
+	var suffix string
+	for t.IsArray() {
+		suffix += "Slice"
+		t = t.ArrayType()
+	}
+	return strcase.ToCamel(fieldGoType(t)) + suffix
+}
+
+// fieldGoType is the Go type of the API type field. The files are received as FileID.
+func fieldGoType(t TypeMapping) string {
+	if t.IsArray() {
+		return "[]" + fieldGoType(t.ArrayType())
+	}
+	if goType := t.GoType(); goType != InputFile {
+		return goType
+	}
+	return "FileID"
+}
+
+// cloneExpr returns the expression cloning the value of the type t.
+func (g *Generator) cloneExpr(expr string, t TypeMapping) string {
+	switch g.kindOf(t) {
+	case kindSlice:
+		return fmt.Sprintf("clone%s(%s)", helperName(t), expr)
+	case kindStruct:
+		return fmt.Sprintf("*%s.Clone()", expr)
+	case kindInterface:
+		return fmt.Sprintf("%s(cloneInterface(%s))", t.GoType(), expr)
+	default:
+		return expr
+	}
+}
+
+// equalExpr returns the expression comparing the values of the type t.
+func (g *Generator) equalExpr(a, b string, t TypeMapping) string {
+	switch g.kindOf(t) {
+	case kindSlice:
+		return fmt.Sprintf("equal%s(%s, %s)", helperName(t), a, b)
+	case kindStruct:
+		return fmt.Sprintf("%s.Equal(&%s)", a, b)
+	case kindInterface:
+		return fmt.Sprintf("equalInterface(%s, %s)", a, b)
+	default:
+		return fmt.Sprintf("%s == %s", a, b)
+	}
+}
+
+// cloneField returns the statement cloning the field of t into res.
+// The plain fields are already copied with the struct, so the statement is empty for them.
+func (g *Generator) cloneField(fieldName string, t TypeMapping, required bool) string {
+	name := camel(fieldName)
+	switch {
+	case required || t.IsArray():
+		if g.isPlain(t) {
+			return ""
+		}
+		return fmt.Sprintf("res.%s = %s", name, g.cloneExpr("t."+name, t))
+	case g.kindOf(t) == kindStruct:
+		return fmt.Sprintf("res.%s = t.%s.Clone()", name, name)
+	default:
+		return fmt.Sprintf("res.%s = clone%sPtr(t.%s)", name, helperName(t), name)
+	}
+}
+
+// equalField returns the expression comparing the field of t and other.
+func (g *Generator) equalField(fieldName string, t TypeMapping, required bool) string {
+	name := camel(fieldName)
+	switch {
+	case required || t.IsArray():
+		return g.equalExpr("t."+name, "other."+name, t)
+	case g.kindOf(t) == kindStruct:
+		return fmt.Sprintf("t.%s.Equal(other.%s)", name, name)
+	default:
+		return fmt.Sprintf("equal%sPtr(t.%s, other.%s)", helperName(t), name, name)
+	}
+}
+
+// sliceTypes returns the array types of the API type fields including the nested ones.
+func (g *Generator) sliceTypes() []TypeMapping {
+	unique := make(map[string]TypeMapping)
+	for typename, desc := range g.schema.Types {
+		for fieldName, field := range desc.Fields {
+			for t := g.getType(fieldName, typename, field.Types); t.IsArray(); t = t.ArrayType() {
+				unique[fieldGoType(t)] = t
+			}
+		}
+	}
+	return sortedTypes(unique)
+}
+
+// pointerTypes returns the types of the optional API type fields which are not arrays and API types.
+func (g *Generator) pointerTypes() []TypeMapping {
+	unique := make(map[string]TypeMapping)
+	for typename, desc := range g.schema.Types {
+		for fieldName, field := range desc.Fields {
+			t := g.getType(fieldName, typename, field.Types)
+			if !field.Required && !t.IsArray() && g.kindOf(t) != kindStruct {
+				unique[fieldGoType(t)] = t
+			}
+		}
+	}
+	return sortedTypes(unique)
+}
+
+// sortedTypes returns the types sorted by the Go types, "str" and "String" are the same type.
+func sortedTypes(unique map[string]TypeMapping) []TypeMapping {
+	res := make([]TypeMapping, 0, len(unique))
+	for _, goType := range sortedKeys(unique) {
+		res = append(res, unique[goType])
+	}
+	return res
+}
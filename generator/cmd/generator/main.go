@@ -3,29 +3,93 @@ package main
 import (
 	"flag"
 	"log"
+	"os"
 
 	"github.com/Feresey/tgbotapi/generator"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "diff" {
+		diff(os.Args[2:])
+		return
+	}
+
 	var (
 		outDir       string
 		templateDir  string
 		apSchemaPath string
+		mappingPath  string
+		check        bool
 	)
 
 	flag.StringVar(&outDir, "o", "", "output dir")
 	flag.StringVar(&templateDir, "t", "", "template dir")
 	flag.StringVar(&apSchemaPath, "s", "", "api schema path")
+	flag.StringVar(&mappingPath, "m", "mapping.json", "schema mapping path")
+	flag.BoolVar(&check, "check", false, "check that the files in the output dir are up to date, do not write them")
 	flag.Parse()
 
-	gen, err := generator.NewGenerator(apSchemaPath, templateDir)
+	gen, err := generator.NewGenerator(apSchemaPath, templateDir, mappingPath)
 	if err != nil {
 		log.Fatal(err)
 	}
 
+	if check {
+		if err := gen.Check(outDir); err != nil {
+			log.Fatal(err)
+		}
+		log.Print("Generated files are up to date")
+		return
+	}
+
 	if err := gen.Generate(outDir); err != nil {
 		log.Fatal("Generate go files: ", err)
 	}
 	log.Print("Success")
 }
+
+// diff prints the markdown changelog between two schema versions:
+//
+//	generator diff -old old.json -new new.json > CHANGES.md
+func diff(args []string) {
+	var (
+		oldSchemaPath string
+		newSchemaPath string
+		templateDir   string
+		mappingPath   string
+		outPath       string
+	)
+
+	flags := flag.NewFlagSet("diff", flag.ExitOnError)
+	flags.StringVar(&oldSchemaPath, "old", "", "old api schema path")
+	flags.StringVar(&newSchemaPath, "new", "", "new api schema path")
+	flags.StringVar(&templateDir, "t", "templates", "template dir")
+	flags.StringVar(&mappingPath, "m", "mapping.json", "schema mapping path")
+	flags.StringVar(&outPath, "o", "", "output file, stdout by default")
+	_ = flags.Parse(args)
+
+	res, err := generator.Diff(oldSchemaPath, newSchemaPath, templateDir, mappingPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := writeDiff(res, outPath); err != nil {
+		log.Fatal("Write diff: ", err)
+	}
+}
+
+// writeDiff writes the changelog to the file or to stdout if the path is empty.
+func writeDiff(res *generator.SchemaDiff, outPath string) error {
+	if outPath == "" {
+		return res.WriteMarkdown(os.Stdout)
+	}
+
+	out, err := os.Create(outPath)
+	if err != nil {
+		return err
+	}
+	if err := res.WriteMarkdown(out); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
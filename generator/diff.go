@@ -0,0 +1,406 @@
+package generator
+
+import (
+	"bufio"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Changes lists the changes of a group of the schema items.
+type Changes struct {
+	Added   []string
+	Removed []string
+	// Changed maps the item name to the list of its changes.
+	Changed map[string][]string
+}
+
+// Empty reports whether there are no changes.
+func (c *Changes) Empty() bool {
+	return len(c.Added) == 0 && len(c.Removed) == 0 && len(c.Changed) == 0
+}
+
+// SchemaDiff is the difference between two versions of the API schema.
+type SchemaDiff struct {
+	OldVersion string
+	NewVersion string
+
+	Methods Changes
+	Types   Changes
+	Enums   Changes
+
+	// Breaking lists the generated identifiers which are removed or changed.
+	Breaking []string
+	// Additions lists the new generated identifiers.
+	Additions []string
+}
+
+// Diff compares two schema files. The mapping is applied to both schemas,
+// the Go API compatibility is checked on the code rendered with the templates.
+func Diff(oldFile, newFile, templatesDir, mappingFile string) (*SchemaDiff, error) {
+	load := func(schemaFile string) (*Generator, error) {
+		schema, err := LoadSchema(schemaFile)
+		if err != nil {
+			return nil, fmt.Errorf("load %s: %w", schemaFile, err)
+		}
+		mapping, err := LoadMapping(mappingFile)
+		if err != nil {
+			return nil, fmt.Errorf("load mapping: %w", err)
+		}
+		return newGenerator(schema, templatesDir, mapping)
+	}
+
+	oldGen, err := load(oldFile)
+	if err != nil {
+		return nil, err
+	}
+	newGen, err := load(newFile)
+	if err != nil {
+		return nil, err
+	}
+	return diffGenerators(oldGen, newGen)
+}
+
+func diffGenerators(oldGen, newGen *Generator) (*SchemaDiff, error) {
+	res := &SchemaDiff{
+		OldVersion: oldGen.schema.Version,
+		NewVersion: newGen.schema.Version,
+	}
+
+	oldMethods, newMethods := oldGen.schema.Methods, newGen.schema.Methods
+	res.Methods = diffItems(sortedKeys(oldMethods), sortedKeys(newMethods), func(name string) []string {
+		oldMethod, newMethod := oldMethods[name], newMethods[name]
+		changes := diffFields("argument", oldMethod.Arguments, newMethod.Arguments)
+		if oldReturns, newReturns := returnsName(oldMethod.Returns), returnsName(newMethod.Returns); oldReturns != newReturns {
+			changes = append(changes, fmt.Sprintf("returns `%s` → `%s`", oldReturns, newReturns))
+		}
+		return changes
+	})
+
+	res.Types = diffItems(sortedKeys(oldGen.schema.Types), sortedKeys(newGen.schema.Types), func(name string) []string {
+		return diffFields("field", oldGen.schema.Types[name].Fields, newGen.schema.Types[name].Fields)
+	})
+
+	res.Enums = diffItems(sortedKeys(oldGen.enums), sortedKeys(newGen.enums), func(name string) []string {
+		oldValues, newValues := enumValues(oldGen.enums[name]), enumValues(newGen.enums[name])
+		var changes []string
+		for _, value := range subtract(newValues, oldValues) {
+			changes = append(changes, fmt.Sprintf("added value `%s`", value))
+		}
+		for _, value := range subtract(oldValues, newValues) {
+			changes = append(changes, fmt.Sprintf("removed value `%s`", value))
+		}
+		return changes
+	})
+
+	oldSurface, err := oldGen.surface()
+	if err != nil {
+		return nil, fmt.Errorf("render %s: %w", oldGen.schema.Version, err)
+	}
+	newSurface, err := newGen.surface()
+	if err != nil {
+		return nil, fmt.Errorf("render %s: %w", newGen.schema.Version, err)
+	}
+	for _, ident := range sortedKeys(oldSurface) {
+		newDecl, ok := newSurface[ident]
+		switch {
+		case !ok:
+			res.Breaking = append(res.Breaking, fmt.Sprintf("`%s`: removed", ident))
+		case newDecl != oldSurface[ident]:
+			res.Breaking = append(res.Breaking, fmt.Sprintf("`%s`: `%s` → `%s`", ident, oldSurface[ident], newDecl))
+		}
+	}
+	for _, ident := range sortedKeys(newSurface) {
+		if _, ok := oldSurface[ident]; !ok {
+			res.Additions = append(res.Additions, fmt.Sprintf("`%s`", ident))
+		}
+	}
+	return res, nil
+}
+
+// diffItems compares the lists of the sorted names. changed returns the changes of the item present in both lists.
+func diffItems(oldNames, newNames []string, changed func(name string) []string) Changes {
+	res := Changes{
+		Added:   subtract(newNames, oldNames),
+		Removed: subtract(oldNames, newNames),
+		Changed: make(map[string][]string),
+	}
+	for _, name := range oldNames {
+		if !contains(newNames, name) {
+			continue
+		}
+		if changes := changed(name); len(changes) != 0 {
+			res.Changed[name] = changes
+		}
+	}
+	return res
+}
+
+func diffFields(kind string, oldFields, newFields map[string]Field) []string {
+	var res []string
+	for _, name := range sortedKeys(newFields) {
+		if _, ok := oldFields[name]; !ok {
+			res = append(res, fmt.Sprintf("added %s `%s` (%s)", kind, name, fieldSummary(newFields[name])))
+		}
+	}
+	for _, name := range sortedKeys(oldFields) {
+		newField, ok := newFields[name]
+		if !ok {
+			res = append(res, fmt.Sprintf("removed %s `%s`", kind, name))
+			continue
+		}
+		oldField := oldFields[name]
+		if oldTypes, newTypes := typesName(oldField.Types), typesName(newField.Types); oldTypes != newTypes {
+			res = append(res, fmt.Sprintf("%s `%s`: type `%s` → `%s`", kind, name, oldTypes, newTypes))
+		}
+		if oldField.Required != newField.Required {
+			res = append(res, fmt.Sprintf("%s `%s`: %s → %s", kind, name, requiredName(oldField), requiredName(newField)))
+		}
+	}
+	return res
+}
+
+func fieldSummary(field Field) string {
+	return fmt.Sprintf("`%s`, %s", typesName(field.Types), requiredName(field))
+}
+
+func requiredName(field Field) string {
+	if field.Required {
+		return "required"
+	}
+	return "optional"
+}
+
+func typesName(types []TypeMapping) string {
+	names := make([]string, 0, len(types))
+	for _, t := range types {
+		names = append(names, string(t))
+	}
+	return strings.Join(names, " | ")
+}
+
+func returnsName(t *TypeMapping) string {
+	if t == nil {
+		return "null"
+	}
+	return string(*t)
+}
+
+func enumValues(values []EnumValue) []string {
+	res := make([]string, 0, len(values))
+	for _, value := range values {
+		res = append(res, value.Value)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// subtract returns the elements of a which are not in b.
+func subtract(a, b []string) []string {
+	var res []string
+	for _, s := range a {
+		if !contains(b, s) {
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+// surface returns the exported Go identifiers of the rendered code with their declarations:
+// the types of the fields and of the constants, the signatures of the functions and of the methods.
+func (g *Generator) surface() (map[string]string, error) {
+	files, err := g.Render()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]string)
+	fset := token.NewFileSet()
+	for _, name := range sortedKeys(files) {
+		file, err := parser.ParseFile(fset, name, files[name], 0)
+		if err != nil {
+			return nil, fmt.Errorf("parse generated %s: %w", name, err)
+		}
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if !decl.Name.IsExported() {
+					continue
+				}
+				name := decl.Name.Name
+				if decl.Recv != nil {
+					name = fmt.Sprintf("(%s).%s", types.ExprString(decl.Recv.List[0].Type), name)
+				}
+				res[name] = signature(decl.Type)
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						typeSurface(res, spec)
+					case *ast.ValueSpec:
+						valueSurface(res, spec)
+					}
+				}
+			}
+		}
+	}
+	return res, nil
+}
+
+func typeSurface(res map[string]string, spec *ast.TypeSpec) {
+	if !spec.Name.IsExported() {
+		return
+	}
+	name := spec.Name.Name
+	switch typ := spec.Type.(type) {
+	case *ast.StructType:
+		res[name] = "struct"
+		for _, field := range typ.Fields.List {
+			for _, ident := range fieldNames(field) {
+				if ident.IsExported() {
+					res[name+"."+ident.Name] = typeString(field.Type)
+				}
+			}
+		}
+	case *ast.InterfaceType:
+		res[name] = "interface"
+		for _, method := range typ.Methods.List {
+			for _, ident := range fieldNames(method) {
+				res[name+"."+ident.Name] = typeString(method.Type)
+			}
+		}
+	default:
+		res[name] = typeString(spec.Type)
+	}
+}
+
+// valueSurface adds the constants and the variables. The values are kept only for the typed constants,
+// the enum values, so the change of e.g. Version is not breaking.
+func valueSurface(res map[string]string, spec *ast.ValueSpec) {
+	for i, ident := range spec.Names {
+		if !ident.IsExported() {
+			continue
+		}
+		switch {
+		case spec.Type == nil:
+			res[ident.Name] = "untyped"
+		case i < len(spec.Values):
+			res[ident.Name] = fmt.Sprintf("%s = %s", typeString(spec.Type), types.ExprString(spec.Values[i]))
+		default:
+			res[ident.Name] = typeString(spec.Type)
+		}
+	}
+}
+
+// fieldNames returns the names of the field, the embedded field is named after its type.
+func fieldNames(field *ast.Field) []*ast.Ident {
+	if len(field.Names) != 0 {
+		return field.Names
+	}
+	typ := field.Type
+	if star, ok := typ.(*ast.StarExpr); ok {
+		typ = star.X
+	}
+	switch typ := typ.(type) {
+	case *ast.Ident:
+		return []*ast.Ident{typ}
+	case *ast.SelectorExpr:
+		return []*ast.Ident{typ.Sel}
+	}
+	return nil
+}
+
+// typeString returns the type expression, the function types are without the parameter names.
+func typeString(typ ast.Expr) string {
+	if ft, ok := typ.(*ast.FuncType); ok {
+		return signature(ft)
+	}
+	return types.ExprString(typ)
+}
+
+// signature returns the function type without the parameter names, e.g. func(context.Context, int64) error.
+func signature(ft *ast.FuncType) string {
+	return types.ExprString(&ast.FuncType{
+		Params:  unnamed(ft.Params),
+		Results: unnamed(ft.Results),
+	})
+}
+
+func unnamed(list *ast.FieldList) *ast.FieldList {
+	if list == nil {
+		return nil
+	}
+	res := &ast.FieldList{}
+	for _, field := range list.List {
+		for i := 0; i < len(field.Names) || i == 0; i++ {
+			res.List = append(res.List, &ast.Field{Type: field.Type})
+		}
+	}
+	return res
+}
+
+// WriteMarkdown writes the changelog in markdown.
+func (d *SchemaDiff) WriteMarkdown(w io.Writer) error {
+	buf := bufio.NewWriter(w)
+
+	fmt.Fprintf(buf, "# Bot API schema diff: %s → %s\n", d.OldVersion, d.NewVersion)
+	if d.Methods.Empty() && d.Types.Empty() && d.Enums.Empty() && len(d.Breaking) == 0 && len(d.Additions) == 0 {
+		fmt.Fprintf(buf, "\nNo changes.\n")
+		return buf.Flush()
+	}
+
+	writeChanges(buf, "Methods", &d.Methods)
+	writeChanges(buf, "Types", &d.Types)
+	writeChanges(buf, "Enums", &d.Enums)
+
+	fmt.Fprintf(buf, "\n## Go API compatibility\n")
+	if len(d.Breaking) == 0 {
+		fmt.Fprintf(buf, "\nNo breaking changes.\n")
+	} else {
+		writeList(buf, fmt.Sprintf("Breaking (%d)", len(d.Breaking)), d.Breaking)
+	}
+	writeList(buf, fmt.Sprintf("Additions (%d)", len(d.Additions)), d.Additions)
+	return buf.Flush()
+}
+
+func writeChanges(w io.Writer, title string, changes *Changes) {
+	if changes.Empty() {
+		return
+	}
+	fmt.Fprintf(w, "\n## %s\n", title)
+	writeList(w, "Added", quote(changes.Added))
+	writeList(w, "Removed", quote(changes.Removed))
+	if len(changes.Changed) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "\n### Changed\n\n")
+	for _, name := range sortedKeys(changes.Changed) {
+		fmt.Fprintf(w, "- `%s`\n", name)
+		for _, change := range changes.Changed[name] {
+			fmt.Fprintf(w, "  - %s\n", change)
+		}
+	}
+}
+
+func writeList(w io.Writer, title string, items []string) {
+	if len(items) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "\n### %s\n\n", title)
+	for _, item := range items {
+		fmt.Fprintf(w, "- %s\n", item)
+	}
+}
+
+func quote(names []string) []string {
+	res := make([]string, 0, len(names))
+	for _, name := range names {
+		res = append(res, "`"+name+"`")
+	}
+	return res
+}
@@ -0,0 +1,132 @@
+package generator
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func diffTestSchema(version string, text Field, entityTypes string, extra map[string]Method) *APISchema {
+	message := TypeMapping("Message")
+	methods := map[string]Method{
+		"sendMessage": {
+			Arguments: map[string]Field{
+				"chat_id": {Types: []TypeMapping{"int", "str"}, Required: true},
+				"text":    text,
+			},
+			Returns: &message,
+		},
+	}
+	for name, method := range extra {
+		methods[name] = method
+	}
+	return &APISchema{
+		Version: version,
+		Methods: methods,
+		Types: map[string]Type{
+			"Message": {Fields: map[string]Field{
+				"message_id": {Types: []TypeMapping{"int"}, Required: true},
+			}},
+			"MessageEntity": {Fields: map[string]Field{
+				"type": {
+					Types:       []TypeMapping{"str"},
+					Required:    true,
+					Description: Description{PlainText: "Type of the entity. Currently, can be " + entityTypes},
+				},
+			}},
+		},
+	}
+}
+
+func newDiffGenerator(t *testing.T, schema *APISchema) *Generator {
+	mapping, err := LoadMapping("mapping.json")
+	require.NoError(t, err)
+	g, err := newGenerator(schema, "templates", mapping)
+	require.NoError(t, err)
+	return g
+}
+
+func TestDiff(t *testing.T) {
+	oldSchema := diffTestSchema("6.6",
+		Field{Types: []TypeMapping{"str"}, Required: true},
+		`"mention", "hashtag"`,
+		map[string]Method{"getMe": {}},
+	)
+	newSchema := diffTestSchema("6.7",
+		Field{Types: []TypeMapping{"int"}, Required: true},
+		`"mention", "blockquote"`,
+		map[string]Method{"getMyName": {}},
+	)
+
+	res, err := diffGenerators(newDiffGenerator(t, oldSchema), newDiffGenerator(t, newSchema))
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"getMyName"}, res.Methods.Added)
+	require.Equal(t, []string{"getMe"}, res.Methods.Removed)
+	require.Equal(t, map[string][]string{
+		"sendMessage": {"argument `text`: type `str` → `int`"},
+	}, res.Methods.Changed)
+	require.True(t, res.Types.Empty())
+	require.Equal(t, map[string][]string{
+		"EntityType": {"added value `blockquote`", "removed value `hashtag`"},
+	}, res.Enums.Changed)
+
+	sendMessage := "`func(context.Context, IntStr, string) (*Message, error)` → " +
+		"`func(context.Context, IntStr, int64) (*Message, error)`"
+	require.Equal(t, []string{
+		"`(*API).GetMe`: removed",
+		"`(*API).SendMessage`: " + sendMessage,
+		"`(*FakeAPI).GetMe`: removed",
+		"`(*FakeAPI).SendMessage`: " + sendMessage,
+		"`BotAPI.GetMe`: removed",
+		"`BotAPI.SendMessage`: " + sendMessage,
+		"`EntityTypeHashtag`: removed",
+		"`FakeAPI.GetMeFunc`: removed",
+		"`FakeAPI.SendMessageFunc`: " + sendMessage,
+	}, res.Breaking)
+	require.Equal(t, []string{
+		"`(*API).GetMyName`",
+		"`(*FakeAPI).GetMyName`",
+		"`BotAPI.GetMyName`",
+		"`EntityTypeBlockquote`",
+		"`FakeAPI.GetMyNameFunc`",
+	}, res.Additions)
+
+	var buf bytes.Buffer
+	require.NoError(t, res.WriteMarkdown(&buf))
+	require.Contains(t, buf.String(), "# Bot API schema diff: 6.6 → 6.7\n")
+	require.Contains(t, buf.String(), "\n### Breaking (9)\n")
+	require.Contains(t, buf.String(), "- `sendMessage`\n  - argument `text`: type `str` → `int`\n")
+}
+
+func TestDiffNoChanges(t *testing.T) {
+	text := Field{Types: []TypeMapping{"str"}, Required: true}
+	res, err := diffGenerators(
+		newDiffGenerator(t, diffTestSchema("6.6", text, `"mention"`, nil)),
+		newDiffGenerator(t, diffTestSchema("6.6", text, `"mention"`, nil)),
+	)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, res.WriteMarkdown(&buf))
+	require.Equal(t, "# Bot API schema diff: 6.6 → 6.6\n\nNo changes.\n", buf.String())
+}
+
+func TestSurfaceConstructors(t *testing.T) {
+	extra := map[string]Method{"sendPhoto": {Arguments: map[string]Field{
+		"chat_id": {Types: []TypeMapping{"int", "str"}, Required: true},
+		"photo":   {Types: []TypeMapping{"InputFile", "str"}, Required: true},
+		"caption": {Types: []TypeMapping{"str"}},
+	}}}
+	text := Field{Types: []TypeMapping{"str"}, Required: true}
+	surface, err := newDiffGenerator(t, diffTestSchema("6.6", text, `"mention"`, extra)).surface()
+	require.NoError(t, err)
+
+	require.Equal(t, "func(int64) *Message", surface["NewMessage"])
+	require.Equal(t, "func(IntStr, InputFile) *SendPhotoConfig", surface["NewSendPhotoConfig"])
+	require.Equal(t, "string", surface["SendPhotoConfig.Caption"])
+	// the methods added by the templates are a part of the surface as well.
+	require.Equal(t, "func() error", surface["(*SendPhotoConfig).Validate"])
+	require.Equal(t, "func() bool", surface["(EntityType).IsKnown"])
+}
@@ -3,19 +3,22 @@ package generator
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"go/token"
 	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
-	"reflect"
 	"regexp"
 	"sort"
 	"strings"
 	"text/template"
+	"unicode"
 
 	"github.com/Masterminds/sprig/v3"
 	"github.com/iancoleman/strcase"
+	"github.com/pmezard/go-difflib/difflib"
 
 	"golang.org/x/tools/imports"
 )
@@ -24,71 +27,8 @@ const InputFile = "InputFile"
 
 const maxInLine = 100
 
-func getEnumName(s string) string {
-	switch s {
-	case "MessageEntity":
-		return "Entity"
-	case "KeyboardButtonPollType":
-		return "KeyboardButton"
-	}
-	return strings.Split(strcase.ToDelimited(s, ' '), " ")[0]
-}
-
-var (
-	markupType = []TypeMapping{
-		"InlineKeyboardMarkup",
-		"ReplyKeyboardMarkup",
-		"ReplyKeyboardRemove",
-		"ForceReply",
-	}
-	inputType = []TypeMapping{
-		InputFile,
-		"str",
-	}
-	intStr = []TypeMapping{
-		"int",
-		"str",
-	}
-)
-
-//nolint:goconst // useless
-func multitype(ss []TypeMapping) TypeMapping {
-	if reflect.DeepEqual(ss, markupType) {
-		return "ReplyMarkup"
-	}
-	if reflect.DeepEqual(ss, inputType) {
-		return InputFile
-	}
-	if reflect.DeepEqual(ss, intStr) {
-		return "IntStr"
-	}
-	return ""
-}
-
-func isInterface(t TypeMapping) bool {
-	for _, name := range []TypeMapping{"ReplyMarkup"} {
-		if t == name {
-			return true
-		}
-	}
-	return false
-}
-
-func getType(fieldName, typeName string, types []TypeMapping) TypeMapping {
-	if len(types) > 1 {
-		return multitype(types)
-	}
-	if len(types) != 1 {
-		return ""
-	}
-	if fieldName == "type" {
-		return TypeMapping(strcase.ToCamel(fmt.Sprintf("%s_type", getEnumName(typeName))))
-	}
-	return types[0]
-}
-
-func defaultReturn(t TypeMapping) string {
-	if !t.IsSimpleType() {
+func (g *Generator) defaultReturn(t TypeMapping) string {
+	if !g.mapping.isSimple(t) {
 		return "nil"
 	}
 	//nolint:goconst // useless
@@ -113,6 +53,20 @@ func rename(s string) string {
 	return goNameReplacer.Replace(s)
 }
 
+func camel(s string) string { return rename(strcase.ToCamel(s)) }
+
+// param returns the name of the function parameter for the field.
+func param(fieldName string) string {
+	name := rename(strcase.ToLowerCamel(fieldName))
+	switch {
+	case name == "type":
+		return "typ"
+	case token.IsKeyword(name):
+		return name + "_"
+	}
+	return name
+}
+
 func formatURL(name string, stared bool, t TypeMapping) string {
 	var sname = name
 	if stared {
@@ -132,32 +86,12 @@ func formatURL(name string, stared bool, t TypeMapping) string {
 }
 
 var funcs = template.FuncMap{
-	"input_type": func(s string) string {
-		return strings.ToLower(strings.TrimPrefix(s, "send"))
-	},
-	"is_sendable": func(m map[string]Field) bool {
-		for _, field := range m {
-			if len(field.Types) != 1 {
-				typ := multitype(field.Types)
-				if typ == InputFile && field.Required {
-					return true
-				}
-			}
-		}
-
-		return false
-	},
-	"skip": func(s string) bool {
-		return s == InputFile
-	},
-	"format_url":     formatURL,
-	"default_return": defaultReturn,
-	"get_type":       getType,
-	"is_interface":   isInterface,
-	"camel":          func(s string) string { return rename(strcase.ToCamel(s)) },
-	"lowercamel":     func(s string) string { return rename(strcase.ToLowerCamel(s)) },
-	"first":          getEnumName,
-	"inc":            func(i int) int { return i + 1 },
+	"format_url":  formatURL,
+	"constraints": methodConstraints,
+	"camel":       camel,
+	"lowercamel":  func(s string) string { return rename(strcase.ToLowerCamel(s)) },
+	"param":       param,
+	"inc":         func(i int) int { return i + 1 },
 	"format": func(s string, tabs int) string {
 		s = strings.TrimPrefix(s, "Optional. ")
 		s = strings.ReplaceAll(s, ".Example", ".\nExample")
@@ -186,40 +120,147 @@ var funcs = template.FuncMap{
 }
 
 type Generator struct {
-	schema *APISchema
-	tmpl   *template.Template
+	schema  *APISchema
+	mapping *Mapping
+	tmpl    *template.Template
+	// map[typename][]value
+	enums map[string][]EnumValue
+	// map[typename.field]typename for the enum fields not named "type".
+	enumFields map[string]string
 }
 
-func NewGenerator(schemaFile, tempaltesDir string) (*Generator, error) {
-	file, err := os.Open(schemaFile)
+// NewGenerator loads the schema, applies the mapping to it and parses the templates.
+func NewGenerator(schemaFile, tempaltesDir, mappingFile string) (*Generator, error) {
+	schema, err := LoadSchema(schemaFile)
 	if err != nil {
 		return nil, err
 	}
-	defer file.Close()
-
-	var schema APISchema
-	err = json.NewDecoder(file).Decode(&schema)
+	mapping, err := LoadMapping(mappingFile)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("load mapping: %w", err)
 	}
+	return newGenerator(schema, tempaltesDir, mapping)
+}
+
+// newGenerator applies the mapping to the schema and parses the templates.
+func newGenerator(schema *APISchema, tempaltesDir string, mapping *Mapping) (*Generator, error) {
+	mapping.Apply(schema)
+
+	g := &Generator{schema: schema, mapping: mapping}
+	g.enums, g.enumFields = g.getEnums()
 
 	tmpl := template.New("").
 		Funcs(sprig.TxtFuncMap()).
-		Funcs(funcs)
-
-	tmpl, err = tmpl.ParseGlob(filepath.Join(tempaltesDir, "*.tpl"))
+		Funcs(funcs).
+		Funcs(template.FuncMap{
+			"get_type":       g.getType,
+			"is_enum":        g.isEnum,
+			"is_simple":      g.mapping.isSimple,
+			"is_interface":   g.mapping.isInterface,
+			"is_sendable":    g.isSendable,
+			"default_return": g.defaultReturn,
+			"first":          g.mapping.enumPrefix,
+			"fixed_value":    g.fixedValue,
+			"clone_field":    g.cloneField,
+			"equal_field":    g.equalField,
+			"clone_expr":     g.cloneExpr,
+			"equal_expr":     g.equalExpr,
+			"is_plain":       g.isPlain,
+			"slice_types":    g.sliceTypes,
+			"pointer_types":  g.pointerTypes,
+			"helper_name":    helperName,
+			"field_type":     fieldGoType,
+		})
+
+	var err error
+	g.tmpl, err = tmpl.ParseGlob(filepath.Join(tempaltesDir, "*.tpl"))
 	if err != nil {
 		return nil, err
 	}
-	return &Generator{schema: &schema, tmpl: tmpl}, nil
+	return g, nil
 }
 
-func (g *Generator) Generate(outDir string) error {
-	err := os.MkdirAll(outDir, os.ModePerm)
+// LoadSchema reads the API schema from the JSON file.
+func LoadSchema(schemaFile string) (*APISchema, error) {
+	file, err := os.Open(schemaFile)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	defer file.Close()
+
+	var schema APISchema
+	if err := json.NewDecoder(file).Decode(&schema); err != nil {
+		return nil, err
+	}
+	return &schema, nil
+}
+
+func (g *Generator) getType(fieldName, typeName string, types []TypeMapping) TypeMapping {
+	if len(types) > 1 {
+		union, _ := g.mapping.union(types)
+		return union.Type
+	}
+	if len(types) != 1 {
+		return ""
+	}
+	if fieldName == "type" {
+		return TypeMapping(strcase.ToCamel(fmt.Sprintf("%s_type", g.mapping.enumPrefix(typeName))))
+	}
+	if enum, ok := g.enumFields[typeName+"."+fieldName]; ok {
+		return TypeMapping(enum)
+	}
+	return types[0]
+}
+
+// fixedValue returns the enum constant of the field which has the only allowed value,
+// e.g. InputMediaPhoto.type must be "photo". It returns an empty string for other fields.
+func (g *Generator) fixedValue(fieldName, typeName string, field Field) string {
+	var (
+		enumName string
+		values   []string
+	)
+	if fieldName == "type" {
+		enumName = strcase.ToCamel(g.mapping.enumPrefix(typeName) + "_type")
+		values = oneof(field.Description.PlainText)
+	} else {
+		var ok bool
+		enumName, values, ok = g.fieldEnum(typeName, fieldName, field)
+		if !ok {
+			return ""
+		}
+	}
+
+	values = unique(values)
+	if len(values) != 1 {
+		return ""
+	}
+	for _, value := range g.enums[enumName] {
+		if value.Value == values[0] {
+			return enumName + value.Name
+		}
+	}
+	return ""
+}
+
+func (g *Generator) isSendable(args map[string]Field) bool {
+	for _, field := range args {
+		if len(field.Types) != 1 && field.Required {
+			if union, _ := g.mapping.union(field.Types); union.Type == InputFile {
+				return true
+			}
+		}
 	}
+	return false
+}
+
+func (g *Generator) isEnum(t TypeMapping) bool {
+	_, ok := g.enums[string(t)]
+	return ok
+}
 
+// Render executes the templates and returns the formatted files by their names.
+// The code which cannot be formatted is an error.
+func (g *Generator) Render() (map[string][]byte, error) {
 	// TODO
 	head := fmt.Sprintf("// Generated by: %s\n\npackage %s", "github.com/Feresey/gen-tgbotapi", "tgapi")
 
@@ -228,66 +269,197 @@ func (g *Generator) Generate(outDir string) error {
 		RequiredOrder []bool
 		*APISchema
 		// map[typename][]value
-		EnumTypes map[string][]string
+		EnumTypes map[string][]EnumValue
 	}{
 		Head:          head,
 		APISchema:     g.schema,
 		RequiredOrder: []bool{true, false},
-		EnumTypes:     g.getEnums(),
+		EnumTypes:     g.enums,
 	}
 
+	res := make(map[string][]byte)
 	for _, tmpl := range g.tmpl.Templates() {
-		name := strings.TrimSuffix(tmpl.Name(), ".tpl")
-		if name == "" {
-			// main template
+		if !strings.HasSuffix(tmpl.Name(), ".go.tpl") {
+			// main template, helpers and the defined templates
 			continue
 		}
-		outName := filepath.Join(outDir, name)
-		log.Printf("Generating template %s", outName)
+		name := strings.TrimSuffix(tmpl.Name(), ".tpl")
 
 		buf := new(bytes.Buffer)
 		if err := tmpl.Execute(buf, templateData); err != nil {
-			return err
+			return nil, err
 		}
 
 		formatted, err := imports.Process("", buf.Bytes(), nil)
 		if err != nil {
-			log.Print("Format generated code: ", err)
-			formatted = buf.Bytes()
+			return nil, fmt.Errorf("format generated %s: %w", name, err)
 		}
+		res[name] = formatted
+	}
+	return res, nil
+}
 
-		if err := ioutil.WriteFile(outName, formatted, 0600); err != nil {
+func (g *Generator) Generate(outDir string) error {
+	err := os.MkdirAll(outDir, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	files, err := g.Render()
+	if err != nil {
+		return err
+	}
+	for _, name := range sortedKeys(files) {
+		outName := filepath.Join(outDir, name)
+		log.Printf("Generating template %s", outName)
+		if err := ioutil.WriteFile(outName, files[name], 0600); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (g *Generator) getEnums() map[string][]string {
-	res := make(map[string][]string)
-	for typename, typeDesc := range g.schema.Types {
-		field, ok := typeDesc.Fields["type"]
-		if !ok {
+// ErrStale is returned by Check if the generated files differ from the templates output.
+var ErrStale = errors.New("generated files are stale")
+
+// Check renders the templates in memory and compares the result with the files in outDir.
+// The returned error contains the unified diff of the stale files.
+func (g *Generator) Check(outDir string) error {
+	files, err := g.Render()
+	if err != nil {
+		return err
+	}
+
+	var diffs []string
+	for _, name := range sortedKeys(files) {
+		outName := filepath.Join(outDir, name)
+		current, err := ioutil.ReadFile(outName)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if bytes.Equal(current, files[name]) {
 			continue
 		}
-		enumName := strcase.ToCamel(getEnumName(typename) + "_type")
-		res[enumName] = append(res[enumName], oneof(field.Description.PlainText)...)
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(string(current)),
+			B:        difflib.SplitLines(string(files[name])),
+			FromFile: outName,
+			ToFile:   outName + " (generated)",
+			Context:  3,
+		})
+		if err != nil {
+			return err
+		}
+		diffs = append(diffs, diff)
+	}
+	if len(diffs) != 0 {
+		return fmt.Errorf("%w:\n%s", ErrStale, strings.Join(diffs, "\n"))
+	}
+	return nil
+}
+
+// EnumValue is a constant of the generated enum.
+type EnumValue struct {
+	// Name is the suffix of the constant name.
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// getEnums returns the values of all enums and the enum fields not named "type".
+func (g *Generator) getEnums() (enums map[string][]EnumValue, fields map[string]string) {
+	values := make(map[string][]string)
+	fields = make(map[string]string)
+	addFields := func(typename string, typeFields map[string]Field) {
+		for fieldName, field := range typeFields {
+			if fieldName == "type" {
+				enumName := strcase.ToCamel(g.mapping.enumPrefix(typename) + "_type")
+				values[enumName] = append(values[enumName], oneof(field.Description.PlainText)...)
+				continue
+			}
+			enumName, list, ok := g.fieldEnum(typename, fieldName, field)
+			if !ok {
+				continue
+			}
+			fields[typename+"."+fieldName] = enumName
+			values[enumName] = append(values[enumName], list...)
+		}
+	}
+	for typename, typeDesc := range g.schema.Types {
+		addFields(typename, typeDesc.Fields)
 	}
 	for typename, typeDesc := range g.schema.Methods {
-		field, ok := typeDesc.Arguments["type"]
-		if !ok {
+		addFields(typename, typeDesc.Arguments)
+	}
+
+	enums = make(map[string][]EnumValue, len(values))
+	for enumName, list := range values {
+		if known, ok := g.mapping.EnumValues[enumName]; ok {
+			enums[enumName] = known
 			continue
 		}
-		enumName := strcase.ToCamel(getEnumName(typename) + "_type")
-		res[enumName] = append(res[enumName], oneof(field.Description.PlainText)...)
+		list = unique(list)
+		res := make([]EnumValue, 0, len(list))
+		for _, value := range list {
+			res = append(res, EnumValue{Name: enumConstName(value), Value: value})
+		}
+		enums[enumName] = res
 	}
+	return enums, fields
+}
+
+var (
+	// the list of the quoted values in the same sentence.
+	enumListRe = regexp.MustCompile(`(?i)\b(?:one of|can be|currently|always|pass)\b[^."]*"`)
+	// Choose one, depending on what the user is about to receive: typing for text messages, ...
+	chooseOneRe = regexp.MustCompile(`\b([a-z]+(?:_[a-z]+)*) (?:for|or)\b`)
+)
+
+// fieldEnum reports whether the string field is an enum and returns its values.
+func (g *Generator) fieldEnum(typename, fieldName string, field Field) (name string, values []string, ok bool) {
+	if len(field.Types) != 1 || field.Types[0].GoType() != "string" {
+		return "", nil, false
+	}
+	name = g.mapping.enumName(typename, fieldName)
+	if _, ok := g.mapping.EnumValues[name]; ok {
+		return name, nil, true
+	}
+
+	text := field.Description.PlainText
+	if idx := strings.Index(text, "Choose one"); idx != -1 {
+		for _, match := range chooseOneRe.FindAllStringSubmatch(text[idx:], -1) {
+			values = append(values, match[1])
+		}
+	} else if enumListRe.MatchString(text) {
+		values = parseEntity(text)
+	}
+	values = dedup(values)
+	return name, values, len(values) != 0
+}
 
-	for idx, list := range res {
-		res[idx] = unique(list)
+// dedup removes the repeated values keeping the order, e.g. the default value listed again.
+func dedup(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	res := values[:0]
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			res = append(res, value)
+		}
 	}
 	return res
 }
 
+// enumConstName returns the suffix of the constant name for the enum value.
+func enumConstName(value string) string {
+	value = strings.Map(func(r rune) rune {
+		if r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, value)
+	return rename(strcase.ToCamel(value))
+}
+
 func unique(ss []string) []string {
 	unique := make(map[string]struct{})
 	for _, s := range ss {
@@ -316,21 +488,21 @@ func oneof(text string) []string {
 		return nil
 	}
 
-	one := "type of the result, must be "
-	if strings.HasPrefix(parts[0], one) {
-		return []string{strings.TrimPrefix(parts[0], one)}
-	}
-
-	if strings.Contains(parts[0], "quiz") && strings.Contains(parts[0], "regular") {
-		return []string{"regular", "quiz"}
+	// Type of the result, must be article
+	if match := mustBeRe.FindStringSubmatch(parts[0]); match != nil {
+		return match[1:]
 	}
 
 	return parseEntity(parts[0])
 }
 
+var (
+	mustBeRe = regexp.MustCompile(`must be (\w+)$`)
+	quotedRe = regexp.MustCompile(`"([\w/.+-]+)"`)
+)
+
 func parseEntity(s string) []string {
-	re := regexp.MustCompile(`"(\w+)"`)
-	matches := re.FindAllStringSubmatch(s, -1)
+	matches := quotedRe.FindAllStringSubmatch(s, -1)
 	res := make([]string, 0, len(matches))
 	for _, match := range matches {
 		res = append(res, match[1])
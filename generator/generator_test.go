@@ -0,0 +1,155 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldEnum(t *testing.T) {
+	mapping, err := LoadMapping("mapping.json")
+	require.NoError(t, err)
+	g := &Generator{mapping: mapping}
+
+	str := []TypeMapping{"str"}
+	tests := []struct {
+		typename string
+		field    string
+		desc     string
+		types    []TypeMapping
+		name     string
+		values   []string
+	}{
+		{
+			typename: "createNewStickerSet",
+			field:    "sticker_format",
+			desc:     `Format of stickers in the set, must be one of "static", "animated", "video"`,
+			name:     "StickerFormat",
+			values:   []string{"static", "animated", "video"},
+		},
+		{
+			typename: "createNewStickerSet",
+			field:    "sticker_type",
+			desc:     `Type of stickers in the set, pass "regular", "mask", or "custom_emoji". By default, a regular sticker set is created.`,
+			name:     "StickerType",
+			values:   []string{"regular", "mask", "custom_emoji"},
+		},
+		{
+			typename: "ChatMemberOwner",
+			field:    "status",
+			desc:     `The member's status in the chat, always "creator"`,
+			name:     "ChatMemberStatus",
+			values:   []string{"creator"},
+		},
+		{
+			typename: "InlineQueryResultGif",
+			field:    "thumbnail_mime_type",
+			desc:     `MIME type of the thumbnail, must be one of "image/jpeg", "image/gif", or "video/mp4". Defaults to "image/jpeg"`,
+			name:     "ThumbnailMimeType",
+			values:   []string{"image/jpeg", "image/gif", "video/mp4"},
+		},
+		{
+			typename: "sendChatAction",
+			field:    "action",
+			desc: "Type of action to broadcast. Choose one, depending on what the user is about to receive: " +
+				"typing for text messages, record_video or upload_video for videos.",
+			name:   "ChatAction",
+			values: []string{"typing", "record_video", "upload_video"},
+		},
+		{
+			typename: "sendMessage",
+			field:    "parse_mode",
+			desc:     "Mode for parsing entities in the message text.",
+			name:     "ParseMode",
+		},
+		{
+			typename: "EncryptedPassportElement",
+			field:    "data",
+			desc: `Base64-encoded encrypted Telegram Passport element data provided by the user, ` +
+				`available for "personal_details", "passport" types. Can be decrypted and verified.`,
+		},
+		{
+			typename: "InputMediaPhoto",
+			field:    "media",
+			desc:     `File to send. Pass a file_id, or pass "attach://<file_attach_name>" to upload a new one`,
+		},
+		{
+			typename: "Chat",
+			field:    "title",
+			desc:     `Title, for supergroups, channels and group chats`,
+		},
+		{
+			typename: "Update",
+			field:    "chat_member",
+			desc:     `The bot must explicitly specify "chat_member" in the list of allowed_updates to receive these updates.`,
+			types:    []TypeMapping{"ChatMemberUpdated"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.typename+"."+tt.field, func(t *testing.T) {
+			types := tt.types
+			if types == nil {
+				types = str
+			}
+			name, values, ok := g.fieldEnum(tt.typename, tt.field, Field{
+				Types:       types,
+				Description: Description{PlainText: tt.desc},
+			})
+			require.Equal(t, tt.name != "", ok)
+			if !ok {
+				return
+			}
+			require.Equal(t, tt.name, name)
+			require.Equal(t, tt.values, values)
+		})
+	}
+}
+
+func TestOneof(t *testing.T) {
+	require.Equal(t, []string{"default"}, oneof("Scope type, must be default"))
+	require.Equal(t, []string{"article"}, oneof("Type of the result, must be article"))
+	require.Equal(t, []string{"private", "group"}, oneof(`Type of chat, can be either "private" or "group"`))
+}
+
+func TestEnumConstName(t *testing.T) {
+	require.Equal(t, "BotCommand", enumConstName("bot_command"))
+	require.Equal(t, "ImageJpeg", enumConstName("image/jpeg"))
+	require.Equal(t, "URL", enumConstName("url"))
+}
+
+func TestFixedValue(t *testing.T) {
+	mapping, err := LoadMapping("mapping.json")
+	require.NoError(t, err)
+	g := &Generator{mapping: mapping, schema: &APISchema{
+		Types: map[string]Type{
+			"InputMediaPhoto": {Fields: map[string]Field{
+				"type": {Types: []TypeMapping{"str"}, Required: true, Description: Description{PlainText: "Type of the result, must be photo"}},
+			}},
+			"ChatMemberOwner": {Fields: map[string]Field{
+				"status": {Types: []TypeMapping{"str"}, Required: true, Description: Description{PlainText: `The member's status in the chat, always "creator"`}},
+			}},
+			"MessageEntity": {Fields: map[string]Field{
+				"type": {Types: []TypeMapping{"str"}, Required: true, Description: Description{PlainText: `Type of the entity. Currently, can be "mention", "hashtag"`}},
+			}},
+		},
+	}}
+	g.enums, g.enumFields = g.getEnums()
+
+	types := g.schema.Types
+	require.Equal(t, "InputTypePhoto", g.fixedValue("type", "InputMediaPhoto", types["InputMediaPhoto"].Fields["type"]))
+	require.Equal(t, "ChatMemberStatusCreator", g.fixedValue("status", "ChatMemberOwner", types["ChatMemberOwner"].Fields["status"]))
+	require.Empty(t, g.fixedValue("type", "MessageEntity", types["MessageEntity"].Fields["type"]))
+}
+
+func TestParam(t *testing.T) {
+	require.Equal(t, "chatID", param("chat_id"))
+	require.Equal(t, "typ", param("type"))
+	require.Equal(t, "range_", param("range"))
+}
+
+func TestHelperName(t *testing.T) {
+	require.Equal(t, "String", helperName("str"))
+	require.Equal(t, "FileID", helperName("InputFile"))
+	require.Equal(t, "InlineKeyboardButtonSliceSlice", helperName("array(array(InlineKeyboardButton))"))
+}
@@ -0,0 +1,62 @@
+package generator
+
+import (
+	"errors"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+// TestGolden renders the templates for the fixture schemas in testdata/golden/*/schema.json
+// and compares the result with the *.golden files near the schema.
+// Run `go test -run TestGolden -update` to accept the changes of the templates.
+func TestGolden(t *testing.T) {
+	fixtures, err := filepath.Glob(filepath.Join("testdata", "golden", "*"))
+	require.NoError(t, err)
+	require.NotEmpty(t, fixtures)
+
+	for _, dir := range fixtures {
+		dir := dir
+		t.Run(filepath.Base(dir), func(t *testing.T) {
+			gen, err := NewGenerator(filepath.Join(dir, "schema.json"), "templates", "mapping.json")
+			require.NoError(t, err)
+
+			files, err := gen.Render()
+			require.NoError(t, err)
+			require.NotEmpty(t, files)
+
+			for name, got := range files {
+				golden := filepath.Join(dir, name+".golden")
+				if *update {
+					require.NoError(t, ioutil.WriteFile(golden, got, 0600))
+					continue
+				}
+				want, err := ioutil.ReadFile(golden)
+				require.NoError(t, err)
+				require.Equal(t, string(want), string(got), "%s is stale, run with -update", golden)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	gen, err := NewGenerator(filepath.Join("testdata", "golden", "basic", "schema.json"), "templates", "mapping.json")
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "generator")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	require.NoError(t, gen.Generate(dir))
+	require.NoError(t, gen.Check(dir))
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "enums.go"), []byte("package tgapi\n"), 0600))
+	err = gen.Check(dir)
+	require.True(t, errors.Is(err, ErrStale), err)
+	require.Contains(t, err.Error(), "+type ChatType string\n")
+}
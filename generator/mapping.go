@@ -0,0 +1,196 @@
+package generator
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+)
+
+// Mapping contains the schema-specific overrides of the generator.
+// It is loaded from the JSON file, so a new schema version can be adapted without changing the code.
+//
+// The fields are referenced by the "type.field" keys, where type is the name of the type or the method.
+// The keys may be patterns, e.g. "*.parse_mode", see path.Match. The exact key is preferred.
+type Mapping struct {
+	// Renames maps the schema type names to the generated type names.
+	Renames map[string]string `json:"renames"`
+	// Unions maps the lists of the field types to a single generated type.
+	Unions []Union `json:"unions"`
+	// SimpleTypes are passed by value in the method arguments and the getters.
+	SimpleTypes []string `json:"simple_types"`
+	// FieldTypes overrides the types of the fields.
+	FieldTypes map[string]TypeMapping `json:"field_types"`
+	// Required overrides the required flags of the fields.
+	Required map[string]bool `json:"required"`
+	// EnumPrefixes overrides the prefix of the enum of the "type" field,
+	// which is the first word of the type name by default.
+	EnumPrefixes map[string]string `json:"enum_prefixes"`
+	// EnumNames overrides the names of the enums of other fields, which are the field names by default.
+	EnumNames map[string]string `json:"enum_names"`
+	// EnumValues are used instead of the values from the schema descriptions.
+	// They are required for the enums which values are not listed in the descriptions.
+	EnumValues map[string][]EnumValue `json:"enum_values"`
+	// SkipTypes and SkipMethods are not generated.
+	SkipTypes   []string `json:"skip_types"`
+	SkipMethods []string `json:"skip_methods"`
+}
+
+// Union is the generated type for the field which accepts several types.
+type Union struct {
+	Types []TypeMapping `json:"types"`
+	Type  TypeMapping   `json:"type"`
+	// Interface is true if the generated type is an interface.
+	Interface bool `json:"interface"`
+}
+
+// LoadMapping reads the mapping from the JSON file. Unknown keys are reported as errors.
+func LoadMapping(mappingFile string) (*Mapping, error) {
+	file, err := os.Open(mappingFile)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var mapping Mapping
+	dec := json.NewDecoder(file)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&mapping); err != nil {
+		return nil, err
+	}
+	return &mapping, nil
+}
+
+// sortedKeys returns the keys of the map with string keys.
+func sortedKeys(m interface{}) []string {
+	keys := reflect.ValueOf(m).MapKeys()
+	res := make([]string, 0, len(keys))
+	for _, key := range keys {
+		res = append(res, key.String())
+	}
+	sort.Strings(res)
+	return res
+}
+
+// matchField returns the key of the field among the keys of the map.
+func matchField(m interface{}, typename, field string) (string, bool) {
+	name := typename + "." + field
+	keys := sortedKeys(m)
+	for _, key := range keys {
+		if key == name {
+			return key, true
+		}
+	}
+	for _, key := range keys {
+		if ok, _ := path.Match(key, name); ok {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+func (m *Mapping) union(types []TypeMapping) (Union, bool) {
+	for _, union := range m.Unions {
+		if reflect.DeepEqual(union.Types, types) {
+			return union, true
+		}
+	}
+	return Union{}, false
+}
+
+func (m *Mapping) isInterface(t TypeMapping) bool {
+	for _, union := range m.Unions {
+		if union.Interface && union.Type == t {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Mapping) isSimple(t TypeMapping) bool {
+	if t.IsSimpleType() || m.isInterface(t) {
+		return true
+	}
+	return contains(m.SimpleTypes, string(t))
+}
+
+func (m *Mapping) enumPrefix(typename string) string {
+	if prefix, ok := m.EnumPrefixes[typename]; ok {
+		return prefix
+	}
+	return strings.Split(strcase.ToDelimited(typename, ' '), " ")[0]
+}
+
+func (m *Mapping) enumName(typename, fieldName string) string {
+	if key, ok := matchField(m.EnumNames, typename, fieldName); ok {
+		return m.EnumNames[key]
+	}
+	return strcase.ToCamel(fieldName)
+}
+
+// renameType renames the type and the types of the array elements.
+func (m *Mapping) renameType(t TypeMapping) TypeMapping {
+	if t.IsArray() {
+		return TypeMapping("array(" + string(m.renameType(t.ArrayType())) + ")")
+	}
+	if name, ok := m.Renames[string(t)]; ok {
+		return TypeMapping(name)
+	}
+	return t
+}
+
+func (m *Mapping) applyFields(typename string, fields map[string]Field) {
+	for fieldName, field := range fields {
+		for idx, t := range field.Types {
+			field.Types[idx] = m.renameType(t)
+		}
+		if key, ok := matchField(m.FieldTypes, typename, fieldName); ok {
+			field.Types = []TypeMapping{m.FieldTypes[key]}
+		}
+		if key, ok := matchField(m.Required, typename, fieldName); ok {
+			field.Required = m.Required[key]
+		}
+		fields[fieldName] = field
+	}
+}
+
+// Apply modifies the schema: removes the skipped types and methods, renames the types
+// and overrides the field types and required flags.
+func (m *Mapping) Apply(schema *APISchema) {
+	for _, name := range m.SkipTypes {
+		delete(schema.Types, name)
+	}
+	for _, name := range m.SkipMethods {
+		delete(schema.Methods, name)
+	}
+
+	for _, typename := range sortedKeys(schema.Types) {
+		typ := schema.Types[typename]
+		m.applyFields(typename, typ.Fields)
+		if name, ok := m.Renames[typename]; ok {
+			delete(schema.Types, typename)
+			schema.Types[name] = typ
+		}
+	}
+	for name, method := range schema.Methods {
+		m.applyFields(name, method.Arguments)
+		if method.Returns != nil {
+			returns := m.renameType(*method.Returns)
+			method.Returns = &returns
+			schema.Methods[name] = method
+		}
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMappingApply(t *testing.T) {
+	returns := TypeMapping("array(Messages)")
+	schema := &APISchema{
+		Types: map[string]Type{
+			"InputFile": {},
+			"OldName": {Fields: map[string]Field{
+				"text": {Types: []TypeMapping{"str"}},
+			}},
+		},
+		Methods: map[string]Method{
+			"sendMessage": {
+				Arguments: map[string]Field{
+					"allowed_updates": {Types: []TypeMapping{"array(str)"}},
+					"media":           {Types: []TypeMapping{"array(array(Media))"}, Required: true},
+				},
+				Returns: &returns,
+			},
+			"internalMethod": {},
+		},
+	}
+	mapping := &Mapping{
+		Renames:     map[string]string{"Messages": "Message", "Media": "InputMedia", "OldName": "NewName"},
+		FieldTypes:  map[string]TypeMapping{"*.allowed_updates": "array(UpdateKind)"},
+		Required:    map[string]bool{"sendMessage.media": false, "*.text": true},
+		SkipTypes:   []string{"InputFile"},
+		SkipMethods: []string{"internalMethod"},
+	}
+	mapping.Apply(schema)
+
+	require.Len(t, schema.Types, 1)
+	require.True(t, schema.Types["NewName"].Fields["text"].Required)
+
+	require.Len(t, schema.Methods, 1)
+	method := schema.Methods["sendMessage"]
+	require.Equal(t, TypeMapping("array(Message)"), *method.Returns)
+	require.Equal(t, []TypeMapping{"array(UpdateKind)"}, method.Arguments["allowed_updates"].Types)
+	require.Equal(t, []TypeMapping{"array(array(InputMedia))"}, method.Arguments["media"].Types)
+	require.False(t, method.Arguments["media"].Required)
+}
+
+func TestMatchField(t *testing.T) {
+	names := map[string]string{
+		"*.parse_mode":           "pattern",
+		"sendMessage.parse_mode": "exact",
+		"ChatMember*.status":     "prefix",
+	}
+	tests := []struct {
+		typename, field string
+		want            string
+	}{
+		{"sendMessage", "parse_mode", "exact"},
+		{"sendPhoto", "parse_mode", "pattern"},
+		{"ChatMemberOwner", "status", "prefix"},
+		{"Chat", "status", ""},
+		{"sendPhoto", "explanation_parse_mode", ""},
+	}
+	for _, tt := range tests {
+		key, ok := matchField(names, tt.typename, tt.field)
+		require.Equal(t, tt.want != "", ok, tt.typename+"."+tt.field)
+		require.Equal(t, tt.want, names[key])
+	}
+}
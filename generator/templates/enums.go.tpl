@@ -1,5 +1,7 @@
 {{.Head}}
 
+// ErrIncorrectEnum is returned by UnmarshalJSON of the enums for the values which are not listed
+// in the API documentation, if the strict mode is enabled with SetStrictEnums.
 type ErrIncorrectEnum struct {
 	Value string
 }
@@ -8,46 +10,46 @@ func (e ErrIncorrectEnum) Error() string {
 	return fmt.Sprintf("incorrect enum value: %s", e.Value)
 }
 {{range $typename, $values := .EnumTypes}}
-type {{$typename}} int
+// {{$typename}} keeps the raw text, so the values unknown for the library are decoded as is.
+type {{$typename}} string
+{{- if $values}}
 
 const (
-	_ {{$typename}} = iota
 {{- range $values}}
-	{{$typename}}{{camel .}}
+	{{$typename}}{{.Name}} {{$typename}} = "{{.Value}}"
 {{- end}}
 )
-
-{{- $valueMap := print "value" $typename}}
-
-{{$indexMap := print "index" $typename -}}
-
-var {{$valueMap}} = map[{{$typename}}]string {
-{{- range $values}}
-	{{$typename}}{{camel .}} : "{{.}}",
-{{- end}}
-}
-
-var {{$indexMap}} = map[string]{{$typename}} {
-{{- range $values}}
-	"{{.}}" : {{$typename}}{{camel .}},
 {{- end}}
-}
 
 func (enum {{$typename}}) String() string {
-	return {{$valueMap}}[enum]
+	return string(enum)
 }
 
-func (enum {{$typename}}) MarshalText() ([]byte, error) {
-	return []byte(enum.String()), nil
+// UnmarshalJSON returns ErrIncorrectEnum for the unknown value in the strict mode.
+func (enum *{{$typename}}) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*enum = {{$typename}}(value)
+	if isStrictEnums() && !enum.IsKnown() {
+		return ErrIncorrectEnum{value}
+	}
+	return nil
 }
 
-func (enum *{{$typename}}) UnmarshalText(src []byte) error {
-	value, ok := {{$indexMap}}[string(src)]
-	if !ok {
-		return ErrIncorrectEnum{string(src)}
+// IsKnown reports whether the value is listed in the API documentation.
+func (enum {{$typename}}) IsKnown() bool {
+{{- if $values}}
+	switch enum {
+	case
+{{- range $idx, $value := $values}}{{if $idx}},{{end}}
+		{{$typename}}{{$value.Name}}
+{{- end}}:
+		return true
 	}
-	*enum = value
-	return nil
+{{- end}}
+	return false
 }
 
 {{- end}}
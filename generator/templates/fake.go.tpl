@@ -0,0 +1,79 @@
+{{.Head}}
+
+// FakeCall is a method call recorded by FakeAPI.
+type FakeCall struct {
+	// Method is the name of the API method, e.g. "sendMessage".
+	Method string
+	// Args are the method arguments without the context.
+	Args []interface{}
+}
+
+// FakeAPI is the BotAPI implementation for tests.
+// Every method records the call and calls the corresponding *Func field, if it is set,
+// otherwise zero values are returned. FakeAPI is safe for concurrent use,
+// but the *Func fields must be set before the first call.
+type FakeAPI struct {
+	// DoFunc is called by Do. The call is recorded with the method of the request.
+	DoFunc func(ctx context.Context, req Request, result interface{}) error
+{{- range $method, $desc := .Methods}}
+	{{- $sig := dict "method" $method "desc" $desc}}
+	{{camel $method}}Func func({{template "params" $sig}}) {{template "results" $sig}}
+{{- end}}
+
+	mu    sync.Mutex
+	calls []FakeCall
+}
+
+var _ BotAPI = (*FakeAPI)(nil)
+
+func (f *FakeAPI) record(method string, args ...interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, FakeCall{Method: method, Args: args})
+}
+
+// Calls returns all recorded calls in the order they were made.
+func (f *FakeAPI) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// CallsOf returns the recorded calls of the API method, e.g. CallsOf("sendMessage").
+func (f *FakeAPI) CallsOf(method string) []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var res []FakeCall
+	for _, call := range f.calls {
+		if call.Method == method {
+			res = append(res, call)
+		}
+	}
+	return res
+}
+
+// Reset forgets the recorded calls.
+func (f *FakeAPI) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = nil
+}
+
+// Do records the call with the method of the request and req as the only argument.
+func (f *FakeAPI) Do(ctx context.Context, req Request, result interface{}) error {
+	f.record(req.Method(), req)
+	if f.DoFunc != nil {
+		return f.DoFunc(ctx, req, result)
+	}
+	return nil
+}
+{{range $method, $desc := .Methods}}
+{{- $sig := dict "method" $method "desc" $desc}}
+func (f *FakeAPI) {{camel $method}}({{template "params" $sig}}) {{template "results" $sig}} {
+	f.record("{{$method}}"{{if $desc.Arguments}}, {{template "args" $sig}}{{end}})
+	if f.{{camel $method}}Func != nil {
+		return f.{{camel $method}}Func(ctx{{if $desc.Arguments}}, {{template "args" $sig}}{{end}})
+	}
+	{{template "zero_return" $sig}}
+}
+{{end}}
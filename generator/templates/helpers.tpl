@@ -0,0 +1,38 @@
+{{- /* The definitions of the method signatures, called with (dict "method" $method "desc" $desc). */ -}}
+
+{{- define "params" -}}
+ctx context.Context
+{{- if gt (len .desc.Arguments) 2}}, args *{{camel .method}}Config
+{{- else}}
+{{- range $argname, $arg := .desc.Arguments}}
+{{- $type := get_type $argname $.method $arg.Types}}, {{lowercamel $argname}} {{if and (not $arg.Required) (not $type.IsArray)}}*{{end}}{{$type.GoType}}
+{{- end}}
+{{- end}}
+{{- end -}}
+
+{{- define "args" -}}
+{{- if gt (len .desc.Arguments) 2}}args
+{{- else}}
+{{- $sep := ""}}
+{{- range $argname, $arg := .desc.Arguments}}{{$sep}}{{lowercamel $argname}}{{$sep = ", "}}{{end}}
+{{- end}}
+{{- end -}}
+
+{{- define "results" -}}
+{{- with .desc.Returns}}
+{{- if eq .GoType "True"}}error
+{{- else}}({{if and (not (is_simple .)) (not .IsArray)}}*{{end}}{{.GoType}}, error)
+{{- end}}
+{{- else}}error
+{{- end}}
+{{- end -}}
+
+{{- define "zero_return" -}}
+{{- with .desc.Returns}}
+{{- if eq .GoType "True"}}return nil
+{{- else}}var res {{if and (not (is_simple .)) (not .IsArray)}}*{{end}}{{.GoType}}
+	return res, nil
+{{- end}}
+{{- else}}return nil
+{{- end}}
+{{- end -}}
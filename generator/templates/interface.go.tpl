@@ -0,0 +1,16 @@
+{{.Head}}
+
+// BotAPI contains all methods of the Bot API.
+// It is implemented by *API and by FakeAPI, so the handlers depending on it can be tested without HTTP.
+type BotAPI interface {
+	// Do calls the API method of the request and decodes the result into result, if it is not nil.
+	Do(ctx context.Context, req Request, result interface{}) error
+{{- range $method, $desc := .Methods}}
+	{{- $sig := dict "method" $method "desc" $desc}}
+	// {{camel $method}}
+	// {{format $desc.Description.PlainText 1}}
+	{{camel $method}}({{template "params" $sig}}) {{template "results" $sig}}
+{{- end}}
+}
+
+var _ BotAPI = (*API)(nil)
@@ -11,26 +11,105 @@ type {{camel $method}}Config struct {
 	{{- if eq $arg.Required $put_required}}
 	// {{camel $argname}}
 	// {{format $arg.Description.PlainText 1}}
-	{{camel $argname}} {{if and (not $type.IsSimpleType) (not $arg.Required) (not $type.IsArray) -}}*{{end -}}
+	{{camel $argname}} {{if and (not (is_simple $type)) (not (is_enum $type)) (not $arg.Required) (not $type.IsArray) -}}*{{end -}}
 		{{- $type.GoType}} `json:"{{$argname}}{{if not $arg.Required}},omitempty{{end}}"`
 	{{- end}}
 {{- end}}
 {{- end}}
 }
+
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *{{camel $method}}Config) Validate() error {
+{{- with constraints $desc.Arguments}}
+	return firstError(
+	{{- range .}}
+		{{.Func}}({{.When}}, "{{.Name}}", {{.Value}}{{range .Args}}, {{.}}{{end}}),
+	{{- end}}
+	)
+{{- else}}
+	return nil
+{{- end}}
+}
+
+// Method returns the name of the API method.
+func (t *{{camel $method}}Config) Method() string {
+	return "{{$method}}"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *{{camel $method}}Config) Files() map[string]*InputFile {
+{{- $has_files := false}}
+{{- range $argname, $arg := $desc.Arguments}}
+	{{- if eq (get_type $argname $method $arg.Types).GoType "InputFile"}}{{$has_files = true}}{{end}}
+{{- end}}
+{{- if $has_files}}
+	files := make(map[string]*InputFile)
+{{- range $argname, $arg := $desc.Arguments}}
+	{{- if eq (get_type $argname $method $arg.Types).GoType "InputFile"}}
+		{{- if $arg.Required}}
+	files["{{$argname}}"] = &t.{{camel $argname}}
+		{{- else}}
+	if t.{{camel $argname}} != nil {
+		files["{{$argname}}"] = t.{{camel $argname}}
+	}
+		{{- end}}
+	{{- end}}
+{{- end}}
+	return files
+{{- else}}
+	return nil
+{{- end}}
+}
+
+// New{{camel $method}}Config creates {{camel $method}}Config with the required arguments.
+func New{{camel $method}}Config(
+{{- $sep := ""}}
+{{- range $argname, $arg := $desc.Arguments}}
+	{{- if and $arg.Required (not (fixed_value $argname $method $arg))}}
+	{{- $sep}}{{param $argname}} {{(get_type $argname $method $arg.Types).GoType}}{{$sep = ", "}}
+	{{- end}}
+{{- end}}) *{{camel $method}}Config {
+	return &{{camel $method}}Config{
+{{- range $argname, $arg := $desc.Arguments}}
+	{{- if $arg.Required}}
+		{{camel $argname}}: {{or (fixed_value $argname $method $arg) (param $argname)}},
+	{{- end}}
+{{- end}}
+	}
+}
+{{- range $argname, $arg := $desc.Arguments}}
+{{- if not $arg.Required}}
+{{- $type := get_type $argname $method $arg.Types}}
+
+func (t *{{camel $method}}Config) Set{{camel $argname}}({{param $argname}} {{$type.GoType}}) *{{camel $method}}Config {
+	t.{{camel $argname}} = {{if and (not (is_simple $type)) (not (is_enum $type)) (not $type.IsArray)}}&{{end}}{{param $argname}}
+	return t
+}
+{{- end}}
+{{- end}}
 {{- end}}
 
 {{- if is_sendable $desc.Arguments}}
+
 func (t {{camel $method}}Config) EncodeURL() (url.Values, error) {
 	res := make(url.Values)
 {{- range $argname, $arg := $desc.Arguments}}
 	{{- $type := (get_type $argname $method $arg.Types)}}
 
 	{{- if not (eq $type.GoType "InputFile")}}
-		{{- if and $type.IsSimpleType (not (is_interface $type)) }}
+		{{- if is_enum $type}}
+			{{- if $arg.Required}}
+	res.Add("{{$argname}}", t.{{camel $argname}}.String())
+			{{- else}}
+	if t.{{camel $argname}} != "" {
+		res.Add("{{$argname}}", t.{{camel $argname}}.String())
+	}
+			{{- end}}
+		{{- else if and (is_simple $type) (not (is_interface $type)) }}
 	res.Add("{{$argname}}", {{format_url (print "t." (camel $argname)) false $type}})
 		{{- else}}
 	if t.{{camel $argname}} != nil {
-			{{- if or (is_interface $type) (not $type.IsSimpleType) }}
+			{{- if or (is_interface $type) (not (is_simple $type)) }}
 	raw, err := json.Marshal({{print "t." (camel $argname)}})
 	if err != nil {
 		return nil, err
@@ -79,30 +158,31 @@ func (api *API) {{camel $method}}(
 {{- $return_stared := false}}
 {{- with $desc.Returns}}
 	{{- if (not (eq .GoType "True"))}}
-		{{- if and (not .IsSimpleType) (not .IsArray)}}*{{$return_stared = true}}{{end}}
+		{{- if and (not (is_simple .)) (not .IsArray)}}*{{$return_stared = true}}{{end}}
 	{{- .GoType}},
 		{{- $returns = true}}
 	{{- end}}
 {{- end -}}
 error) {
-{{- if not (empty $input_file)}}
 {{- if gt (len $desc.Arguments) 2}}
-	{{- $input_file = print "args." (camel $input_file)}}
+	{{- if $returns}}
+	var res {{$desc.Returns.GoType}}
+	if err := api.Do(ctx, args, &res); err != nil {
+		return {{default_return $desc.Returns}}, err
+	}
+	return {{if $return_stared}}&{{end}}res, nil
+	{{- else}}
+	return api.Do(ctx, args, nil)
+	{{- end}}
 {{- else}}
+{{- if not (empty $input_file)}}
+	{{- $field := $input_file}}
 	{{- $input_file = lowercamel $input_file}}
-{{- end}}
 	if {{$input_file}}.Reader != nil {
-		{{- if gt (len $desc.Arguments) 2}}
-		values, err := args.EncodeURL()
-		if err != nil {
-			return {{if $returns}}nil,{{end}} err
-		}
-		{{- else}}
 		values := url.Values{
 			"{{$second}}" : []string{ {{- format_url (lowercamel $second) false (get_type $second $method (index $desc.Arguments $second).Types) -}} },
 		}
-		{{- end}}
-		{{if $returns}}resp{{else}}_{{end}}, err := api.UploadFile(ctx, values, "{{$method}}","{{input_type $method}}", &{{$input_file}})
+		{{if $returns}}resp{{else}}_{{end}}, err := api.UploadFile(ctx, values, "{{$method}}","{{$field}}", &{{$input_file}})
 		{{- if not $returns}}
 		return err
 		{{- else}}
@@ -116,8 +196,6 @@ error) {
 		{{- end}}
 	}
 {{- end}}
-
-{{- if not (gt (len $desc.Arguments) 2)}}
 	{{- if gt (len $desc.Arguments) 0}}
 	args := map[string]interface{} {
 		{{- range $argname, $arg := $desc.Arguments}}
@@ -125,7 +203,6 @@ error) {
 		{{- end}}
 	}
 	{{- end}}
-{{- end}}
 	{{if $returns}}resp{{else}}_{{end}}, err := api.MakeRequest(ctx, "{{$method}}", {{if eq (len $desc.Arguments) 0}}nil{{else}}args{{end}})
 	{{- if not $returns}}
 	return err
@@ -137,5 +214,6 @@ error) {
 	err = json.Unmarshal(resp.Result, &data)
 	return {{if $return_stared}}&{{end}}data, err
 	{{- end}}
+{{- end}}
 }
 {{end}}
\ No newline at end of file
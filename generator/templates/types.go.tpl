@@ -3,7 +3,7 @@
 const Version = "{{.Version}}"
 
 // TODO: category description
-{{range $typename, $desc := .Types}} {{- if not (skip $typename)}}
+{{range $typename, $desc := .Types}}
 
 // {{camel $typename}}
 // {{format $desc.Description.PlainText 0}}
@@ -24,11 +24,49 @@ type {{camel $typename}} struct {
 {{- end}}
 }
 
+{{- $has_required := false}}
+{{- range $field_name, $field_desc := $desc.Fields}}
+	{{- if $field_desc.Required}}{{$has_required = true}}{{end}}
+{{- end}}
+{{- if $has_required}}
+
+// New{{camel $typename}} creates {{camel $typename}} with the required fields.
+func New{{camel $typename}}(
+{{- $sep := ""}}
+{{- range $field_name, $field_desc := $desc.Fields}}
+	{{- if and $field_desc.Required (not (fixed_value $field_name $typename $field_desc))}}
+	{{- $ttype := (get_type $field_name $typename $field_desc.Types).GoType}}
+	{{- if eq $ttype "InputFile"}}{{$ttype = "FileID"}}{{end}}
+	{{- $sep}}{{param $field_name}} {{$ttype}}{{$sep = ", "}}
+	{{- end}}
+{{- end}}) *{{camel $typename}} {
+	return &{{camel $typename}}{
+{{- range $field_name, $field_desc := $desc.Fields}}
+	{{- if $field_desc.Required}}
+		{{camel $field_name}}: {{or (fixed_value $field_name $typename $field_desc) (param $field_name)}},
+	{{- end}}
+{{- end}}
+	}
+}
+{{- range $field_name, $field_desc := $desc.Fields}}
+{{- if not $field_desc.Required}}
+{{- $type := get_type $field_name $typename $field_desc.Types}}
+{{- $ttype := $type.GoType}}
+{{- if eq $ttype "InputFile"}}{{$ttype = "FileID"}}{{end}}
+
+func (t *{{camel $typename}}) Set{{camel $field_name}}({{param $field_name}} {{$ttype}}) *{{camel $typename}} {
+	t.{{camel $field_name}} = {{if not $type.IsArray}}&{{end}}{{param $field_name}}
+	return t
+}
+{{- end}}
+{{- end}}
+{{- end}}
+
 {{range $field_name, $field_desc := $desc.Fields}}
 {{- $type := get_type $field_name $typename $field_desc.Types }}
 {{- if not $type.IsArray}}
 {{- $required := $field_desc.Required}}
-{{- $simple := $type.IsSimpleType}}
+{{- $simple := (is_simple $type)}}
 {{- $ttype := $type.GoType}}
 {{- if eq $ttype "InputFile"}}
 {{- $ttype = "FileID"}}
@@ -57,4 +95,78 @@ func (t *{{camel $typename}}) Get{{camel $field_name}}() {{if not $simple}}*{{en
 {{- end}}
 {{- end}}
 
-{{end}}{{end}}
\ No newline at end of file
+// Clone returns a deep copy of t.
+func (t *{{camel $typename}}) Clone() *{{camel $typename}} {
+	if t == nil {
+		return nil
+	}
+	res := *t
+{{- range $field_name, $field_desc := $desc.Fields}}
+	{{- with clone_field $field_name (get_type $field_name $typename $field_desc.Types) $field_desc.Required}}
+	{{.}}
+	{{- end}}
+{{- end}}
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *{{camel $typename}}) Equal(other *{{camel $typename}}) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return {{if not $desc.Fields}}true{{end}}
+{{- $sep := ""}}
+{{- range $field_name, $field_desc := $desc.Fields}}
+	{{- $sep}}{{equal_field $field_name (get_type $field_name $typename $field_desc.Types) $field_desc.Required}}
+	{{- $sep = " &&\n\t\t"}}
+{{- end}}
+}
+
+{{end}}
+{{- range slice_types}}
+{{- $elem := .ArrayType}}
+
+func clone{{helper_name .}}(s {{field_type .}}) {{field_type .}} {
+	if s == nil {
+		return nil
+	}
+	res := make({{field_type .}}, len(s))
+{{- if is_plain $elem}}
+	copy(res, s)
+{{- else}}
+	for i := range s {
+		res[i] = {{clone_expr "s[i]" $elem}}
+	}
+{{- end}}
+	return res
+}
+
+func equal{{helper_name .}}(a, b {{field_type .}}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if {{if is_plain $elem}}a[i] != b[i]{{else}}!{{equal_expr "a[i]" "b[i]" $elem}}{{end}} {
+			return false
+		}
+	}
+	return true
+}
+{{- end}}
+{{- range pointer_types}}
+
+func clone{{helper_name .}}Ptr(p *{{field_type .}}) *{{field_type .}} {
+	if p == nil {
+		return nil
+	}
+	v := {{clone_expr "*p" .}}
+	return &v
+}
+
+func equal{{helper_name .}}Ptr(a, b *{{field_type .}}) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return {{equal_expr "*a" "*b" .}}
+}
+{{- end}}
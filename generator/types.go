@@ -19,24 +19,18 @@ func (t TypeMapping) GoType() string {
 		return "int64"
 	case "Float", "float":
 		return "float64"
-	case "InputMediaAudio, InputMediaDocument, InputMediaPhoto and InputMediaVideo":
-		return "InputMediaGraphics"
-	// retarded parsing
-	case "Messages":
-		return " Message"
 	}
 	return rename(string(t))
 }
 
+// IsSimpleType reports whether the type is a builtin type passed by value.
+// The generated types passed by value are listed in the Mapping.
 func (t TypeMapping) IsSimpleType() bool {
 	switch t {
-	case "str", "String", "int", "bool", "IntStr":
+	case "str", "String", "int", "bool":
 		return true
-	case "CaptionEntities":
-		return false
-	default:
-		return isInterface(t)
 	}
+	return false
 }
 
 func (t TypeMapping) IsArray() bool { return strings.HasPrefix(string(t), "array(") }
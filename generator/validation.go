@@ -0,0 +1,192 @@
+package generator
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+)
+
+// Constraint is a rule for an argument extracted from its description.
+// It is rendered by the methods.go template as a call of the validate* function from tgapi/validate.go.
+type Constraint struct {
+	// Func is the name of the validation function.
+	Func string
+	// When is the Go condition in which the constraint is checked.
+	When string
+	// Args are the rest of the function arguments after the field name and value.
+	Args []string
+}
+
+var (
+	charactersRe     = regexp.MustCompile(`(\d+)-(\d+) characters`)
+	eachCharactersRe = regexp.MustCompile(`(\d+)-(\d+) characters each`)
+	bytesRe          = regexp.MustCompile(`(\d+)-(\d+) bytes`)
+	maxBytesRe       = regexp.MustCompile(`can't exceed (\d+) bytes`)
+	countRe          = regexp.MustCompile(`(?:include|list of|,) (\d+)-(\d+) (?:items|initial|strings)`)
+	maxCountRe       = regexp.MustCompile(`At most (\d+)`)
+	betweenRe        = regexp.MustCompile(`between (\d+)(?: and |-)(\d+)`)
+	rangeRe          = regexp.MustCompile(`[;,] (\d+)-(\d+)(?:[.,;]|$)`)
+	requiredIfNotRe  = regexp.MustCompile(`Required if (\w+)(?: and (\w+))? (?:is|are) not specified`)
+	requiredIfBoolRe = regexp.MustCompile(`Required if (\w+) is (True|False)`)
+)
+
+const (
+	afterEntitiesParsing = "after entities parsing"
+	parseModeArg         = "parse_mode"
+)
+
+func fieldRef(argname string) string {
+	return "t." + rename(strcase.ToCamel(argname))
+}
+
+func joinConditions(conds ...string) string {
+	var res []string
+	for _, cond := range conds {
+		if cond != "" {
+			res = append(res, cond)
+		}
+	}
+	if len(res) == 0 {
+		return "true"
+	}
+	return strings.Join(res, " && ")
+}
+
+// constraints extracts the rules for the argument of the method.
+func constraints(argname string, arg Field, args map[string]Field) []Constraint {
+	if len(arg.Types) != 1 {
+		return requiredIf(arg.Description.PlainText, args)
+	}
+	var (
+		text    = arg.Description.PlainText
+		typ     = arg.Types[0]
+		res     []Constraint
+		present string
+	)
+	if !arg.Required {
+		present = fmt.Sprintf("!isZero(%s)", fieldRef(argname))
+	}
+
+	switch {
+	case typ.GoType() == "string":
+		if match := charactersRe.FindStringSubmatch(text); match != nil {
+			var plain string
+			if parseMode := parseModeOf(argname, args); parseMode != "" && strings.Contains(text, afterEntitiesParsing) {
+				// the length of the formatted text is unknown before parsing.
+				plain = fmt.Sprintf("isZero(%s)", fieldRef(parseMode))
+			}
+			res = append(res, Constraint{
+				Func: "validateLength",
+				When: joinConditions(present, plain),
+				Args: match[1:],
+			})
+		}
+		if match := bytesRe.FindStringSubmatch(text); match != nil {
+			res = append(res, Constraint{Func: "validateBytes", When: joinConditions(present), Args: match[1:]})
+		} else if match := maxBytesRe.FindStringSubmatch(text); match != nil {
+			res = append(res, Constraint{
+				Func: "validateBytes",
+				When: joinConditions(present),
+				Args: []string{"0", match[1]},
+			})
+		}
+	case typ.IsArray():
+		if match := countRe.FindStringSubmatch(text); match != nil {
+			res = append(res, Constraint{Func: "validateCount", When: joinConditions(present), Args: match[1:]})
+		} else if match := maxCountRe.FindStringSubmatch(text); match != nil {
+			res = append(res, Constraint{
+				Func: "validateCount",
+				When: joinConditions(present),
+				Args: []string{"0", match[1]},
+			})
+		}
+		if match := eachCharactersRe.FindStringSubmatch(text); match != nil && typ.ArrayType().GoType() == "string" {
+			res = append(res, Constraint{Func: "validateEachLength", When: joinConditions(present), Args: match[1:]})
+		}
+	case typ.GoType() == "int64" || typ.GoType() == "float64":
+		match := betweenRe.FindStringSubmatch(text)
+		if match == nil {
+			match = rangeRe.FindStringSubmatch(text)
+		}
+		if match != nil {
+			res = append(res, Constraint{Func: "validateRange", When: joinConditions(present), Args: match[1:]})
+		}
+	}
+
+	return append(res, requiredIf(text, args)...)
+}
+
+// parseModeOf returns the name of the parse mode argument for the text argument.
+func parseModeOf(argname string, args map[string]Field) string {
+	for _, name := range []string{argname + "_" + parseModeArg, parseModeArg} {
+		if _, ok := args[name]; ok {
+			return name
+		}
+	}
+	return ""
+}
+
+// requiredIf extracts the "Required if ..." rules of the optional arguments.
+func requiredIf(text string, args map[string]Field) []Constraint {
+	if match := requiredIfNotRe.FindStringSubmatch(text); match != nil {
+		var conds []string
+		for _, other := range match[1:] {
+			if other == "" {
+				continue
+			}
+			if _, ok := args[other]; !ok {
+				return nil
+			}
+			conds = append(conds, fmt.Sprintf("isZero(%s)", fieldRef(other)))
+		}
+		return []Constraint{{
+			Func: "validateRequired",
+			When: joinConditions(conds...),
+			Args: []string{fmt.Sprintf("%q", strings.ToLower(match[0]))},
+		}}
+	}
+
+	if match := requiredIfBoolRe.FindStringSubmatch(text); match != nil {
+		other, ok := args[match[1]]
+		if !ok || len(other.Types) != 1 || other.Types[0].GoType() != "bool" {
+			return nil
+		}
+		cond := fieldRef(match[1])
+		if match[2] == "False" {
+			cond = "!" + cond
+		}
+		return []Constraint{{
+			Func: "validateRequired",
+			When: cond,
+			Args: []string{fmt.Sprintf("%q", strings.ToLower(match[0]))},
+		}}
+	}
+	return nil
+}
+
+// ArgConstraint is a constraint bound to the argument.
+type ArgConstraint struct {
+	Name  string
+	Value string
+	Constraint
+}
+
+// methodConstraints returns constraints of all arguments sorted by the argument name.
+func methodConstraints(args map[string]Field) []ArgConstraint {
+	names := make([]string, 0, len(args))
+	for name := range args {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var res []ArgConstraint
+	for _, name := range names {
+		for _, c := range constraints(name, args[name], args) {
+			res = append(res, ArgConstraint{Name: name, Value: fieldRef(name), Constraint: c})
+		}
+	}
+	return res
+}
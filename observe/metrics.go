@@ -0,0 +1,256 @@
+package observe
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/Feresey/tgbotapi/tgapi"
+)
+
+// DefaultBuckets are the upper bounds of the duration histograms in seconds.
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30}
+
+const (
+	typeCounter   = "counter"
+	typeGauge     = "gauge"
+	typeHistogram = "histogram"
+)
+
+type series struct {
+	labels []string
+	value  float64
+	// histogram only. Buckets are cumulative.
+	buckets []uint64
+	sum     float64
+	count   uint64
+}
+
+type family struct {
+	name   string
+	help   string
+	typ    string
+	labels []string
+	series map[string]*series
+}
+
+func newFamily(name, typ, help string, labels ...string) *family {
+	return &family{
+		name:   name,
+		help:   help,
+		typ:    typ,
+		labels: labels,
+		series: make(map[string]*series),
+	}
+}
+
+func (f *family) get(buckets int, values ...string) *series {
+	key := strings.Join(values, "\xff")
+	s, ok := f.series[key]
+	if !ok {
+		s = &series{labels: values}
+		if f.typ == typeHistogram {
+			s.buckets = make([]uint64, buckets)
+		}
+		f.series[key] = s
+	}
+	return s
+}
+
+// Registry collects metrics of the API calls and handled updates.
+// It renders them in the Prometheus text format, so it can be scraped as an http.Handler.
+type Registry struct {
+	mu      sync.Mutex
+	buckets []float64
+
+	requests         *family
+	requestRetries   *family
+	requestDuration  *family
+	requestsInFlight *family
+	updates          *family
+	updatePanics     *family
+	updateDuration   *family
+	updatesInFlight  *family
+}
+
+var (
+	_ tgapi.Observer = (*Registry)(nil)
+	_ http.Handler   = (*Registry)(nil)
+)
+
+// NewRegistry creates a registry with the given histogram buckets in seconds.
+// DefaultBuckets are used if none given.
+func NewRegistry(buckets ...float64) *Registry {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+
+	r := &Registry{
+		buckets: buckets,
+		requests: newFamily("tgapi_requests_total", typeCounter,
+			"Total number of API method calls.", "method", "code"),
+		requestRetries: newFamily("tgapi_request_retries_total", typeCounter,
+			"Total number of API method calls retrying a failed call.", "method"),
+		requestDuration: newFamily("tgapi_request_duration_seconds", typeHistogram,
+			"Duration of API method calls.", "method"),
+		requestsInFlight: newFamily("tgapi_requests_in_flight", typeGauge,
+			"Number of API method calls in progress."),
+		updates: newFamily("tgapi_updates_total", typeCounter,
+			"Total number of handled updates.", "kind", "handler"),
+		updatePanics: newFamily("tgapi_update_panics_total", typeCounter,
+			"Total number of handler panics.", "kind", "handler"),
+		updateDuration: newFamily("tgapi_update_duration_seconds", typeHistogram,
+			"Duration of update handling.", "kind", "handler"),
+		updatesInFlight: newFamily("tgapi_updates_in_flight", typeGauge,
+			"Number of updates being handled."),
+	}
+	// gauges are always exposed.
+	r.requestsInFlight.get(0)
+	r.updatesInFlight.get(0)
+	return r
+}
+
+func (r *Registry) observe(s *series, seconds float64) {
+	for idx, le := range r.buckets {
+		if seconds <= le {
+			s.buckets[idx]++
+		}
+	}
+	s.sum += seconds
+	s.count++
+}
+
+// code returns the label for the result of API call.
+func code(stats tgapi.RequestStats) string {
+	switch {
+	case stats.Err == nil:
+		return "ok"
+	case stats.ErrorCode != 0:
+		return strconv.Itoa(stats.ErrorCode)
+	default:
+		return "error"
+	}
+}
+
+func (r *Registry) ObserveRequest(ctx context.Context, method string) (context.Context, func(tgapi.RequestStats)) {
+	r.mu.Lock()
+	r.requestsInFlight.get(0).value++
+	r.mu.Unlock()
+
+	return ctx, func(stats tgapi.RequestStats) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		r.requestsInFlight.get(0).value--
+		r.requests.get(0, method, code(stats)).value++
+		if stats.Retries > 0 {
+			r.requestRetries.get(0, method).value++
+		}
+		r.observe(r.requestDuration.get(len(r.buckets), method), stats.Duration.Seconds())
+	}
+}
+
+func (r *Registry) ObserveUpdate(ctx context.Context, _ *tgapi.Update) (context.Context, func(tgapi.UpdateStats)) {
+	r.mu.Lock()
+	r.updatesInFlight.get(0).value++
+	r.mu.Unlock()
+
+	return ctx, func(stats tgapi.UpdateStats) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		kind := stats.Kind.String()
+		r.updatesInFlight.get(0).value--
+		r.updates.get(0, kind, stats.Handler).value++
+		if stats.Panic != nil {
+			r.updatePanics.get(0, kind, stats.Handler).value++
+		}
+		r.observe(r.updateDuration.get(len(r.buckets), kind, stats.Handler), stats.Duration.Seconds())
+	}
+}
+
+// ServeHTTP renders the metrics in the Prometheus text format.
+func (r *Registry) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	if err := r.Write(w); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// Write renders the metrics in the Prometheus text format.
+func (r *Registry) Write(w io.Writer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	buf := bufio.NewWriter(w)
+	for _, f := range []*family{
+		r.requests,
+		r.requestRetries,
+		r.requestDuration,
+		r.requestsInFlight,
+		r.updates,
+		r.updatePanics,
+		r.updateDuration,
+		r.updatesInFlight,
+	} {
+		r.writeFamily(buf, f)
+	}
+	return buf.Flush()
+}
+
+func (r *Registry) writeFamily(w *bufio.Writer, f *family) {
+	fmt.Fprintf(w, "# HELP %s %s\n", f.name, f.help)
+	fmt.Fprintf(w, "# TYPE %s %s\n", f.name, f.typ)
+
+	keys := make([]string, 0, len(f.series))
+	for key := range f.series {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		s := f.series[key]
+		labels := formatLabels(f.labels, s.labels)
+		if f.typ != typeHistogram {
+			fmt.Fprintf(w, "%s%s %s\n", f.name, labels, formatFloat(s.value))
+			continue
+		}
+		for idx, le := range r.buckets {
+			bucketLabels := formatLabels(
+				append(f.labels[:len(f.labels):len(f.labels)], "le"),
+				append(s.labels[:len(s.labels):len(s.labels)], formatFloat(le)))
+			fmt.Fprintf(w, "%s_bucket%s %d\n", f.name, bucketLabels, s.buckets[idx])
+		}
+		infLabels := formatLabels(
+			append(f.labels[:len(f.labels):len(f.labels)], "le"),
+			append(s.labels[:len(s.labels):len(s.labels)], "+Inf"))
+		fmt.Fprintf(w, "%s_bucket%s %d\n", f.name, infLabels, s.count)
+		fmt.Fprintf(w, "%s_sum%s %s\n", f.name, labels, formatFloat(s.sum))
+		fmt.Fprintf(w, "%s_count%s %d\n", f.name, labels, s.count)
+	}
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+
+func formatLabels(names, values []string) string {
+	if len(names) == 0 {
+		return ""
+	}
+	pairs := make([]string, len(names))
+	for idx, name := range names {
+		pairs[idx] = name + `="` + labelEscaper.Replace(values[idx]) + `"`
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
@@ -0,0 +1,46 @@
+// Package observe contains implementations of tgapi.Observer:
+// the in-process metrics Registry with the Prometheus text exposition and the Tracer.
+//
+//	registry := observe.NewRegistry()
+//	tracer := observe.NewTracer(exportSpan)
+//	api := tgapi.New(token, tgapi.APIObserver(observe.Multi(registry, tracer)))
+//	http.Handle("/metrics", registry)
+package observe
+
+import (
+	"context"
+
+	"github.com/Feresey/tgbotapi/tgapi"
+)
+
+type multi []tgapi.Observer
+
+// Multi combines several observers into one.
+// The contexts are chained in the given order, the callbacks are called in the reverse order.
+func Multi(observers ...tgapi.Observer) tgapi.Observer {
+	return multi(observers)
+}
+
+func (m multi) ObserveRequest(ctx context.Context, method string) (context.Context, func(tgapi.RequestStats)) {
+	dones := make([]func(tgapi.RequestStats), len(m))
+	for idx, observer := range m {
+		ctx, dones[idx] = observer.ObserveRequest(ctx, method)
+	}
+	return ctx, func(stats tgapi.RequestStats) {
+		for idx := len(dones) - 1; idx >= 0; idx-- {
+			dones[idx](stats)
+		}
+	}
+}
+
+func (m multi) ObserveUpdate(ctx context.Context, update *tgapi.Update) (context.Context, func(tgapi.UpdateStats)) {
+	dones := make([]func(tgapi.UpdateStats), len(m))
+	for idx, observer := range m {
+		ctx, dones[idx] = observer.ObserveUpdate(ctx, update)
+	}
+	return ctx, func(stats tgapi.UpdateStats) {
+		for idx := len(dones) - 1; idx >= 0; idx-- {
+			dones[idx](stats)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package observe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Feresey/tgbotapi/tgapi"
+)
+
+func newServer(t *testing.T) *httptest.Server {
+	var updates int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/getMe"):
+			fmt.Fprint(w, `{"ok":true,"result":{"id":1,"is_bot":true,"first_name":"bot"}}`)
+		case strings.HasSuffix(r.URL.Path, "/sendMessage"):
+			fmt.Fprint(w, `{"ok":false,"error_code":403,"description":"Forbidden: bot was blocked by the user"}`)
+		case strings.HasSuffix(r.URL.Path, "/getUpdates"):
+			if atomic.AddInt32(&updates, 1) == 1 {
+				fmt.Fprint(w, `{"ok":true,"result":[{"update_id":1,"message":{"message_id":1,"date":0,`+
+					`"chat":{"id":1,"type":"private"},"text":"hi"}}]}`)
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+			fmt.Fprint(w, `{"ok":true,"result":[]}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestObserve(t *testing.T) {
+	server := newServer(t)
+
+	var (
+		mu    sync.Mutex
+		spans []*Span
+	)
+	tracer := NewTracer(func(span *Span) {
+		mu.Lock()
+		defer mu.Unlock()
+		spans = append(spans, span)
+	})
+	registry := NewRegistry(0.5, 1)
+
+	api := tgapi.NewWithEndpointAndClient("token", server.URL, server.URL, server.Client(),
+		tgapi.APIObserver(Multi(registry, tracer)),
+	)
+
+	ctx := context.Background()
+	_, err := api.GetMe(ctx)
+	require.NoError(t, err)
+
+	var sendErr error
+	handled := make(chan struct{})
+	tree := tgapi.NewCallTree(nil)
+	tree.NewChild(nil, func(ctx context.Context, _ *tgapi.Update) {
+		defer close(handled)
+		// the retry of a failed call, e.g. by the broadcaster.
+		_, sendErr = api.SendMessage(tgapi.WithRetries(ctx, 1), &tgapi.SendMessageConfig{ChatID: tgapi.NewInt(1), Text: "hi"})
+	}).Name("greet")
+
+	poller := tgapi.NewPoller(api, tree)
+	go poller.Listen(&tgapi.GetUpdatesConfig{})
+	<-handled
+	require.NoError(t, poller.Shutdown(ctx))
+	require.True(t, errors.Is(sendErr, tgapi.ErrForbidden))
+
+	resp := httptest.NewRecorder()
+	registry.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	raw, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	metrics := string(raw)
+
+	for _, line := range []string{
+		`tgapi_requests_total{method="getMe",code="ok"} 1`,
+		`tgapi_requests_total{method="sendMessage",code="403"} 1`,
+		`tgapi_request_retries_total{method="sendMessage"} 1`,
+		`tgapi_request_duration_seconds_count{method="getMe"} 1`,
+		`tgapi_updates_total{kind="message",handler="greet"} 1`,
+		`tgapi_update_duration_seconds_bucket{kind="message",handler="greet",le="+Inf"} 1`,
+		`tgapi_updates_in_flight 0`,
+		`# TYPE tgapi_update_duration_seconds histogram`,
+	} {
+		require.Contains(t, metrics, line)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	byName := make(map[string]*Span)
+	for _, span := range spans {
+		byName[span.Name] = span
+	}
+	update, send := byName["tgapi.update"], byName["tgapi.sendMessage"]
+	require.NotNil(t, update)
+	require.NotNil(t, send)
+	require.Equal(t, update.TraceID, send.TraceID)
+	require.Equal(t, update.SpanID, send.ParentID)
+	require.Equal(t, "greet", update.Attributes()["handler"])
+	require.Equal(t, "403", send.Attributes()["error_code"])
+	require.Equal(t, "1", send.Attributes()["retries"])
+	require.Equal(t, "0", byName["tgapi.getMe"].Attributes()["retries"])
+	require.Error(t, send.Err)
+}
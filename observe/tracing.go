@@ -0,0 +1,144 @@
+package observe
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Feresey/tgbotapi/tgapi"
+)
+
+// Span is a timed operation: handling of an update, an API call or a custom user operation.
+type Span struct {
+	TraceID  string
+	SpanID   string
+	ParentID string
+	Name     string
+	Start    time.Time
+	Duration time.Duration
+	Err      error
+
+	mu         sync.Mutex
+	attributes map[string]string
+	tracer     *Tracer
+	ended      bool
+}
+
+// SetAttribute attaches the key-value pair to the span.
+func (s *Span) SetAttribute(key, value string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attributes[key] = value
+}
+
+// Attributes returns a copy of the span attributes.
+func (s *Span) Attributes() map[string]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := make(map[string]string, len(s.attributes))
+	for key, value := range s.attributes {
+		res[key] = value
+	}
+	return res
+}
+
+// End finishes the span and passes it to the exporter. Subsequent calls do nothing.
+func (s *Span) End(err error) {
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+	s.Duration = time.Since(s.Start)
+	s.Err = err
+	s.mu.Unlock()
+
+	s.tracer.export(s)
+}
+
+type spanKey struct{}
+
+// SpanFromContext returns the current span or nil.
+func SpanFromContext(ctx context.Context) *Span {
+	span, _ := ctx.Value(spanKey{}).(*Span)
+	return span
+}
+
+// Tracer creates spans for the handled updates and the API calls.
+// The span of an update is stored in the handler context,
+// so the API calls made with this context become its children.
+type Tracer struct {
+	export func(*Span)
+}
+
+var _ tgapi.Observer = (*Tracer)(nil)
+
+// NewTracer creates a tracer which passes every finished span to export.
+// export must be safe for concurrent use.
+func NewTracer(export func(*Span)) *Tracer {
+	return &Tracer{export: export}
+}
+
+func newID(size int) string {
+	id := make([]byte, size)
+	if _, err := rand.Read(id); err != nil {
+		// should never happen.
+		return strconv.FormatInt(time.Now().UnixNano(), 16)
+	}
+	return hex.EncodeToString(id)
+}
+
+const (
+	traceIDSize = 16
+	spanIDSize  = 8
+)
+
+// Start creates a span which is a child of the span in ctx, if any.
+// The returned context contains the new span.
+func (t *Tracer) Start(ctx context.Context, name string) (context.Context, *Span) {
+	span := &Span{
+		SpanID:     newID(spanIDSize),
+		Name:       name,
+		Start:      time.Now(),
+		attributes: make(map[string]string),
+		tracer:     t,
+	}
+	if parent := SpanFromContext(ctx); parent != nil {
+		span.TraceID = parent.TraceID
+		span.ParentID = parent.SpanID
+	} else {
+		span.TraceID = newID(traceIDSize)
+	}
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+func (t *Tracer) ObserveRequest(ctx context.Context, method string) (context.Context, func(tgapi.RequestStats)) {
+	ctx, span := t.Start(ctx, "tgapi."+method)
+	span.SetAttribute("method", method)
+	return ctx, func(stats tgapi.RequestStats) {
+		span.SetAttribute("retries", strconv.Itoa(stats.Retries))
+		if stats.ErrorCode != 0 {
+			span.SetAttribute("error_code", strconv.Itoa(stats.ErrorCode))
+		}
+		span.End(stats.Err)
+	}
+}
+
+func (t *Tracer) ObserveUpdate(ctx context.Context, update *tgapi.Update) (context.Context, func(tgapi.UpdateStats)) {
+	ctx, span := t.Start(ctx, "tgapi.update")
+	span.SetAttribute("update_id", strconv.FormatInt(update.UpdateID, 10))
+	span.SetAttribute("kind", update.Kind().String())
+	return ctx, func(stats tgapi.UpdateStats) {
+		span.SetAttribute("handler", stats.Handler)
+		var err error
+		if stats.Panic != nil {
+			err = fmt.Errorf("panic: %v", stats.Panic)
+		}
+		span.End(err)
+	}
+}
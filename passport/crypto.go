@@ -0,0 +1,168 @@
+// Package passport decrypts the Telegram Passport data shared with the bot.
+//
+// The credentials are encrypted with the public key of the bot, see
+// https://core.telegram.org/passport#decrypting-data.
+// The data of every element and the files are encrypted with AES-256-CBC with the secrets from the credentials.
+package passport
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/Feresey/tgbotapi/tgapi"
+)
+
+var (
+	// ErrHashMismatch is returned when the hash of the decrypted data differs from the expected one.
+	ErrHashMismatch = errors.New("hash mismatch")
+	// ErrDecrypt is returned when the data cannot be decrypted with the secret.
+	ErrDecrypt = errors.New("cannot decrypt")
+)
+
+// ParsePrivateKey parses the PEM encoded RSA private key of the bot in PKCS #1 or PKCS #8 form.
+func ParsePrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block with the private key")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("the private key is %T, not RSA", key)
+	}
+	return rsaKey, nil
+}
+
+// Credentials are the decrypted EncryptedCredentials.
+type Credentials struct {
+	// SecureData are the credentials of the elements by the element type, e.g. "passport".
+	SecureData map[string]*SecureValue `json:"secure_data"`
+	// Nonce is the nonce passed to the authorization request.
+	// It must be checked to prevent the replay of the passport data of another request.
+	Nonce string `json:"nonce"`
+}
+
+// SecureValue are the credentials of the data and the files of the element.
+type SecureValue struct {
+	Data        *DataCredentials  `json:"data,omitempty"`
+	FrontSide   *FileCredentials  `json:"front_side,omitempty"`
+	ReverseSide *FileCredentials  `json:"reverse_side,omitempty"`
+	Selfie      *FileCredentials  `json:"selfie,omitempty"`
+	Translation []FileCredentials `json:"translation,omitempty"`
+	Files       []FileCredentials `json:"files,omitempty"`
+}
+
+// DataCredentials decrypt EncryptedPassportElement.Data.
+type DataCredentials struct {
+	DataHash string `json:"data_hash"`
+	Secret   string `json:"secret"`
+}
+
+// FileCredentials decrypt the downloaded PassportFile.
+type FileCredentials struct {
+	FileHash string `json:"file_hash"`
+	Secret   string `json:"secret"`
+}
+
+// DecryptCredentials decrypts the credentials with the private key of the bot.
+func DecryptCredentials(key *rsa.PrivateKey, encrypted *tgapi.EncryptedCredentials) (*Credentials, error) {
+	encryptedSecret, err := base64.StdEncoding.DecodeString(encrypted.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("decode credentials secret: %w", err)
+	}
+	secret, err := rsa.DecryptOAEP(sha1.New(), rand.Reader, key, encryptedSecret, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w credentials secret: %v", ErrDecrypt, err)
+	}
+
+	data, err := decryptBase64(secret, encrypted.Hash, encrypted.Data)
+	if err != nil {
+		return nil, fmt.Errorf("credentials: %w", err)
+	}
+	var res Credentials
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, fmt.Errorf("decode credentials: %w", err)
+	}
+	return &res, nil
+}
+
+// Decrypt decrypts the element data with the credentials.
+func (c *DataCredentials) Decrypt(data string) ([]byte, error) {
+	secret, err := base64.StdEncoding.DecodeString(c.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("decode secret: %w", err)
+	}
+	return decryptBase64(secret, c.DataHash, data)
+}
+
+// Decrypt decrypts the content of the downloaded file with the credentials.
+func (c *FileCredentials) Decrypt(data []byte) ([]byte, error) {
+	secret, err := base64.StdEncoding.DecodeString(c.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("decode secret: %w", err)
+	}
+	hash, err := base64.StdEncoding.DecodeString(c.FileHash)
+	if err != nil {
+		return nil, fmt.Errorf("decode hash: %w", err)
+	}
+	return decrypt(secret, hash, data)
+}
+
+// decryptBase64 decrypts the base64 encoded data with the base64 encoded hash.
+func decryptBase64(secret []byte, hash, data string) ([]byte, error) {
+	rawHash, err := base64.StdEncoding.DecodeString(hash)
+	if err != nil {
+		return nil, fmt.Errorf("decode hash: %w", err)
+	}
+	rawData, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, fmt.Errorf("decode data: %w", err)
+	}
+	return decrypt(secret, rawHash, rawData)
+}
+
+// decrypt decrypts the data with AES-256-CBC, checks its SHA-256 hash and removes the padding.
+// The key and the IV are the parts of SHA-512(secret + hash).
+func decrypt(secret, hash, data []byte) ([]byte, error) {
+	digest := sha512.Sum512(append(append([]byte(nil), secret...), hash...))
+	key, iv := digest[:32], digest[32:48]
+	if len(data) == 0 || len(data)%aes.BlockSize != 0 {
+		return nil, fmt.Errorf("%w: the length %d is not a multiple of the block size", ErrDecrypt, len(data))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]byte, len(data))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(res, data)
+
+	sum := sha256.Sum256(res)
+	if !hmac.Equal(sum[:], hash) {
+		return nil, ErrHashMismatch
+	}
+	// the first byte is the length of the random padding, 32 to 255 bytes.
+	padding := int(res[0])
+	if padding < 32 || padding > len(res) {
+		return nil, fmt.Errorf("%w: invalid padding %d", ErrDecrypt, padding)
+	}
+	return res[padding:], nil
+}
@@ -0,0 +1,117 @@
+package passport
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Feresey/tgbotapi/tgapi"
+)
+
+// The sources of the element errors.
+const (
+	SourceData            = "data"
+	SourceFrontSide       = "front_side"
+	SourceReverseSide     = "reverse_side"
+	SourceSelfie          = "selfie"
+	SourceFile            = "file"
+	SourceFiles           = "files"
+	SourceTranslationFile = "translation_file"
+	SourceTranslation     = "translation_files"
+	SourceUnspecified     = "unspecified"
+)
+
+// ElementError is the issue of the passport element which the user has to fix.
+// It is reported to the user with SetErrors.
+type ElementError struct {
+	Type   tgapi.PassportType
+	Source string
+	// FieldName is the name of the data field with the issue, SourceData only.
+	FieldName string
+	// Hashes are the data hash, the file hashes or the element hash, depending on the source.
+	Hashes  []string
+	Message string
+	// Err is the cause of the issue, e.g. ErrHashMismatch.
+	Err error
+}
+
+func (e *ElementError) Error() string {
+	res := fmt.Sprintf("passport element %s, %s", e.Type, e.Source)
+	if e.FieldName != "" {
+		res += " " + e.FieldName
+	}
+	res += ": " + e.Message
+	if e.Err != nil {
+		res += ": " + e.Err.Error()
+	}
+	return res
+}
+
+func (e *ElementError) Unwrap() error { return e.Err }
+
+// Report returns the PassportElementError* value for setPassportDataErrors matching the source,
+// e.g. *tgapi.PassportElementErrorFrontSide.
+func (e *ElementError) Report() interface{} {
+	var hash string
+	if len(e.Hashes) != 0 {
+		hash = e.Hashes[0]
+	}
+	switch e.Source {
+	case SourceData:
+		return &tgapi.PassportElementErrorDataField{
+			Source: e.Source, Type: e.Type, FieldName: e.FieldName, DataHash: hash, Message: e.Message,
+		}
+	case SourceFrontSide:
+		return &tgapi.PassportElementErrorFrontSide{Source: e.Source, Type: e.Type, FileHash: hash, Message: e.Message}
+	case SourceReverseSide:
+		return &tgapi.PassportElementErrorReverseSide{Source: e.Source, Type: e.Type, FileHash: hash, Message: e.Message}
+	case SourceSelfie:
+		return &tgapi.PassportElementErrorSelfie{Source: e.Source, Type: e.Type, FileHash: hash, Message: e.Message}
+	case SourceFile:
+		return &tgapi.PassportElementErrorFile{Source: e.Source, Type: e.Type, FileHash: hash, Message: e.Message}
+	case SourceFiles:
+		return &tgapi.PassportElementErrorFiles{Source: e.Source, Type: e.Type, FileHashes: e.Hashes, Message: e.Message}
+	case SourceTranslationFile:
+		return &tgapi.PassportElementErrorTranslationFile{
+			Source: e.Source, Type: e.Type, FileHash: hash, Message: e.Message,
+		}
+	case SourceTranslation:
+		return &tgapi.PassportElementErrorTranslationFiles{
+			Source: e.Source, Type: e.Type, FileHashes: e.Hashes, Message: e.Message,
+		}
+	default:
+		return &tgapi.PassportElementErrorUnspecified{
+			Source: SourceUnspecified, Type: e.Type, ElementHash: hash, Message: e.Message,
+		}
+	}
+}
+
+// Errors are the issues of the elements returned by Decrypt.
+type Errors []*ElementError
+
+func (e Errors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+type setErrorsRequest struct {
+	UserID int64         `json:"user_id"`
+	Errors []interface{} `json:"errors"`
+}
+
+func (r *setErrorsRequest) Method() string { return "setPassportDataErrors" }
+
+func (r *setErrorsRequest) Files() map[string]*tgapi.InputFile { return nil }
+
+// SetErrors reports the issues to the user, the user will not be able to resend the passport
+// until the issues are fixed.
+func SetErrors(ctx context.Context, api tgapi.BotAPI, userID int64, errs ...*ElementError) error {
+	req := &setErrorsRequest{UserID: userID, Errors: make([]interface{}, 0, len(errs))}
+	for _, err := range errs {
+		req.Errors = append(req.Errors, err.Report())
+	}
+	return api.Do(ctx, req, nil)
+}
@@ -0,0 +1,307 @@
+package passport
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/Feresey/tgbotapi/tgapi"
+)
+
+// PersonalDetails are the data of the personal_details element.
+type PersonalDetails struct {
+	FirstName  string `json:"first_name"`
+	LastName   string `json:"last_name"`
+	MiddleName string `json:"middle_name,omitempty"`
+	// BirthDate is in the DD.MM.YYYY format.
+	BirthDate string `json:"birth_date"`
+	// Gender is "male" or "female".
+	Gender string `json:"gender"`
+	// CountryCode is the citizenship, ISO 3166-1 alpha-2 country code.
+	CountryCode          string `json:"country_code"`
+	ResidenceCountryCode string `json:"residence_country_code"`
+	FirstNameNative      string `json:"first_name_native,omitempty"`
+	LastNameNative       string `json:"last_name_native,omitempty"`
+	MiddleNameNative     string `json:"middle_name_native,omitempty"`
+}
+
+// IDDocumentData are the data of the passport, driver_license, identity_card and internal_passport elements.
+type IDDocumentData struct {
+	DocumentNo string `json:"document_no"`
+	// ExpiryDate is in the DD.MM.YYYY format, it is empty if the document does not expire.
+	ExpiryDate string `json:"expiry_date,omitempty"`
+}
+
+// ResidentialAddress are the data of the address element.
+type ResidentialAddress struct {
+	StreetLine1 string `json:"street_line1"`
+	StreetLine2 string `json:"street_line2,omitempty"`
+	City        string `json:"city"`
+	State       string `json:"state,omitempty"`
+	CountryCode string `json:"country_code"`
+	PostCode    string `json:"post_code"`
+}
+
+// File is the passport file with its credentials, the content is downloaded and decrypted with Download.
+type File struct {
+	tgapi.PassportFile
+	Credentials FileCredentials
+
+	typ    tgapi.PassportType
+	source string
+}
+
+// Element is the decrypted passport element. The fields are set depending on the type.
+type Element struct {
+	Type tgapi.EncryptedType
+	// Hash is the element hash, it identifies the element in PassportElementErrorUnspecified.
+	Hash string
+
+	PersonalDetails *PersonalDetails
+	Document        *IDDocumentData
+	Address         *ResidentialAddress
+	PhoneNumber     string
+	Email           string
+
+	FrontSide   *File
+	ReverseSide *File
+	Selfie      *File
+	Files       []File
+	Translation []File
+}
+
+// Passport is the decrypted PassportData.
+type Passport struct {
+	Credentials *Credentials
+	Elements    []Element
+}
+
+// Element returns the element of the type.
+func (p *Passport) Element(typ tgapi.EncryptedType) (*Element, bool) {
+	for i := range p.Elements {
+		if p.Elements[i].Type == typ {
+			return &p.Elements[i], true
+		}
+	}
+	return nil, false
+}
+
+// Decrypt decrypts the credentials and the data of the elements with the private key of the bot.
+// The elements which cannot be decrypted are skipped and returned in Errors along with the rest of the passport,
+// report them to the user with SetErrors. Other errors mean that the credentials cannot be decrypted.
+// Check Credentials.Nonce against the nonce of the request.
+func Decrypt(key *rsa.PrivateKey, data *tgapi.PassportData) (*Passport, error) {
+	credentials, err := DecryptCredentials(key, &data.Credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Passport{Credentials: credentials}
+	var errs Errors
+	for i := range data.Data {
+		element, err := decryptElement(credentials, &data.Data[i])
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		res.Elements = append(res.Elements, *element)
+	}
+	if len(errs) != 0 {
+		return res, errs
+	}
+	return res, nil
+}
+
+func passportType(typ tgapi.EncryptedType) tgapi.PassportType {
+	return tgapi.PassportType(typ)
+}
+
+func decryptElement(credentials *Credentials, encrypted *tgapi.EncryptedPassportElement) (*Element, *ElementError) {
+	typ := passportType(encrypted.Type)
+	res := &Element{
+		Type:        encrypted.Type,
+		Hash:        encrypted.Hash,
+		PhoneNumber: encrypted.GetPhoneNumber(),
+		Email:       encrypted.GetEmail(),
+	}
+	if res.PhoneNumber != "" || res.Email != "" {
+		// the phone number and the email are not encrypted.
+		return res, nil
+	}
+
+	value := credentials.SecureData[encrypted.Type.String()]
+	if value == nil {
+		return nil, &ElementError{
+			Type:    typ,
+			Source:  SourceUnspecified,
+			Hashes:  []string{encrypted.Hash},
+			Message: "The element cannot be decrypted, please send it again.",
+			Err:     errors.New("no credentials"),
+		}
+	}
+
+	if encrypted.Data != nil {
+		if err := res.decryptData(typ, value.Data, *encrypted.Data); err != nil {
+			if err.Hashes == nil {
+				err.Hashes = []string{encrypted.Hash}
+			}
+			return nil, err
+		}
+	}
+
+	if err := res.setFiles(encrypted, value); err != nil {
+		return nil, &ElementError{
+			Type:    typ,
+			Source:  SourceUnspecified,
+			Hashes:  []string{encrypted.Hash},
+			Message: "The files cannot be decrypted, please upload them again.",
+			Err:     err,
+		}
+	}
+	return res, nil
+}
+
+// decryptData decrypts the data into the typed struct and checks the required fields.
+func (e *Element) decryptData(typ tgapi.PassportType, credentials *DataCredentials, data string) *ElementError {
+	fail := func(message string, err error) *ElementError {
+		return &ElementError{Type: typ, Source: SourceUnspecified, Message: message, Err: err}
+	}
+	if credentials == nil {
+		return fail("The element cannot be decrypted, please send it again.", errors.New("no data credentials"))
+	}
+	raw, err := credentials.Decrypt(data)
+	if err != nil {
+		return fail("The element is damaged, please send it again.", err)
+	}
+
+	var target interface{}
+	switch typ {
+	case tgapi.PassportTypePersonalDetails:
+		e.PersonalDetails = &PersonalDetails{}
+		target = e.PersonalDetails
+	case tgapi.PassportTypePassport, tgapi.PassportTypeDriverLicense,
+		tgapi.PassportTypeIDentityCard, tgapi.PassportTypeInternalPassport:
+		e.Document = &IDDocumentData{}
+		target = e.Document
+	case tgapi.PassportTypeAddress:
+		e.Address = &ResidentialAddress{}
+		target = e.Address
+	default:
+		return nil
+	}
+	if err := json.Unmarshal(raw, target); err != nil {
+		return fail("The element is damaged, please send it again.", err)
+	}
+
+	// the required fields in the documentation order.
+	var required [][2]string
+	switch data := target.(type) {
+	case *PersonalDetails:
+		required = [][2]string{
+			{"first_name", data.FirstName}, {"last_name", data.LastName}, {"birth_date", data.BirthDate},
+			{"gender", data.Gender}, {"country_code", data.CountryCode},
+			{"residence_country_code", data.ResidenceCountryCode},
+		}
+	case *IDDocumentData:
+		required = [][2]string{{"document_no", data.DocumentNo}}
+	case *ResidentialAddress:
+		required = [][2]string{
+			{"street_line1", data.StreetLine1}, {"city", data.City},
+			{"country_code", data.CountryCode}, {"post_code", data.PostCode},
+		}
+	}
+	for _, field := range required {
+		if field[1] == "" {
+			return &ElementError{
+				Type:      typ,
+				Source:    SourceData,
+				FieldName: field[0],
+				Hashes:    []string{credentials.DataHash},
+				Message:   "The field is required.",
+			}
+		}
+	}
+	return nil
+}
+
+// setFiles pairs the files of the element with their credentials.
+func (e *Element) setFiles(encrypted *tgapi.EncryptedPassportElement, value *SecureValue) error {
+	typ := passportType(encrypted.Type)
+	single := []struct {
+		dst         **File
+		file        *tgapi.PassportFile
+		credentials *FileCredentials
+		source      string
+	}{
+		{&e.FrontSide, encrypted.FrontSide, value.FrontSide, SourceFrontSide},
+		{&e.ReverseSide, encrypted.ReverseSide, value.ReverseSide, SourceReverseSide},
+		{&e.Selfie, encrypted.Selfie, value.Selfie, SourceSelfie},
+	}
+	for _, f := range single {
+		if f.file == nil {
+			continue
+		}
+		if f.credentials == nil {
+			return fmt.Errorf("no credentials of %s", f.source)
+		}
+		*f.dst = &File{PassportFile: *f.file, Credentials: *f.credentials, typ: typ, source: f.source}
+	}
+
+	var err error
+	if e.Files, err = newFiles(typ, SourceFile, encrypted.Files, value.Files); err != nil {
+		return err
+	}
+	e.Translation, err = newFiles(typ, SourceTranslationFile, encrypted.Translation, value.Translation)
+	return err
+}
+
+func newFiles(typ tgapi.PassportType, source string, files []tgapi.PassportFile, credentials []FileCredentials) ([]File, error) {
+	if len(files) != len(credentials) {
+		return nil, fmt.Errorf("%d files of %s, %d credentials", len(files), source, len(credentials))
+	}
+	var res []File
+	for i := range files {
+		res = append(res, File{PassportFile: files[i], Credentials: credentials[i], typ: typ, source: source})
+	}
+	return res, nil
+}
+
+// Decrypt decrypts the downloaded content of the file.
+// The error is *ElementError with the source of the file.
+func (f *File) Decrypt(data []byte) ([]byte, error) {
+	res, err := f.Credentials.Decrypt(data)
+	if err != nil {
+		return nil, &ElementError{
+			Type:    f.typ,
+			Source:  f.source,
+			Hashes:  []string{f.Credentials.FileHash},
+			Message: "The file is damaged, please upload it again.",
+			Err:     err,
+		}
+	}
+	return res, nil
+}
+
+// Downloader downloads the files, it is implemented by *tgapi.API.
+type Downloader interface {
+	GetFileDirectly(ctx context.Context, fileID string) (io.ReadCloser, error)
+}
+
+// Download downloads and decrypts the file.
+func (f *File) Download(ctx context.Context, api Downloader) ([]byte, error) {
+	body, err := api.GetFileDirectly(ctx, f.FileID)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	return f.Decrypt(data)
+}
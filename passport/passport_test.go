@@ -0,0 +1,206 @@
+package passport
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Feresey/tgbotapi/tgapi"
+)
+
+var testKey = func() *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}()
+
+// encrypt encrypts the payload as Telegram does.
+func encrypt(t *testing.T, payload []byte) (data, hash, secret []byte) {
+	secret = make([]byte, 32)
+	_, err := rand.Read(secret)
+	require.NoError(t, err)
+
+	padding := 32 + (aes.BlockSize-(len(payload)+32)%aes.BlockSize)%aes.BlockSize
+	padded := make([]byte, padding)
+	_, err = rand.Read(padded)
+	require.NoError(t, err)
+	padded[0] = byte(padding)
+	padded = append(padded, payload...)
+
+	sum := sha256.Sum256(padded)
+	hash = sum[:]
+	digest := sha512.Sum512(append(append([]byte(nil), secret...), hash...))
+	block, err := aes.NewCipher(digest[:32])
+	require.NoError(t, err)
+	data = make([]byte, len(padded))
+	cipher.NewCBCEncrypter(block, digest[32:48]).CryptBlocks(data, padded)
+	return data, hash, secret
+}
+
+func b64(data []byte) string { return base64.StdEncoding.EncodeToString(data) }
+
+func encryptData(t *testing.T, value interface{}) (string, *DataCredentials) {
+	raw, err := json.Marshal(value)
+	require.NoError(t, err)
+	data, hash, secret := encrypt(t, raw)
+	return b64(data), &DataCredentials{DataHash: b64(hash), Secret: b64(secret)}
+}
+
+func encryptFile(t *testing.T, content string) ([]byte, *FileCredentials) {
+	data, hash, secret := encrypt(t, []byte(content))
+	return data, &FileCredentials{FileHash: b64(hash), Secret: b64(secret)}
+}
+
+func encryptCredentials(t *testing.T, credentials *Credentials) tgapi.EncryptedCredentials {
+	raw, err := json.Marshal(credentials)
+	require.NoError(t, err)
+	data, hash, secret := encrypt(t, raw)
+	encryptedSecret, err := rsa.EncryptOAEP(sha1.New(), rand.Reader, &testKey.PublicKey, secret, nil)
+	require.NoError(t, err)
+	return *tgapi.NewEncryptedCredentials(b64(data), b64(hash), b64(encryptedSecret))
+}
+
+type fakeDownloader map[string][]byte
+
+func (f fakeDownloader) GetFileDirectly(_ context.Context, fileID string) (io.ReadCloser, error) {
+	return ioutil.NopCloser(bytes.NewReader(f[fileID])), nil
+}
+
+func TestDecrypt(t *testing.T) {
+	details := PersonalDetails{
+		FirstName: "John", LastName: "Doe", BirthDate: "01.02.1990", Gender: "male",
+		CountryCode: "US", ResidenceCountryCode: "US",
+	}
+	detailsData, detailsCredentials := encryptData(t, details)
+	documentData, documentCredentials := encryptData(t, IDDocumentData{DocumentNo: "123"})
+	front, frontCredentials := encryptFile(t, "front side")
+	_, selfieCredentials := encryptFile(t, "selfie")
+	addressData, addressCredentials := encryptData(t, ResidentialAddress{City: "Springfield"})
+	damagedData, damagedCredentials := encryptData(t, IDDocumentData{DocumentNo: "456"})
+	damaged, err := base64.StdEncoding.DecodeString(damagedData)
+	require.NoError(t, err)
+	damaged[len(damaged)-1] ^= 1
+
+	document := tgapi.NewEncryptedPassportElement("passport-hash", tgapi.EncryptedTypePassport).
+		SetData(documentData).
+		SetFrontSide(*tgapi.NewPassportFile(0, "front", 0, "front-unique")).
+		SetSelfie(*tgapi.NewPassportFile(0, "selfie", 0, "selfie-unique"))
+	data := tgapi.NewPassportData(
+		encryptCredentials(t, &Credentials{
+			SecureData: map[string]*SecureValue{
+				"personal_details": {Data: detailsCredentials},
+				"passport":         {Data: documentCredentials, FrontSide: frontCredentials, Selfie: selfieCredentials},
+				"address":          {Data: addressCredentials},
+				"driver_license":   {Data: damagedCredentials},
+			},
+			Nonce: "nonce",
+		}),
+		[]tgapi.EncryptedPassportElement{
+			*tgapi.NewEncryptedPassportElement("details-hash", tgapi.EncryptedTypePersonalDetails).SetData(detailsData),
+			*document,
+			*tgapi.NewEncryptedPassportElement("phone-hash", tgapi.EncryptedTypePhoneNumber).SetPhoneNumber("123456"),
+			*tgapi.NewEncryptedPassportElement("address-hash", tgapi.EncryptedTypeAddress).SetData(addressData),
+			*tgapi.NewEncryptedPassportElement("license-hash", tgapi.EncryptedTypeDriverLicense).SetData(b64(damaged)),
+		},
+	)
+
+	passport, err := Decrypt(testKey, data)
+	var errs Errors
+	require.True(t, errors.As(err, &errs))
+	require.Equal(t, "nonce", passport.Credentials.Nonce)
+	require.Len(t, passport.Elements, 3)
+
+	element, ok := passport.Element(tgapi.EncryptedTypePersonalDetails)
+	require.True(t, ok)
+	require.Equal(t, &details, element.PersonalDetails)
+	element, ok = passport.Element(tgapi.EncryptedTypePhoneNumber)
+	require.True(t, ok)
+	require.Equal(t, "123456", element.PhoneNumber)
+
+	element, ok = passport.Element(tgapi.EncryptedTypePassport)
+	require.True(t, ok)
+	require.Equal(t, "123", element.Document.DocumentNo)
+	// the selfie is replaced by the front side.
+	files := fakeDownloader{"front": front, "selfie": front}
+	content, err := element.FrontSide.Download(context.Background(), files)
+	require.NoError(t, err)
+	require.Equal(t, "front side", string(content))
+	_, err = element.Selfie.Download(context.Background(), files)
+	require.True(t, errors.Is(err, ErrHashMismatch))
+	var elementErr *ElementError
+	require.True(t, errors.As(err, &elementErr))
+	require.Equal(t, &tgapi.PassportElementErrorSelfie{
+		Source:   "selfie",
+		Type:     tgapi.PassportTypePassport,
+		FileHash: selfieCredentials.FileHash,
+		Message:  elementErr.Message,
+	}, elementErr.Report())
+
+	require.Len(t, errs, 2)
+	require.Equal(t, &tgapi.PassportElementErrorDataField{
+		Source:    "data",
+		Type:      tgapi.PassportTypeAddress,
+		FieldName: "street_line1",
+		DataHash:  addressCredentials.DataHash,
+		Message:   "The field is required.",
+	}, errs[0].Report())
+	require.True(t, errors.Is(errs[1], ErrHashMismatch))
+	require.Equal(t, &tgapi.PassportElementErrorUnspecified{
+		Source:      "unspecified",
+		Type:        tgapi.PassportTypeDriverLicense,
+		ElementHash: "license-hash",
+		Message:     errs[1].Message,
+	}, errs[1].Report())
+
+	fake := &tgapi.FakeAPI{}
+	require.NoError(t, SetErrors(context.Background(), fake, 42, errs...))
+	calls := fake.CallsOf("setPassportDataErrors")
+	require.Len(t, calls, 1)
+	raw, err := json.Marshal(calls[0].Args[0])
+	require.NoError(t, err)
+	require.Contains(t, string(raw), `"user_id":42`)
+	require.Contains(t, string(raw), `"field_name":"street_line1"`)
+	require.Contains(t, string(raw), `"element_hash":"license-hash"`)
+}
+
+func TestDecryptCredentialsWrongKey(t *testing.T) {
+	other, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	credentials := encryptCredentials(t, &Credentials{Nonce: "nonce"})
+	_, err = Decrypt(other, tgapi.NewPassportData(credentials, nil))
+	require.True(t, errors.Is(err, ErrDecrypt))
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	pkcs1 := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(testKey)})
+	key, err := ParsePrivateKey(pkcs1)
+	require.NoError(t, err)
+	require.True(t, testKey.Equal(key))
+
+	raw, err := x509.MarshalPKCS8PrivateKey(testKey)
+	require.NoError(t, err)
+	key, err = ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: raw}))
+	require.NoError(t, err)
+	require.True(t, testKey.Equal(key))
+
+	_, err = ParsePrivateKey([]byte("garbage"))
+	require.Error(t, err)
+}
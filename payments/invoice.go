@@ -0,0 +1,133 @@
+package payments
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Feresey/tgbotapi/tgapi"
+)
+
+// maxSuggestedTips is the maximum number of the suggested tip amounts.
+const maxSuggestedTips = 4
+
+// Invoice builds SendInvoiceConfig with the prices in the major units of the currency, e.g.
+//
+//	args, err := payments.NewInvoice(token, "USD", "Pizza", "Large pepperoni", "order-42").
+//		Price("Pizza", "12.99").
+//		Price("Delivery", "2").
+//		Tips("5", "1", "2").
+//		Config(tgapi.NewInt(chatID))
+//
+// The errors of the amounts are returned by Config.
+type Invoice struct {
+	args *tgapi.SendInvoiceConfig
+	err  error
+}
+
+// NewInvoice starts the invoice. The payload is not shown to the user, use it to identify the order.
+func NewInvoice(providerToken, currency, title, description, payload string) *Invoice {
+	return &Invoice{
+		args: &tgapi.SendInvoiceConfig{
+			Currency:      currency,
+			Description:   description,
+			Payload:       payload,
+			ProviderToken: providerToken,
+			Title:         title,
+		},
+	}
+}
+
+func (i *Invoice) parse(amount string) int64 {
+	minor, err := ParseAmount(i.args.Currency, amount)
+	if err != nil && i.err == nil {
+		i.err = err
+	}
+	return minor
+}
+
+// Price adds the price component with the amount in the major units, e.g. "12.99". The amount may be negative for discounts.
+func (i *Invoice) Price(label, amount string) *Invoice {
+	return i.PriceMinor(label, i.parse(amount))
+}
+
+// PriceMinor adds the price component with the amount in the minor units.
+func (i *Invoice) PriceMinor(label string, amount int64) *Invoice {
+	i.args.Prices = append(i.args.Prices, *tgapi.NewLabeledPrice(amount, label))
+	return i
+}
+
+// Tips allows the tips up to max with the suggested amounts in the major units.
+func (i *Invoice) Tips(max string, suggested ...string) *Invoice {
+	i.args.MaxTipAmount = i.parse(max)
+	i.args.SuggestedTipAmounts = nil
+	for _, amount := range suggested {
+		i.args.SuggestedTipAmounts = append(i.args.SuggestedTipAmounts, i.parse(amount))
+	}
+	return i
+}
+
+// Flexible requests the shipping address, the final price depends on the shipping options,
+// see the Shipping option of the Handler.
+func (i *Invoice) Flexible() *Invoice {
+	i.args.IsFlexible = true
+	i.args.NeedShippingAddress = true
+	return i
+}
+
+// Photo sets the product photo.
+func (i *Invoice) Photo(url string, width, height int64) *Invoice {
+	i.args.PhotoURL = url
+	i.args.PhotoWidth = width
+	i.args.PhotoHeight = height
+	return i
+}
+
+// Modify changes the other arguments of the invoice, e.g. NeedEmail.
+func (i *Invoice) Modify(f func(*tgapi.SendInvoiceConfig)) *Invoice {
+	f(i.args)
+	return i
+}
+
+// Total returns the sum of the prices in the minor units.
+func (i *Invoice) Total() int64 {
+	var total int64
+	for _, price := range i.args.Prices {
+		total += price.Amount
+	}
+	return total
+}
+
+// Config returns the arguments of sendInvoice to the chat.
+func (i *Invoice) Config(chatID tgapi.IntStr) (*tgapi.SendInvoiceConfig, error) {
+	if err := i.validate(); err != nil {
+		return nil, fmt.Errorf("invoice %q: %w", i.args.Payload, err)
+	}
+	args := *i.args
+	args.ChatID = chatID
+	args.Prices = append([]tgapi.LabeledPrice(nil), i.args.Prices...)
+	args.SuggestedTipAmounts = append([]int64(nil), i.args.SuggestedTipAmounts...)
+	return &args, nil
+}
+
+func (i *Invoice) validate() error {
+	if i.err != nil {
+		return i.err
+	}
+	if len(i.args.Prices) == 0 {
+		return errors.New("no prices")
+	}
+	if i.Total() <= 0 {
+		return fmt.Errorf("%w: the total %s must be positive", ErrInvalidAmount, FormatAmount(i.args.Currency, i.Total()))
+	}
+
+	tips := i.args.SuggestedTipAmounts
+	if len(tips) > maxSuggestedTips {
+		return fmt.Errorf("at most %d suggested tips are allowed", maxSuggestedTips)
+	}
+	for n, tip := range tips {
+		if tip <= 0 || tip > i.args.MaxTipAmount || (n > 0 && tip <= tips[n-1]) {
+			return errors.New("the suggested tips must be positive, increasing and not exceed the max tip")
+		}
+	}
+	return nil
+}
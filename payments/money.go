@@ -0,0 +1,68 @@
+package payments
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidAmount is returned when the amount cannot be represented in the minor units of the currency.
+var ErrInvalidAmount = errors.New("invalid amount")
+
+// exponents are the currencies whose minor unit is not the hundredth of the major one.
+var exponents = map[string]int{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"MGA": 0, "PYG": 0, "RWF": 0, "UGX": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	// Telegram Stars.
+	"XTR": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+}
+
+// Exponent returns the number of the digits after the decimal point in the amounts of the currency,
+// e.g. 2 for USD and 0 for JPY. The prices in the API are integers in the minor units:
+// 1.45 USD is 145 and 100 JPY is 100.
+func Exponent(currency string) int {
+	if exp, ok := exponents[strings.ToUpper(currency)]; ok {
+		return exp
+	}
+	return 2
+}
+
+// ParseAmount converts the decimal amount in the major units, e.g. "12.34", to the minor units.
+// The amount with more fractional digits than the currency has is rejected, there is no rounding.
+func ParseAmount(currency, amount string) (int64, error) {
+	exp := Exponent(currency)
+	whole, frac := amount, ""
+	if dot := strings.IndexByte(amount, '.'); dot >= 0 {
+		whole, frac = amount[:dot], amount[dot+1:]
+	}
+	frac = strings.TrimRight(frac, "0")
+	if whole == "" || len(frac) > exp || strings.HasPrefix(whole, "+") {
+		return 0, fmt.Errorf("%w %q for %s", ErrInvalidAmount, amount, currency)
+	}
+	frac += strings.Repeat("0", exp-len(frac))
+
+	minor, err := strconv.ParseInt(whole+frac, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w %q for %s: %v", ErrInvalidAmount, amount, currency, err)
+	}
+	return minor, nil
+}
+
+// FormatAmount formats the amount in the minor units as the decimal in the major units, e.g. "12.34".
+func FormatAmount(currency string, minor int64) string {
+	exp := Exponent(currency)
+	sign := ""
+	if minor < 0 {
+		sign, minor = "-", -minor
+	}
+	digits := strconv.FormatInt(minor, 10)
+	if exp == 0 {
+		return sign + digits
+	}
+	if len(digits) <= exp {
+		digits = strings.Repeat("0", exp-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-exp] + "." + digits[len(digits)-exp:]
+}
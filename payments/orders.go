@@ -0,0 +1,94 @@
+package payments
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Feresey/tgbotapi/tgapi"
+)
+
+// Order is the successful payment.
+type Order struct {
+	ChatID  int64
+	UserID  int64
+	Payload string
+	// Currency and TotalAmount in the minor units, see FormatAmount.
+	Currency    string
+	TotalAmount int64
+	// TelegramPaymentChargeID identifies the payment, it is the key of the OrderStore.
+	TelegramPaymentChargeID string
+	ProviderPaymentChargeID string
+	ShippingOptionID        string
+	OrderInfo               *tgapi.OrderInfo
+	PaidAt                  time.Time
+	// Fulfilled is set when the OnPaid hook has succeeded.
+	Fulfilled bool
+}
+
+// OrderStore records the paid orders.
+type OrderStore interface {
+	// Record saves the order unless the order with the same TelegramPaymentChargeID is saved already.
+	// pending reports whether the saved order is not fulfilled yet.
+	Record(ctx context.Context, order *Order) (pending bool, err error)
+	// Fulfill marks the order as fulfilled.
+	Fulfill(ctx context.Context, chargeID string) error
+	// Pending returns the orders which are not fulfilled.
+	Pending(ctx context.Context) ([]*Order, error)
+}
+
+// MemoryOrderStore keeps the orders in memory. It is suitable for tests and for a single process only.
+type MemoryOrderStore struct {
+	mu     sync.Mutex
+	orders map[string]*Order
+}
+
+var _ OrderStore = (*MemoryOrderStore)(nil)
+
+func NewMemoryOrderStore() *MemoryOrderStore {
+	return &MemoryOrderStore{orders: make(map[string]*Order)}
+}
+
+func (s *MemoryOrderStore) Record(_ context.Context, order *Order) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if saved, ok := s.orders[order.TelegramPaymentChargeID]; ok {
+		return !saved.Fulfilled, nil
+	}
+	s.orders[order.TelegramPaymentChargeID] = order
+	return !order.Fulfilled, nil
+}
+
+func (s *MemoryOrderStore) Fulfill(_ context.Context, chargeID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	order, ok := s.orders[chargeID]
+	if !ok {
+		return fmt.Errorf("order %s is not recorded", chargeID)
+	}
+	order.Fulfilled = true
+	return nil
+}
+
+func (s *MemoryOrderStore) Pending(context.Context) ([]*Order, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var res []*Order
+	for _, order := range s.orders {
+		if !order.Fulfilled {
+			res = append(res, order)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].PaidAt.Before(res[j].PaidAt) })
+	return res, nil
+}
+
+// Get returns the order by TelegramPaymentChargeID.
+func (s *MemoryOrderStore) Get(chargeID string) (*Order, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	order, ok := s.orders[chargeID]
+	return order, ok
+}
@@ -0,0 +1,320 @@
+// Package payments builds the invoices and handles the shipping queries,
+// the pre-checkout queries and the successful payments.
+package payments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Feresey/tgbotapi/tgapi"
+)
+
+const (
+	// DefaultAnswerTimeout is the time to answer the shipping and pre-checkout queries.
+	// Telegram waits for the answer for 10 seconds, the rest is left for the request.
+	DefaultAnswerTimeout = 8 * time.Second
+
+	// DefaultRejectMessage is shown to the user when the query is rejected with an error other than Reject.
+	DefaultRejectMessage = "Sorry, the payment cannot be processed right now. Please try again later."
+	// DefaultTimeoutMessage is shown to the user when the query is not answered in time.
+	DefaultTimeoutMessage = "Sorry, the payment is taking too long. Please try again later."
+	// DefaultNoShippingMessage is shown to the user when there are no shipping options for the address.
+	DefaultNoShippingMessage = "Sorry, we cannot deliver to this address."
+)
+
+// RejectError is the error whose message is shown to the user.
+type RejectError struct {
+	Message string
+}
+
+func (e *RejectError) Error() string { return "rejected: " + e.Message }
+
+// Reject returns the error rejecting the query with the message for the user,
+// e.g. "Sorry, the pizza is out of stock".
+func Reject(message string) error {
+	return &RejectError{Message: message}
+}
+
+// ShippingProvider returns the shipping options for the address.
+// The providers of the Handler are asked in order and their options are combined.
+type ShippingProvider interface {
+	ShippingOptions(ctx context.Context, query *tgapi.ShippingQuery) ([]tgapi.ShippingOption, error)
+}
+
+// ShippingProviderFunc is the function implementing ShippingProvider.
+type ShippingProviderFunc func(ctx context.Context, query *tgapi.ShippingQuery) ([]tgapi.ShippingOption, error)
+
+func (f ShippingProviderFunc) ShippingOptions(ctx context.Context, query *tgapi.ShippingQuery) ([]tgapi.ShippingOption, error) {
+	return f(ctx, query)
+}
+
+// FlatRate offers the same option for every address.
+func FlatRate(option tgapi.ShippingOption) ShippingProvider {
+	return ShippingProviderFunc(func(context.Context, *tgapi.ShippingQuery) ([]tgapi.ShippingOption, error) {
+		return []tgapi.ShippingOption{option}, nil
+	})
+}
+
+// ForCountries asks the provider only for the addresses in the countries with the ISO 3166-1 alpha-2 codes.
+func ForCountries(provider ShippingProvider, countryCodes ...string) ShippingProvider {
+	countries := make(map[string]bool, len(countryCodes))
+	for _, code := range countryCodes {
+		countries[code] = true
+	}
+	return ShippingProviderFunc(func(ctx context.Context, query *tgapi.ShippingQuery) ([]tgapi.ShippingOption, error) {
+		if !countries[query.ShippingAddress.CountryCode] {
+			return nil, nil
+		}
+		return provider.ShippingOptions(ctx, query)
+	})
+}
+
+// PreCheckoutFunc validates the order before the payment, e.g. checks the stock.
+// The query is rejected if an error is returned, use Reject to show the reason to the user.
+type PreCheckoutFunc func(ctx context.Context, query *tgapi.PreCheckoutQuery) error
+
+// Handler answers the shipping and pre-checkout queries in time and records the successful payments.
+// The other updates are ignored. HandleUpdate can be used as the HandlerFunc of the CallTree.
+type Handler struct {
+	api tgapi.BotAPI
+
+	shipping       []ShippingProvider
+	preCheckout    PreCheckoutFunc
+	orders         OrderStore
+	onPaid         func(context.Context, *Order) error
+	timeout        time.Duration
+	rejectMessage  string
+	timeoutMessage string
+	onError        tgapi.ErrorCallback
+
+	now func() time.Time
+}
+
+var _ tgapi.Handler = (*Handler)(nil)
+
+// Option is used to customize the Handler.
+type Option func(*Handler)
+
+// Shipping sets the providers of the shipping options for the flexible invoices.
+func Shipping(providers ...ShippingProvider) Option {
+	return func(h *Handler) {
+		h.shipping = append(h.shipping, providers...)
+	}
+}
+
+// PreCheckout sets the validator of the orders. All orders are accepted by default.
+func PreCheckout(f PreCheckoutFunc) Option {
+	return func(h *Handler) {
+		h.preCheckout = f
+	}
+}
+
+// Orders sets the store of the paid orders, NewMemoryOrderStore is used by default.
+func Orders(store OrderStore) Option {
+	return func(h *Handler) {
+		h.orders = store
+	}
+}
+
+// OnPaid sets the hook called for every successful payment after the order is recorded,
+// e.g. to ship the goods. The order is marked as fulfilled when the hook succeeds,
+// the redelivered updates of the fulfilled orders are skipped. The failed orders are retried
+// with the redelivered updates and with Handler.RetryPending, so the hook must be idempotent.
+func OnPaid(f func(context.Context, *Order) error) Option {
+	return func(h *Handler) {
+		h.onPaid = f
+	}
+}
+
+// AnswerTimeout sets the time to answer the queries. When it passes, the query is rejected
+// with the timeout message and the context of the validator or the shipping providers is canceled.
+// DefaultAnswerTimeout is used by default.
+func AnswerTimeout(timeout time.Duration) Option {
+	return func(h *Handler) {
+		h.timeout = timeout
+	}
+}
+
+// Messages sets the messages shown to the user when the query is rejected with an error other
+// than Reject and when the query is not answered in time.
+func Messages(reject, timeout string) Option {
+	return func(h *Handler) {
+		h.rejectMessage = reject
+		h.timeoutMessage = timeout
+	}
+}
+
+// ErrorListener sets up a listener for the errors of the answers and of the hooks.
+func ErrorListener(listener tgapi.ErrorCallback) Option {
+	return func(h *Handler) {
+		h.onError = listener
+	}
+}
+
+// New creates the Handler answering with api.
+func New(api tgapi.BotAPI, options ...Option) *Handler {
+	h := &Handler{
+		api:            api,
+		orders:         NewMemoryOrderStore(),
+		timeout:        DefaultAnswerTimeout,
+		rejectMessage:  DefaultRejectMessage,
+		timeoutMessage: DefaultTimeoutMessage,
+		onError: func(err error) {
+			log.Printf("payments: %v", err)
+		},
+		now: time.Now,
+	}
+	for _, option := range options {
+		option(h)
+	}
+	return h
+}
+
+// HandleUpdate is the implementation method for the tgapi.Handler interface.
+func (h *Handler) HandleUpdate(ctx context.Context, update *tgapi.Update) {
+	var err error
+	switch {
+	case update.ShippingQuery != nil:
+		err = h.handleShipping(ctx, update.ShippingQuery)
+	case update.PreCheckoutQuery != nil:
+		err = h.handlePreCheckout(ctx, update.PreCheckoutQuery)
+	case update.Message != nil && update.Message.SuccessfulPayment != nil:
+		err = h.handlePayment(ctx, update.Message)
+	}
+	if err != nil && h.onError != nil {
+		h.onError(err)
+	}
+}
+
+// answer is the result of the query: the error rejects it.
+type answer struct {
+	options []tgapi.ShippingOption
+	err     error
+}
+
+// decide runs f with the timeout. If the timeout passes, the query is rejected with the timeout message.
+func (h *Handler) decide(ctx context.Context, f func(ctx context.Context) answer) answer {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	res := make(chan answer, 1)
+	go func() {
+		res <- f(ctx)
+	}()
+	select {
+	case a := <-res:
+		return a
+	case <-ctx.Done():
+		return answer{err: Reject(h.timeoutMessage)}
+	}
+}
+
+// userMessage returns the reason of the rejection shown to the user.
+func (h *Handler) userMessage(err error) string {
+	var reject *RejectError
+	if errors.As(err, &reject) {
+		return reject.Message
+	}
+	return h.rejectMessage
+}
+
+func (h *Handler) handleShipping(ctx context.Context, query *tgapi.ShippingQuery) error {
+	a := h.decide(ctx, func(ctx context.Context) answer {
+		var res answer
+		for _, provider := range h.shipping {
+			options, err := provider.ShippingOptions(ctx, query)
+			if err != nil {
+				return answer{err: err}
+			}
+			res.options = append(res.options, options...)
+		}
+		if len(res.options) == 0 {
+			res.err = Reject(DefaultNoShippingMessage)
+		}
+		return res
+	})
+
+	args := tgapi.NewAnswerShippingQueryConfig(a.err == nil, query.ID)
+	if a.err != nil {
+		args.ErrorMessage = h.userMessage(a.err)
+	} else {
+		args.ShippingOptions = a.options
+	}
+	if err := h.api.AnswerShippingQuery(ctx, args); err != nil {
+		return fmt.Errorf("answer shipping query %s: %w", query.ID, err)
+	}
+	return nil
+}
+
+func (h *Handler) handlePreCheckout(ctx context.Context, query *tgapi.PreCheckoutQuery) error {
+	a := h.decide(ctx, func(ctx context.Context) answer {
+		if h.preCheckout == nil {
+			return answer{}
+		}
+		return answer{err: h.preCheckout(ctx, query)}
+	})
+
+	args := tgapi.NewAnswerPreCheckoutQueryConfig(a.err == nil, query.ID)
+	if a.err != nil {
+		args.ErrorMessage = h.userMessage(a.err)
+	}
+	if err := h.api.AnswerPreCheckoutQuery(ctx, args); err != nil {
+		return fmt.Errorf("answer pre-checkout query %s: %w", query.ID, err)
+	}
+	return nil
+}
+
+func (h *Handler) handlePayment(ctx context.Context, msg *tgapi.Message) error {
+	payment := msg.SuccessfulPayment
+	order := &Order{
+		ChatID:                  msg.Chat.ID,
+		UserID:                  msg.GetFrom().GetID(),
+		Payload:                 payment.InvoicePayload,
+		Currency:                payment.Currency,
+		TotalAmount:             payment.TotalAmount,
+		TelegramPaymentChargeID: payment.TelegramPaymentChargeID,
+		ProviderPaymentChargeID: payment.ProviderPaymentChargeID,
+		ShippingOptionID:        payment.GetShippingOptionID(),
+		OrderInfo:               payment.OrderInfo,
+		PaidAt:                  h.now(),
+	}
+	pending, err := h.orders.Record(ctx, order)
+	if err != nil {
+		return fmt.Errorf("record order %s: %w", order.TelegramPaymentChargeID, err)
+	}
+	if !pending {
+		return nil
+	}
+	return h.fulfill(ctx, order)
+}
+
+// RetryPending calls the OnPaid hook for the recorded orders which are not fulfilled,
+// e.g. on start or periodically. The errors of the hook are reported to the error listener.
+func (h *Handler) RetryPending(ctx context.Context) error {
+	orders, err := h.orders.Pending(ctx)
+	if err != nil {
+		return fmt.Errorf("pending orders: %w", err)
+	}
+	for _, order := range orders {
+		if err := h.fulfill(ctx, order); err != nil && h.onError != nil {
+			h.onError(err)
+		}
+	}
+	return nil
+}
+
+// fulfill calls the OnPaid hook and marks the order as fulfilled if it succeeds.
+func (h *Handler) fulfill(ctx context.Context, order *Order) error {
+	if h.onPaid != nil {
+		if err := h.onPaid(ctx, order); err != nil {
+			return fmt.Errorf("order %s: %w", order.TelegramPaymentChargeID, err)
+		}
+	}
+	if err := h.orders.Fulfill(ctx, order.TelegramPaymentChargeID); err != nil {
+		return fmt.Errorf("fulfill order %s: %w", order.TelegramPaymentChargeID, err)
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+package payments
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Feresey/tgbotapi/tgapi"
+)
+
+func TestAmount(t *testing.T) {
+	tests := []struct {
+		currency string
+		amount   string
+		minor    int64
+		format   string
+	}{
+		{currency: "USD", amount: "12.34", minor: 1234, format: "12.34"},
+		{currency: "USD", amount: "2", minor: 200, format: "2.00"},
+		{currency: "USD", amount: "0.5", minor: 50, format: "0.50"},
+		{currency: "eur", amount: "-1.05", minor: -105, format: "-1.05"},
+		{currency: "JPY", amount: "100", minor: 100, format: "100"},
+		{currency: "KWD", amount: "1.5", minor: 1500, format: "1.500"},
+		{currency: "USD", amount: "0.10", minor: 10, format: "0.10"},
+	}
+	for _, tt := range tests {
+		minor, err := ParseAmount(tt.currency, tt.amount)
+		require.NoError(t, err, tt.amount)
+		require.Equal(t, tt.minor, minor, tt.amount)
+		require.Equal(t, tt.format, FormatAmount(tt.currency, tt.minor))
+	}
+
+	for _, amount := range []string{"1.234", ".5", "1,5", "", "+1", "1e3"} {
+		_, err := ParseAmount("USD", amount)
+		require.True(t, errors.Is(err, ErrInvalidAmount), amount)
+	}
+	_, err := ParseAmount("JPY", "1.5")
+	require.True(t, errors.Is(err, ErrInvalidAmount))
+}
+
+func TestInvoice(t *testing.T) {
+	invoice := NewInvoice("token", "USD", "Pizza", "Large pepperoni", "order-42").
+		Price("Pizza", "12.99").
+		Price("Discount", "-1").
+		Tips("5", "1", "2.50").
+		Flexible()
+	require.Equal(t, int64(1199), invoice.Total())
+
+	args, err := invoice.Config(tgapi.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, []tgapi.LabeledPrice{
+		*tgapi.NewLabeledPrice(1299, "Pizza"),
+		*tgapi.NewLabeledPrice(-100, "Discount"),
+	}, args.Prices)
+	require.Equal(t, int64(500), args.MaxTipAmount)
+	require.Equal(t, []int64{100, 250}, args.SuggestedTipAmounts)
+	require.True(t, args.IsFlexible)
+	require.Equal(t, tgapi.NewInt(1), args.ChatID)
+
+	_, err = NewInvoice("token", "USD", "t", "d", "p").Price("Pizza", "12.999").Config(tgapi.NewInt(1))
+	require.True(t, errors.Is(err, ErrInvalidAmount))
+	_, err = NewInvoice("token", "USD", "t", "d", "p").Price("Free", "0").Config(tgapi.NewInt(1))
+	require.True(t, errors.Is(err, ErrInvalidAmount))
+	_, err = NewInvoice("token", "USD", "t", "d", "p").Config(tgapi.NewInt(1))
+	require.Error(t, err)
+	_, err = NewInvoice("token", "USD", "t", "d", "p").Price("Pizza", "1").Tips("1", "2").Config(tgapi.NewInt(1))
+	require.Error(t, err)
+}
+
+func shippingUpdate(country string) *tgapi.Update {
+	address := tgapi.ShippingAddress{CountryCode: country}
+	return &tgapi.Update{ShippingQuery: tgapi.NewShippingQuery(tgapi.User{ID: 1}, "ship", "order-42", address)}
+}
+
+func TestShipping(t *testing.T) {
+	fake := &tgapi.FakeAPI{}
+	courier := *tgapi.NewShippingOption("courier", []tgapi.LabeledPrice{*tgapi.NewLabeledPrice(500, "Courier")}, "Courier")
+	post := *tgapi.NewShippingOption("post", []tgapi.LabeledPrice{*tgapi.NewLabeledPrice(100, "Post")}, "Post")
+	h := New(fake, Shipping(ForCountries(FlatRate(courier), "US"), FlatRate(post)))
+
+	h.HandleUpdate(context.Background(), shippingUpdate("US"))
+	h.HandleUpdate(context.Background(), shippingUpdate("DE"))
+	answers := fake.CallsOf("answerShippingQuery")
+	require.Len(t, answers, 2)
+	require.Equal(t, []tgapi.ShippingOption{courier, post}, answers[0].Args[0].(*tgapi.AnswerShippingQueryConfig).ShippingOptions)
+	require.Equal(t, []tgapi.ShippingOption{post}, answers[1].Args[0].(*tgapi.AnswerShippingQueryConfig).ShippingOptions)
+
+	fake.Reset()
+	New(fake).HandleUpdate(context.Background(), shippingUpdate("US"))
+	args := fake.CallsOf("answerShippingQuery")[0].Args[0].(*tgapi.AnswerShippingQueryConfig)
+	require.False(t, args.Ok)
+	require.Equal(t, DefaultNoShippingMessage, args.ErrorMessage)
+}
+
+func preCheckoutUpdate(payload string) *tgapi.Update {
+	return &tgapi.Update{PreCheckoutQuery: tgapi.NewPreCheckoutQuery("USD", tgapi.User{ID: 1}, payload, payload, 1199)}
+}
+
+func TestPreCheckout(t *testing.T) {
+	fake := &tgapi.FakeAPI{}
+	var reported []error
+	h := New(fake,
+		PreCheckout(func(ctx context.Context, query *tgapi.PreCheckoutQuery) error {
+			switch query.InvoicePayload {
+			case "out-of-stock":
+				return Reject("The pizza is out of stock")
+			case "broken":
+				return errors.New("database is down")
+			case "hang":
+				<-ctx.Done()
+				return ctx.Err()
+			}
+			return nil
+		}),
+		AnswerTimeout(10*time.Millisecond),
+		ErrorListener(func(err error) { reported = append(reported, err) }),
+	)
+
+	ctx := context.Background()
+	for _, payload := range []string{"ok", "out-of-stock", "broken", "hang"} {
+		h.HandleUpdate(ctx, preCheckoutUpdate(payload))
+	}
+	var got []tgapi.AnswerPreCheckoutQueryConfig
+	for _, call := range fake.CallsOf("answerPreCheckoutQuery") {
+		got = append(got, *call.Args[0].(*tgapi.AnswerPreCheckoutQueryConfig))
+	}
+	require.Equal(t, []tgapi.AnswerPreCheckoutQueryConfig{
+		{Ok: true, PreCheckoutQueryID: "ok"},
+		{PreCheckoutQueryID: "out-of-stock", ErrorMessage: "The pizza is out of stock"},
+		{PreCheckoutQueryID: "broken", ErrorMessage: DefaultRejectMessage},
+		{PreCheckoutQueryID: "hang", ErrorMessage: DefaultTimeoutMessage},
+	}, got)
+	require.Empty(t, reported)
+}
+
+func TestSuccessfulPayment(t *testing.T) {
+	store := NewMemoryOrderStore()
+	var paid []*Order
+	h := New(&tgapi.FakeAPI{}, Orders(store), OnPaid(func(ctx context.Context, order *Order) error {
+		paid = append(paid, order)
+		return nil
+	}))
+
+	payment := tgapi.NewSuccessfulPayment("USD", "order-42", "provider-1", "telegram-1", 1199)
+	update := &tgapi.Update{Message: &tgapi.Message{
+		Chat:              tgapi.Chat{ID: 10},
+		From:              &tgapi.User{ID: 1},
+		SuccessfulPayment: payment,
+	}}
+	// the redelivered update does not call the hook again.
+	h.HandleUpdate(context.Background(), update)
+	h.HandleUpdate(context.Background(), update)
+	require.Len(t, paid, 1)
+
+	order, ok := store.Get("telegram-1")
+	require.True(t, ok)
+	require.Equal(t, paid[0], order)
+	require.True(t, order.Fulfilled)
+	require.Equal(t, int64(10), order.ChatID)
+	require.Equal(t, int64(1), order.UserID)
+	require.Equal(t, "order-42", order.Payload)
+	require.Equal(t, "11.99", FormatAmount(order.Currency, order.TotalAmount))
+}
+
+func TestSuccessfulPaymentRetry(t *testing.T) {
+	store := NewMemoryOrderStore()
+	var (
+		fail     = true
+		paid     []string
+		reported []error
+	)
+	h := New(&tgapi.FakeAPI{}, Orders(store), OnPaid(func(ctx context.Context, order *Order) error {
+		if fail {
+			return errors.New("warehouse is down")
+		}
+		paid = append(paid, order.TelegramPaymentChargeID)
+		return nil
+	}), ErrorListener(func(err error) { reported = append(reported, err) }))
+
+	payment := func(chargeID string) *tgapi.Update {
+		return &tgapi.Update{Message: &tgapi.Message{
+			Chat:              tgapi.Chat{ID: 10},
+			SuccessfulPayment: tgapi.NewSuccessfulPayment("USD", "order", "provider", chargeID, 100),
+		}}
+	}
+	ctx := context.Background()
+	h.HandleUpdate(ctx, payment("telegram-1"))
+	h.HandleUpdate(ctx, payment("telegram-2"))
+	require.Len(t, reported, 2)
+	order, ok := store.Get("telegram-1")
+	require.True(t, ok)
+	require.False(t, order.Fulfilled)
+
+	// the redelivered update of the failed order calls the hook again.
+	fail = false
+	h.HandleUpdate(ctx, payment("telegram-1"))
+	require.Equal(t, []string{"telegram-1"}, paid)
+	require.True(t, order.Fulfilled)
+
+	require.NoError(t, h.RetryPending(ctx))
+	require.Equal(t, []string{"telegram-1", "telegram-2"}, paid)
+	require.NoError(t, h.RetryPending(ctx))
+	require.Len(t, paid, 2)
+	require.Len(t, reported, 2)
+}
@@ -0,0 +1,304 @@
+// Package predicates contains composable tgapi.AcceptFunc for routing updates with tgapi.CallTree:
+//
+//	tree := tgapi.NewCallTree(defaultHandler)
+//	tree.NewChild(predicates.And(
+//		predicates.ChatType(tgapi.ChatTypePrivate),
+//		predicates.Command(bot, "start"),
+//	), startHandler)
+//
+// All predicates are non-blocking and safe for concurrent use.
+package predicates
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/Feresey/tgbotapi/tgapi"
+)
+
+// Any accepts every update.
+func Any(*tgapi.Update) bool { return true }
+
+// And accepts an update if all of the predicates accept it.
+func And(accept ...tgapi.AcceptFunc) tgapi.AcceptFunc {
+	return func(upd *tgapi.Update) bool {
+		for _, fn := range accept {
+			if !fn(upd) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or accepts an update if any of the predicates accepts it.
+func Or(accept ...tgapi.AcceptFunc) tgapi.AcceptFunc {
+	return func(upd *tgapi.Update) bool {
+		for _, fn := range accept {
+			if fn(upd) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not inverts the predicate.
+func Not(accept tgapi.AcceptFunc) tgapi.AcceptFunc {
+	return func(upd *tgapi.Update) bool {
+		return !accept(upd)
+	}
+}
+
+// Kind accepts updates of the given kinds.
+func Kind(kinds ...tgapi.UpdateKind) tgapi.AcceptFunc {
+	return func(upd *tgapi.Update) bool {
+		kind := upd.Kind()
+		for _, k := range kinds {
+			if k == kind {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// New accepts new messages and channel posts.
+func New(upd *tgapi.Update) bool {
+	return upd.Message != nil || upd.ChannelPost != nil
+}
+
+// Edited accepts edited messages and channel posts.
+func Edited(upd *tgapi.Update) bool {
+	return upd.EditedMessage != nil || upd.EditedChannelPost != nil
+}
+
+// ChatType accepts updates from chats of the given types.
+func ChatType(types ...tgapi.ChatType) tgapi.AcceptFunc {
+	return func(upd *tgapi.Update) bool {
+		chat := upd.EffectiveChat()
+		if chat == nil {
+			return false
+		}
+		for _, typ := range types {
+			if chat.Type == typ {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// ChatID accepts updates from the given chats.
+func ChatID(ids ...int64) tgapi.AcceptFunc {
+	return func(upd *tgapi.Update) bool {
+		chat := upd.EffectiveChat()
+		return chat != nil && contains(ids, chat.ID)
+	}
+}
+
+// UserID accepts updates caused by the given users.
+func UserID(ids ...int64) tgapi.AcceptFunc {
+	return func(upd *tgapi.Update) bool {
+		user := upd.EffectiveUser()
+		return user != nil && contains(ids, user.ID)
+	}
+}
+
+func contains(ids []int64, id int64) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Command accepts messages with one of the given commands, e.g. Command(bot, "start", "help").
+// Commands are given without the leading slash. The command with the "@botname" suffix
+// is accepted only if it names the bot, so /start@other_bot in a group is rejected.
+// bot is the result of the API.GetMe.
+func Command(bot *tgapi.User, names ...string) tgapi.AcceptFunc {
+	return func(upd *tgapi.Update) bool {
+		msg := message(upd)
+		if msg == nil || !msg.IsCommand() {
+			return false
+		}
+		command := msg.CommandWithAt()
+		if i := strings.Index(command, "@"); i != -1 {
+			if bot.Username == nil || !strings.EqualFold(command[i+1:], *bot.Username) {
+				return false
+			}
+			command = command[:i]
+		}
+		for _, name := range names {
+			if strings.EqualFold(command, name) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Text accepts messages which text or caption matches the regular expression.
+func Text(re *regexp.Regexp) tgapi.AcceptFunc {
+	return func(upd *tgapi.Update) bool {
+		msg := message(upd)
+		if msg == nil {
+			return false
+		}
+		if msg.Text != nil {
+			return re.MatchString(*msg.Text)
+		}
+		if msg.Caption != nil {
+			return re.MatchString(*msg.Caption)
+		}
+		return false
+	}
+}
+
+// TextRegexp is like Text, but compiles the expression.
+// It panics if the expression cannot be parsed.
+func TextRegexp(expr string) tgapi.AcceptFunc {
+	return Text(regexp.MustCompile(expr))
+}
+
+// CallbackPrefix accepts callback queries which data starts with the prefix.
+func CallbackPrefix(prefix string) tgapi.AcceptFunc {
+	return func(upd *tgapi.Update) bool {
+		if upd.CallbackQuery == nil || upd.CallbackQuery.Data == nil {
+			return false
+		}
+		return strings.HasPrefix(*upd.CallbackQuery.Data, prefix)
+	}
+}
+
+// message returns new or edited message or channel post without the message of callback query.
+func message(upd *tgapi.Update) *tgapi.Message {
+	if upd.CallbackQuery != nil {
+		return nil
+	}
+	return upd.EffectiveMessage()
+}
+
+// Photo accepts messages with a photo.
+func Photo(upd *tgapi.Update) bool {
+	msg := message(upd)
+	return msg != nil && len(msg.Photo) != 0
+}
+
+// Document accepts messages with a document.
+func Document(upd *tgapi.Update) bool {
+	return message(upd).GetDocument() != nil
+}
+
+// Sticker accepts messages with a sticker.
+func Sticker(upd *tgapi.Update) bool {
+	return message(upd).GetSticker() != nil
+}
+
+// Location accepts messages with a location. Venues also contain a location.
+func Location(upd *tgapi.Update) bool {
+	return message(upd).GetLocation() != nil
+}
+
+// Contact accepts messages with a contact.
+func Contact(upd *tgapi.Update) bool {
+	return message(upd).GetContact() != nil
+}
+
+// Service accepts service messages: chat members changes, pinned messages,
+// forum topics events, video chats events, payments, etc.
+func Service(upd *tgapi.Update) bool {
+	msg := message(upd)
+	if msg == nil {
+		return false
+	}
+	return len(msg.NewChatMembers) != 0 ||
+		msg.LeftChatMember != nil ||
+		msg.NewChatTitle != nil ||
+		len(msg.NewChatPhoto) != 0 ||
+		msg.DeleteChatPhoto != nil ||
+		msg.GroupChatCreated != nil ||
+		msg.SupergroupChatCreated != nil ||
+		msg.ChannelChatCreated != nil ||
+		msg.MessageAutoDeleteTimerChanged != nil ||
+		msg.MigrateToChatID != nil ||
+		msg.MigrateFromChatID != nil ||
+		msg.PinnedMessage != nil ||
+		msg.SuccessfulPayment != nil ||
+		msg.UserShared != nil ||
+		msg.ChatShared != nil ||
+		msg.ConnectedWebsite != nil ||
+		msg.WriteAccessAllowed != nil ||
+		msg.ProximityAlertTriggered != nil ||
+		msg.ForumTopicCreated != nil ||
+		msg.ForumTopicEdited != nil ||
+		msg.ForumTopicClosed != nil ||
+		msg.ForumTopicReopened != nil ||
+		msg.GeneralForumTopicHidden != nil ||
+		msg.GeneralForumTopicUnhidden != nil ||
+		msg.VideoChatScheduled != nil ||
+		msg.VideoChatStarted != nil ||
+		msg.VideoChatEnded != nil ||
+		msg.VideoChatParticipantsInvited != nil ||
+		msg.WebAppData != nil
+}
+
+// Topic accepts messages from forum topics.
+// Without arguments any topic is accepted, otherwise only the given ones.
+func Topic(threadIDs ...int64) tgapi.AcceptFunc {
+	return func(upd *tgapi.Update) bool {
+		threadID := upd.EffectiveThreadID()
+		if threadID == 0 {
+			return false
+		}
+		return len(threadIDs) == 0 || contains(threadIDs, threadID)
+	}
+}
+
+// ReplyToBot accepts messages which are replies to the bot messages.
+// bot is the result of the API.GetMe.
+func ReplyToBot(bot *tgapi.User) tgapi.AcceptFunc {
+	return func(upd *tgapi.Update) bool {
+		reply := message(upd).GetReplyToMessage()
+		if reply == nil || reply.From == nil {
+			return false
+		}
+		// replies to the topic root are sent for every message in the topic.
+		if reply.ForumTopicCreated != nil {
+			return false
+		}
+		return reply.From.ID == bot.ID
+	}
+}
+
+// MentionOfBot accepts messages which mention the bot either by username or by a text mention.
+// bot is the result of the API.GetMe.
+func MentionOfBot(bot *tgapi.User) tgapi.AcceptFunc {
+	mention := "@" + bot.GetUsername()
+	return func(upd *tgapi.Update) bool {
+		msg := message(upd)
+		if msg == nil {
+			return false
+		}
+		text, entities := msg.GetText(), msg.Entities
+		if msg.Text == nil {
+			text, entities = msg.GetCaption(), msg.CaptionEntities
+		}
+		for idx := range entities {
+			entity := &entities[idx]
+			switch entity.Type {
+			case tgapi.EntityTypeMention:
+				if bot.Username != nil && strings.EqualFold(entity.Extract(text), mention) {
+					return true
+				}
+			case tgapi.EntityTypeTextMention:
+				if entity.User.GetID() == bot.ID {
+					return true
+				}
+			}
+		}
+		return false
+	}
+}
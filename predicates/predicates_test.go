@@ -0,0 +1,101 @@
+package predicates
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Feresey/tgbotapi/tgapi"
+)
+
+func update(t *testing.T, raw string) *tgapi.Update {
+	t.Helper()
+	var upd tgapi.Update
+	require.NoError(t, json.Unmarshal([]byte(raw), &upd))
+	return &upd
+}
+
+func TestPredicates(t *testing.T) {
+	username := "test_bot"
+	bot := &tgapi.User{ID: 42, IsBot: true, FirstName: "bot", Username: &username}
+
+	command := update(t, `{"update_id":1,"message":{"message_id":1,"date":0,
+		"chat":{"id":10,"type":"private"},"from":{"id":20,"is_bot":false,"first_name":"a"},
+		"text":"/start@test_bot payload","entities":[{"type":"bot_command","offset":0,"length":15}]}}`)
+	mention := update(t, `{"update_id":2,"edited_message":{"message_id":1,"date":0,
+		"chat":{"id":-100,"type":"supergroup"},"from":{"id":21,"is_bot":false,"first_name":"b"},
+		"message_thread_id":5,"is_topic_message":true,
+		"text":"привет @Test_Bot","entities":[{"type":"mention","offset":7,"length":9}],
+		"reply_to_message":{"message_id":0,"date":0,"chat":{"id":-100,"type":"supergroup"},
+		"from":{"id":42,"is_bot":true,"first_name":"bot"}}}}`)
+	callback := update(t, `{"update_id":3,"callback_query":{"id":"q","chat_instance":"i","data":"vote:1",
+		"from":{"id":20,"is_bot":false,"first_name":"a"},
+		"message":{"message_id":1,"date":0,"chat":{"id":10,"type":"private"},"photo":[]}}}`)
+	service := update(t, `{"update_id":4,"message":{"message_id":1,"date":0,
+		"chat":{"id":-100,"type":"supergroup"},"new_chat_members":[{"id":22,"is_bot":false,"first_name":"c"}]}}`)
+
+	tests := []struct {
+		name   string
+		accept tgapi.AcceptFunc
+		want   []*tgapi.Update
+	}{
+		{"new", New, []*tgapi.Update{command, service}},
+		{"edited", Edited, []*tgapi.Update{mention}},
+		{"private", ChatType(tgapi.ChatTypePrivate), []*tgapi.Update{command, callback}},
+		{"chat id", ChatID(-100), []*tgapi.Update{mention, service}},
+		{"user id", UserID(20), []*tgapi.Update{command, callback}},
+		{"command", Command(bot, "START"), []*tgapi.Update{command}},
+		{"other command", Command(bot, "help"), nil},
+		{"text", TextRegexp(`^привет`), []*tgapi.Update{mention}},
+		{"callback", CallbackPrefix("vote:"), []*tgapi.Update{callback}},
+		{"kind", Kind(tgapi.UpdateKindCallbackQuery), []*tgapi.Update{callback}},
+		{"service", Service, []*tgapi.Update{service}},
+		{"topic", Topic(), []*tgapi.Update{mention}},
+		{"other topic", Topic(6), nil},
+		{"reply", ReplyToBot(bot), []*tgapi.Update{mention}},
+		{"mention", MentionOfBot(bot), []*tgapi.Update{mention}},
+		{"and", And(New, ChatType(tgapi.ChatTypeSupergroup)), []*tgapi.Update{service}},
+		{"or", Or(Edited, Kind(tgapi.UpdateKindCallbackQuery)), []*tgapi.Update{mention, callback}},
+		{"not", Not(Any), nil},
+		{"photo", Photo, nil},
+	}
+
+	all := []*tgapi.Update{command, mention, callback, service}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var got []*tgapi.Update
+			for _, upd := range all {
+				if tt.accept(upd) {
+					got = append(got, upd)
+				}
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommandBotName(t *testing.T) {
+	username := "test_bot"
+	bot := &tgapi.User{ID: 42, IsBot: true, FirstName: "bot", Username: &username}
+	command := func(text string) *tgapi.Update {
+		raw, err := json.Marshal(text)
+		require.NoError(t, err)
+		cmdLen := len(text)
+		if i := strings.Index(text, " "); i != -1 {
+			cmdLen = i
+		}
+		return update(t, fmt.Sprintf(`{"update_id":1,"message":{"message_id":1,"date":0,
+			"chat":{"id":-100,"type":"supergroup"},"text":%s,
+			"entities":[{"type":"bot_command","offset":0,"length":%d}]}}`, raw, cmdLen))
+	}
+
+	start := Command(bot, "start")
+	require.True(t, start(command("/start")))
+	require.True(t, start(command("/start@Test_Bot arg")))
+	require.False(t, start(command("/start@other_bot")))
+	require.False(t, Command(&tgapi.User{ID: 42}, "start")(command("/start@test_bot")))
+}
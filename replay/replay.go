@@ -0,0 +1,319 @@
+// Package replay contains an http.RoundTripper which records the Bot API traffic to a cassette file
+// and replays it later, so the code using tgapi.API can be tested offline and deterministically:
+//
+//	transport, err := replay.NewReplayer("testdata/bot.cassette.json")
+//	if err != nil {
+//		return err
+//	}
+//	api := tgapi.NewWithEndpointAndClient("TOKEN", tgapi.APIEndpoint, tgapi.FileEndpoint, transport.Client())
+//
+// The bot token is redacted from the recorded URLs.
+// Interactions are matched on the HTTP method, the URL path with query and the request body.
+// Each test may call Scope with its name, so it is replayed the same way alone and with the other tests.
+package replay
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// ErrNoInteraction is returned by a replaying transport when the cassette has no matching interaction.
+var ErrNoInteraction = errors.New("no matching interaction in cassette")
+
+// Body is the payload of a request or a response.
+// JSON objects are stored as is, so the cassette is readable,
+// other text bodies are stored as strings and binary ones are base64-encoded.
+type Body struct {
+	JSON   json.RawMessage `json:"json,omitempty"`
+	Text   string          `json:"text,omitempty"`
+	Binary []byte          `json:"binary,omitempty"`
+}
+
+func newBody(raw []byte) Body {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) != 0 && (trimmed[0] == '{' || trimmed[0] == '[') && json.Valid(trimmed) {
+		return Body{JSON: append(json.RawMessage(nil), trimmed...)}
+	}
+	if utf8.Valid(raw) {
+		return Body{Text: string(raw)}
+	}
+	return Body{Binary: raw}
+}
+
+// Bytes returns the payload. JSON is returned in the compact form.
+func (b Body) Bytes() []byte {
+	switch {
+	case b.JSON != nil:
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, b.JSON); err != nil {
+			return b.JSON
+		}
+		return buf.Bytes()
+	case b.Binary != nil:
+		return b.Binary
+	default:
+		return []byte(b.Text)
+	}
+}
+
+type Request struct {
+	Method      string `json:"method"`
+	URL         string `json:"url"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        Body   `json:"body"`
+}
+
+func (r *Request) key() string {
+	return r.Method + " " + r.URL + "\n" + string(r.Body.Bytes())
+}
+
+type Response struct {
+	StatusCode  int    `json:"status_code"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        Body   `json:"body"`
+}
+
+type Interaction struct {
+	// Scope is the scope active when the interaction was recorded, see Transport.Scope.
+	Scope    string   `json:"scope,omitempty"`
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Cassette is the content of the cassette file.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Mode is the mode of the transport.
+type Mode int
+
+const (
+	// ModeReplay serves the requests from the cassette.
+	ModeReplay Mode = iota
+	// ModeRecord sends the requests to the real transport and stores them in the cassette.
+	ModeRecord
+)
+
+// Transport records or replays the HTTP interactions.
+type Transport struct {
+	mode Mode
+	path string
+	real http.RoundTripper
+
+	mu       sync.Mutex
+	scope    string
+	cassette Cassette
+	used     []bool
+}
+
+var _ http.RoundTripper = (*Transport)(nil)
+
+// NewRecorder creates a transport which sends the requests with real and records them.
+// The cassette is written to path by Save.
+func NewRecorder(path string, real http.RoundTripper) *Transport {
+	if real == nil {
+		real = http.DefaultTransport
+	}
+	return &Transport{
+		mode: ModeRecord,
+		path: path,
+		real: real,
+	}
+}
+
+// NewReplayer creates a transport which serves the requests from the cassette at path.
+func NewReplayer(path string) (*Transport, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cassette Cassette
+	if err := json.Unmarshal(raw, &cassette); err != nil {
+		return nil, fmt.Errorf("decode cassette %q: %w", path, err)
+	}
+
+	return &Transport{
+		mode:     ModeReplay,
+		path:     path,
+		cassette: cassette,
+		used:     make([]bool, len(cassette.Interactions)),
+	}, nil
+}
+
+// Mode returns the mode of the transport.
+func (t *Transport) Mode() Mode { return t.mode }
+
+// Client returns an HTTP client using the transport.
+func (t *Transport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+// Scope sets the scope of the next interactions, usually the name of the test.
+// The recorded interactions are tagged with the scope and only the interactions of the scope are replayed.
+// Setting the scope again makes its interactions available for replay from the start.
+func (t *Transport) Scope(name string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.scope = name
+	for idx := range t.used {
+		if t.cassette.Interactions[idx].Scope == name {
+			t.used[idx] = false
+		}
+	}
+}
+
+// Save writes the recorded interactions to the cassette file.
+// It does nothing in the replay mode.
+func (t *Transport) Save() error {
+	if t.mode != ModeRecord {
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	raw, err := json.MarshalIndent(t.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(t.path, append(raw, '\n'), 0600)
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorded, err := newRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.mode == ModeRecord {
+		return t.record(req, recorded)
+	}
+	return t.replay(req, recorded)
+}
+
+func (t *Transport) record(req *http.Request, recorded *Request) (*http.Response, error) {
+	resp, err := t.real.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	scope := t.scope
+	t.mu.Unlock()
+
+	interaction := Interaction{
+		Scope:   scope,
+		Request: *recorded,
+		Response: Response{
+			StatusCode:  resp.StatusCode,
+			ContentType: resp.Header.Get("Content-Type"),
+			Body:        newBody(body),
+		},
+	}
+
+	t.mu.Lock()
+	t.cassette.Interactions = append(t.cassette.Interactions, interaction)
+	t.mu.Unlock()
+
+	return interaction.Response.build(req), nil
+}
+
+func (t *Transport) replay(req *http.Request, recorded *Request) (*http.Response, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// the same requests are served in the recorded order, the last one is repeated after that.
+	key := recorded.key()
+	last := -1
+	for idx := range t.cassette.Interactions {
+		interaction := &t.cassette.Interactions[idx]
+		if interaction.Scope != t.scope || interaction.Request.key() != key {
+			continue
+		}
+		last = idx
+		if !t.used[idx] {
+			t.used[idx] = true
+			return interaction.Response.build(req), nil
+		}
+	}
+	if last != -1 {
+		return t.cassette.Interactions[last].Response.build(req), nil
+	}
+	return nil, fmt.Errorf("%w: %s %s", ErrNoInteraction, recorded.Method, recorded.URL)
+}
+
+func (r *Response) build(req *http.Request) *http.Response {
+	body := r.Body.Bytes()
+	header := make(http.Header)
+	if r.ContentType != "" {
+		header.Set("Content-Type", r.ContentType)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+var tokenRe = regexp.MustCompile(`/bot[^/]*/`)
+
+// RedactToken replaces the bot token in the URL path.
+func RedactToken(path string) string {
+	return tokenRe.ReplaceAllString(path, "/botTOKEN/")
+}
+
+const fixedBoundary = "BOUNDARY"
+
+func newRequest(req *http.Request) (*Request, error) {
+	var body []byte
+	if req.Body != nil {
+		raw, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = ioutil.NopCloser(bytes.NewReader(raw))
+		body = raw
+	}
+
+	contentType := req.Header.Get("Content-Type")
+	// multipart boundaries are random.
+	if mediaType, params, err := mime.ParseMediaType(contentType); err == nil &&
+		strings.HasPrefix(mediaType, "multipart/") && params["boundary"] != "" {
+		body = bytes.ReplaceAll(body, []byte(params["boundary"]), []byte(fixedBoundary))
+		params["boundary"] = fixedBoundary
+		contentType = mime.FormatMediaType(mediaType, params)
+	}
+
+	return &Request{
+		Method:      req.Method,
+		URL:         RedactToken(req.URL.RequestURI()),
+		ContentType: contentType,
+		Body:        newBody(body),
+	}, nil
+}
@@ -0,0 +1,110 @@
+package replay
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func upload(t *testing.T, cli *http.Client, url string, payload []byte) string {
+	t.Helper()
+	body := new(bytes.Buffer)
+	w := multipart.NewWriter(body)
+	part, err := w.CreateFormFile("document", "file.bin")
+	require.NoError(t, err)
+	_, err = part.Write(payload)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	resp, err := cli.Post(url, w.FormDataContentType(), body)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	raw, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(raw)
+}
+
+func TestRecordReplay(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"ok":true,"result":%d}`, n)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	url := server.URL + "/bot123:SECRET/sendDocument"
+	binary := []byte{0xff, 0xfe, 0x00}
+
+	recorder := NewRecorder(path, server.Client().Transport)
+	require.Equal(t, `{"ok":true,"result":1}`, upload(t, recorder.Client(), url, binary))
+	require.Equal(t, `{"ok":true,"result":2}`, upload(t, recorder.Client(), url, binary))
+	require.Equal(t, `{"ok":true,"result":3}`, upload(t, recorder.Client(), url, []byte("text")))
+	require.NoError(t, recorder.Save())
+
+	raw, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.NotContains(t, string(raw), "SECRET")
+	require.True(t, strings.Contains(string(raw), "/botTOKEN/sendDocument"))
+
+	replayer, err := NewReplayer(path)
+	require.NoError(t, err)
+	// the host and the token may differ, the order of the same requests is kept.
+	url = "http://api.example.com/botOTHER/sendDocument"
+	require.Equal(t, `{"ok":true,"result":3}`, upload(t, replayer.Client(), url, []byte("text")))
+	require.Equal(t, `{"ok":true,"result":1}`, upload(t, replayer.Client(), url, binary))
+	require.Equal(t, `{"ok":true,"result":2}`, upload(t, replayer.Client(), url, binary))
+	// the last matching interaction is repeated.
+	require.Equal(t, `{"ok":true,"result":2}`, upload(t, replayer.Client(), url, binary))
+
+	_, err = replayer.Client().Post(url, "application/json", strings.NewReader("{}"))
+	require.True(t, errors.Is(err, ErrNoInteraction))
+	require.EqualValues(t, 3, atomic.LoadInt32(&calls))
+}
+
+func TestScope(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"ok":true,"result":%d}`, atomic.AddInt32(&calls, 1))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	url := server.URL + "/botTOKEN/sendMessage"
+	post := func(cli *http.Client) string {
+		return upload(t, cli, url, []byte("text"))
+	}
+
+	recorder := NewRecorder(path, server.Client().Transport)
+	recorder.Scope("first")
+	require.Equal(t, `{"ok":true,"result":1}`, post(recorder.Client()))
+	require.Equal(t, `{"ok":true,"result":2}`, post(recorder.Client()))
+	recorder.Scope("second")
+	require.Equal(t, `{"ok":true,"result":3}`, post(recorder.Client()))
+	require.NoError(t, recorder.Save())
+
+	replayer, err := NewReplayer(path)
+	require.NoError(t, err)
+	replayer.Scope("second")
+	require.Equal(t, `{"ok":true,"result":3}`, post(replayer.Client()))
+	for i := 0; i < 2; i++ {
+		replayer.Scope("first")
+		require.Equal(t, `{"ok":true,"result":1}`, post(replayer.Client()))
+		require.Equal(t, `{"ok":true,"result":2}`, post(replayer.Client()))
+	}
+
+	replayer.Scope("third")
+	_, err = replayer.Client().Post(url, "text/plain", strings.NewReader("text"))
+	require.True(t, errors.Is(err, ErrNoInteraction))
+}
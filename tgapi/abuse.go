@@ -5,11 +5,7 @@ import (
 	"io"
 	"strconv"
 	"strings"
-)
-
-const (
-	ParseModeMarkdown = "markdown"
-	ParseModeHTML     = "html"
+	"unicode/utf16"
 )
 
 type True struct{}
@@ -17,6 +13,13 @@ type True struct{}
 func (True) MarshalText() ([]byte, error) { return []byte("true"), nil }
 func (*True) UnmarshalText([]byte) error  { return nil }
 
+// MarshalJSON and UnmarshalJSON take precedence over the text methods:
+// the API sends True as a JSON boolean, which encoding/json refuses to
+// decode into a TextUnmarshaler, e.g. "is_topic_message":true read by
+// Update.EffectiveThreadID, and expects a boolean for force_reply.
+func (True) MarshalJSON() ([]byte, error) { return []byte("true"), nil }
+func (*True) UnmarshalJSON([]byte) error  { return nil }
+
 type InputMediaGraphics interface{}
 
 type IntStr struct {
@@ -82,6 +85,17 @@ func (t *MessageEntity) IsCommand() bool {
 	return t.Type == EntityTypeBotCommand
 }
 
+// Extract returns the part of the text covered by the entity.
+// Entity offsets are counted in UTF-16 code units, not in bytes.
+func (t *MessageEntity) Extract(text string) string {
+	encoded := utf16.Encode([]rune(text))
+	begin, end := t.Offset, t.Offset+t.Length
+	if begin < 0 || begin > end || end > int64(len(encoded)) {
+		return ""
+	}
+	return string(utf16.Decode(encoded[begin:end]))
+}
+
 // IsCommand returns true if message starts with a "bot_command" entity.
 func (t *Message) IsCommand() bool {
 	if t.Entities == nil || len(t.Entities) == 0 {
@@ -6,11 +6,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
-	"mime/multipart"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
 const APIEndpoint = "https://api.telegram.org"
@@ -24,7 +23,11 @@ type Response struct {
 	ErrorCode   int                `json:"error_code,omitempty"`
 }
 
+// Error is returned when the API responds with "ok": false.
+// Use errors.Is with the Err* sentinels to classify it.
 type Error struct {
+	// Method is the name of the failed API method.
+	Method  string
 	Code    int
 	Message string
 	ResponseParameters
@@ -32,8 +35,8 @@ type Error struct {
 
 func (e Error) Error() string {
 	return fmt.Sprintf(
-		"api response. code: %d, message: %s, parameters: %v",
-		e.Code, e.Message, e.ResponseParameters)
+		"api response. method: %s, code: %d, message: %s, parameters: %v",
+		e.Method, e.Code, e.Message, e.ResponseParameters)
 }
 
 type API struct {
@@ -41,22 +44,40 @@ type API struct {
 	token        string
 	endpoint     string
 	fileEndpoint string
+
+	observer   Observer
+	validation bool
+}
+
+// APIOption is used to customize the API client.
+type APIOption func(*API)
+
+// APIObserver sets up an observer for every API method call.
+func APIObserver(observer Observer) APIOption {
+	return func(api *API) {
+		api.observer = observer
+	}
 }
 
-func NewWithEndpointAndClient(token, endpoint, fileEndpoint string, cli *http.Client) *API {
-	return &API{
+func NewWithEndpointAndClient(token, endpoint, fileEndpoint string, cli *http.Client, options ...APIOption) *API {
+	api := &API{
 		cli:          cli,
 		token:        token,
 		endpoint:     fmt.Sprintf("%s/bot%s", endpoint, token),
 		fileEndpoint: fmt.Sprintf("%s/bot%s", fileEndpoint, token),
+		observer:     NopObserver{},
+	}
+	for _, option := range options {
+		option(api)
 	}
+	return api
 }
 
-func New(token string) *API {
-	return NewWithEndpointAndClient(token, APIEndpoint, FileEndpoint, http.DefaultClient)
+func New(token string, options ...APIOption) *API {
+	return NewWithEndpointAndClient(token, APIEndpoint, FileEndpoint, http.DefaultClient, options...)
 }
 
-func (api *API) decodeAPIResponse(req *http.Request) (*Response, error) {
+func (api *API) decodeAPIResponse(method string, req *http.Request) (*Response, error) {
 	resp, err := api.cli.Do(req)
 	if err != nil {
 		var urlErr *url.Error
@@ -75,6 +96,7 @@ func (api *API) decodeAPIResponse(req *http.Request) (*Response, error) {
 
 	if !apiResp.OK {
 		return &apiResp, Error{
+			Method:             method,
 			Code:               apiResp.ErrorCode,
 			Message:            apiResp.Description,
 			ResponseParameters: apiResp.Parameters,
@@ -84,23 +106,58 @@ func (api *API) decodeAPIResponse(req *http.Request) (*Response, error) {
 	return &apiResp, nil
 }
 
+// do sends the request body to the method and observes the call.
+func (api *API) do(
+	ctx context.Context,
+	method string,
+	contentType string,
+	query url.Values,
+	body []byte,
+) (resp *Response, err error) {
+	ctx, done := api.observer.ObserveRequest(ctx, method)
+	stats := RequestStats{Method: method, Retries: RequestRetries(ctx)}
+	start := time.Now()
+	defer func() {
+		stats.Duration = time.Since(start)
+		stats.Err = err
+		var apiErr Error
+		if errors.As(err, &apiErr) {
+			stats.ErrorCode = apiErr.Code
+		}
+		done(stats)
+	}()
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("%s/%s", api.endpoint, method),
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.URL.RawQuery = query.Encode()
+	req.Header.Set("Content-Type", contentType)
+
+	return api.decodeAPIResponse(method, req)
+}
+
 // MakeRequest makes a request to a specific endpoint with our token.
+// If the validation is enabled with APIValidation, the arguments are validated before sending.
 func (api *API) MakeRequest(ctx context.Context, method string, data interface{}) (*Response, error) {
-	body, err := json.Marshal(data)
-	if err != nil {
+	if err := api.validateRequest(method, data); err != nil {
 		return nil, err
 	}
 
-	url := fmt.Sprintf("%s/%s", api.endpoint, method)
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	body, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("Content-Type", "application/json")
 
-	return api.decodeAPIResponse(req)
+	return api.do(ctx, method, "application/json", nil, body)
 }
 
+// UploadFile sends the file in the multipart body with the form field filetype, the values are sent in the query.
 func (api *API) UploadFile(
 	ctx context.Context,
 	values url.Values,
@@ -108,37 +165,5 @@ func (api *API) UploadFile(
 	filetype string,
 	file *InputFile,
 ) (*Response, error) {
-	b := new(bytes.Buffer)
-	w := multipart.NewWriter(b)
-	defer w.Close()
-
-	wr, err := w.CreateFormFile(filetype, file.Name)
-	if err != nil {
-		return nil, err
-	}
-
-	_, err = io.Copy(wr, file.Reader)
-	if err != nil {
-		return nil, err
-	}
-
-	err = w.Close()
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequestWithContext(
-		ctx,
-		http.MethodPost,
-		fmt.Sprintf("%s/%s", api.endpoint, method),
-		b,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	req.URL.RawQuery = values.Encode()
-	req.Header.Set("Content-Type", w.FormDataContentType())
-
-	return api.decodeAPIResponse(req)
+	return api.uploadFiles(ctx, method, values, map[string]*InputFile{filetype: file})
 }
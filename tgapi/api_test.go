@@ -4,33 +4,64 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"log"
 	"net/http"
 	"os"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/Feresey/tgbotapi/replay"
 )
 
+const cassettePath = "testdata/api.cassette.json"
+
+var record = flag.Bool("record", false, "record the live API traffic to "+cassettePath+" using TEST_TOKEN")
+
 func TestMain(m *testing.M) {
 	if !flag.Parsed() {
 		flag.Parse()
 	}
-	if testing.Short() {
-		return
+	// the cassette is replayed offline, so the tests run with -short as well.
+	// Only -record sends the live traffic.
+	var err error
+	if *record {
+		transport = replay.NewRecorder(cassettePath, http.DefaultTransport)
+	} else {
+		transport, err = replay.NewReplayer(cassettePath)
+		if err != nil {
+			log.Fatal(err)
+		}
 	}
+	api = NewWithEndpointAndClient(TestToken, APIEndpoint, FileEndpoint, transport.Client())
+	incorrectAPI = NewWithEndpointAndClient("MyAwesomeBotToken", APIEndpoint, FileEndpoint, transport.Client())
 
-	os.Exit(m.Run())
+	code := m.Run()
+	if err := transport.Save(); err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(code)
 }
 
 var ctx = context.Background()
 
+// transport records or replays the traffic of api and incorrectAPI.
+var transport *replay.Transport
+
+// useCassette scopes the interactions of the cassette to the test.
+func useCassette(t *testing.T) {
+	transport.Scope(t.Name())
+}
+
 func TestGetMe(t *testing.T) {
+	useCassette(t)
 	me, err := api.GetMe(ctx)
 	require.NoError(t, err)
 	require.NotEmpty(t, me)
 }
 
 func TestGetCommands(t *testing.T) {
+	useCassette(t)
 	_, err := api.GetMyCommands(ctx)
 	require.NoError(t, err)
 }
@@ -52,28 +83,42 @@ const (
 
 var ExistingDocumentFileID = "BQACAgIAAxkDAAIC3F9mU70O5BkltxDxiksePupAyPDNAAJBBwACasQ5S9ha1qz0L1inGwQ"
 
-var api = New(TestToken)
+var (
+	api          *API
+	incorrectAPI *API
+)
 
 func TestIncorrect(t *testing.T) {
-	aapi := New("MyAwesomeBotToken")
-	_, err := aapi.GetChat(ctx, NewStr("@chat"))
+	useCassette(t)
+	_, err := incorrectAPI.GetChat(ctx, NewStr("@chat"))
 	require.Error(t, err)
 
 	fmt.Println(err)
 
-	aapi = NewWithEndpointAndClient("MyAwesomeBotToken", "https://localost:8080", "", http.DefaultClient)
+	aapi := NewWithEndpointAndClient("MyAwesomeBotToken", "https://localost:8080", "", &http.Client{
+		Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+			return nil, fmt.Errorf("dial tcp: lookup localost: no such host")
+		}),
+	})
 	_, err = aapi.GetChat(ctx, NewStr("@chat"))
 	require.Error(t, err)
+	require.NotContains(t, err.Error(), "MyAwesomeBotToken")
 
 	fmt.Println(err)
 }
 
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
 func TestGetUpdates(t *testing.T) {
+	useCassette(t)
 	_, err := api.GetUpdates(ctx, nil)
 	require.NoError(t, err)
 }
 
 func TestSendWithMessage(t *testing.T) {
+	useCassette(t)
 	msg := &SendMessageConfig{
 		ChatID:    IntStr{Int: ChatID},
 		Text:      "A test message from the test library in telegram-bot-api",
@@ -87,6 +132,7 @@ func TestSendWithMessage(t *testing.T) {
 }
 
 func TestSendWithMessageReply(t *testing.T) {
+	useCassette(t)
 	msg := &SendMessageConfig{
 		ChatID:           IntStr{Int: ChatID},
 		Text:             "A test message from the test library in telegram-bot-api",
@@ -101,6 +147,7 @@ func TestSendWithMessageReply(t *testing.T) {
 }
 
 func TestSendWithMessageForward(t *testing.T) {
+	useCassette(t)
 	msg := &ForwardMessageConfig{
 		ChatID:     IntStr{Int: ChatID},
 		FromChatID: IntStr{Int: ChatID},
@@ -111,6 +158,7 @@ func TestSendWithMessageForward(t *testing.T) {
 }
 
 func TestDeleteMessage(t *testing.T) {
+	useCassette(t)
 	msg := &SendMessageConfig{
 		ChatID:    IntStr{Int: ChatID},
 		Text:      "A test message from the test library in telegram-bot-api",
@@ -195,6 +243,7 @@ func TestDeleteMessage(t *testing.T) {
 // }
 
 func TestSendWithNewDocument(t *testing.T) {
+	useCassette(t)
 	file, err := os.Open("testdata/image.jpg")
 	require.NoError(t, err)
 	defer file.Close()
@@ -210,6 +259,7 @@ func TestSendWithNewDocument(t *testing.T) {
 }
 
 func TestSendWithExistingDocument(t *testing.T) {
+	useCassette(t)
 	_, err := api.SendDocument(ctx, &SendDocumentConfig{
 		ChatID: NewInt(ChatID),
 		Document: InputFile{
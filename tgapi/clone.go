@@ -0,0 +1,33 @@
+package tgapi
+
+import "reflect"
+
+// cloneInterface clones the value of the union interface field, e.g. ReplyMarkup.
+// The API types are cloned with their Clone method, other values are returned as is.
+func cloneInterface(v interface{}) interface{} {
+	value := reflect.ValueOf(v)
+	if !value.IsValid() {
+		return v
+	}
+
+	ptr := value
+	if value.Kind() != reflect.Ptr {
+		ptr = reflect.New(value.Type())
+		ptr.Elem().Set(value)
+	}
+	clone := ptr.MethodByName("Clone")
+	if !clone.IsValid() || clone.Type().NumIn() != 0 || clone.Type().NumOut() != 1 {
+		return v
+	}
+
+	res := clone.Call(nil)[0]
+	if value.Kind() != reflect.Ptr {
+		return res.Elem().Interface()
+	}
+	return res.Interface()
+}
+
+// equalInterface compares the values of the union interface field.
+func equalInterface(a, b interface{}) bool {
+	return reflect.DeepEqual(a, b)
+}
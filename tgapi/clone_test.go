@@ -0,0 +1,73 @@
+package tgapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testKeyboard() *InlineKeyboardMarkup {
+	return NewInlineKeyboardMarkup([][]InlineKeyboardButton{
+		{*NewInlineKeyboardButton("yes").SetCallbackData("yes"), *NewInlineKeyboardButton("no").SetCallbackData("no")},
+		{*NewInlineKeyboardButton("site").SetURL("https://example.com")},
+	})
+}
+
+func TestCloneKeyboard(t *testing.T) {
+	keyboard := testKeyboard()
+	clone := keyboard.Clone()
+	require.True(t, keyboard.Equal(clone))
+	require.True(t, keyboard.Equal(testKeyboard()))
+
+	*clone.InlineKeyboard[0][0].CallbackData = "maybe"
+	clone.InlineKeyboard[1] = append(clone.InlineKeyboard[1], *NewInlineKeyboardButton("more"))
+	require.False(t, keyboard.Equal(clone))
+	require.Equal(t, "yes", keyboard.InlineKeyboard[0][0].GetCallbackData())
+	require.Len(t, keyboard.InlineKeyboard[1], 1)
+}
+
+func TestCloneMessage(t *testing.T) {
+	title := "chat"
+	msg := &Message{
+		MessageID: 1,
+		Chat:      Chat{ID: 2, Type: ChatTypeGroup, Title: &title},
+		Text:      func() *string { s := "hello"; return &s }(),
+		Entities:  []MessageEntity{*NewMessageEntity(5, 0, EntityTypeBold)},
+		ReplyToMessage: &Message{
+			MessageID: 0,
+			Chat:      Chat{ID: 2, Type: ChatTypeGroup},
+		},
+		ReplyMarkup: testKeyboard(),
+	}
+
+	clone := msg.Clone()
+	require.Equal(t, msg, clone)
+	require.True(t, msg.Equal(clone))
+
+	*clone.Chat.Title = "other"
+	clone.Entities[0].Length = 1
+	clone.ReplyToMessage.MessageID = 3
+	require.False(t, msg.Equal(clone))
+	require.Equal(t, "chat", msg.Chat.GetTitle())
+	require.Equal(t, int64(5), msg.Entities[0].Length)
+	require.Equal(t, int64(0), msg.ReplyToMessage.MessageID)
+
+	require.Nil(t, (*Message)(nil).Clone())
+	require.True(t, (*Message)(nil).Equal(nil))
+	require.False(t, msg.Equal(nil))
+}
+
+func TestCloneInterface(t *testing.T) {
+	keyboard := testKeyboard()
+
+	clone := cloneInterface(keyboard).(*InlineKeyboardMarkup)
+	require.NotSame(t, keyboard, clone)
+	require.True(t, equalInterface(keyboard, clone))
+
+	value := cloneInterface(*keyboard).(InlineKeyboardMarkup)
+	*value.InlineKeyboard[0][0].CallbackData = "maybe"
+	require.Equal(t, "yes", keyboard.InlineKeyboard[0][0].GetCallbackData())
+
+	require.Nil(t, cloneInterface(nil))
+	require.Equal(t, "text", cloneInterface("text"))
+}
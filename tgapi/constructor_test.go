@@ -0,0 +1,36 @@
+package tgapi
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigConstructor(t *testing.T) {
+	cfg := NewSendMessageConfig(IntStr{Int: 42}, "*hello*").
+		SetParseMode(ParseModeMarkdown).
+		SetDisableNotification(true).
+		SetReplyMarkup(NewInlineKeyboardMarkup([][]InlineKeyboardButton{{
+			*NewInlineKeyboardButton("ok").SetCallbackData("ok"),
+		}}))
+
+	raw, err := json.Marshal(cfg)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"chat_id": "42",
+		"text": "*hello*",
+		"parse_mode": "Markdown",
+		"disable_notification": true,
+		"reply_markup": {"inline_keyboard": [[{"text": "ok", "callback_data": "ok"}]]}
+	}`, string(raw))
+}
+
+func TestTypeConstructorFixedValue(t *testing.T) {
+	photo := NewInputMediaPhoto("attach://photo").SetCaption("caption")
+	require.Equal(t, InputTypePhoto, photo.Type)
+	require.Equal(t, "caption", photo.GetCaption())
+
+	require.Equal(t, BotTypeDefault, NewBotCommandScopeDefault().Type)
+	require.Equal(t, ChatMemberStatusCreator, NewChatMemberOwner(false, User{ID: 1}).Status)
+}
@@ -0,0 +1,20 @@
+package tgapi
+
+import "sync/atomic"
+
+var strictEnums int32
+
+// SetStrictEnums makes UnmarshalJSON of the enums return ErrIncorrectEnum for the unknown values.
+// By default the unknown values are kept, so the new values added to the API do not break the decoding.
+// The strict mode is intended for tests.
+func SetStrictEnums(strict bool) {
+	var value int32
+	if strict {
+		value = 1
+	}
+	atomic.StoreInt32(&strictEnums, value)
+}
+
+func isStrictEnums() bool {
+	return atomic.LoadInt32(&strictEnums) != 0
+}
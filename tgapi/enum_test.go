@@ -0,0 +1,76 @@
+package tgapi
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const updateWithUnknownEnums = `{
+	"update_id": 1,
+	"message": {
+		"message_id": 2,
+		"date": 0,
+		"chat": {"id": 3, "type": "secret"},
+		"text": "hello",
+		"entities": [
+			{"type": "bold", "offset": 0, "length": 1},
+			{"type": "future_entity", "offset": 1, "length": 1}
+		]
+	}
+}`
+
+func TestUnknownEnum(t *testing.T) {
+	var upd Update
+	require.NoError(t, json.Unmarshal([]byte(updateWithUnknownEnums), &upd))
+
+	chatType := upd.Message.Chat.Type
+	require.False(t, chatType.IsKnown())
+	require.Equal(t, "secret", chatType.String())
+
+	require.True(t, upd.Message.Entities[0].Type.IsKnown())
+	require.Equal(t, EntityTypeBold, upd.Message.Entities[0].Type)
+	future := upd.Message.Entities[1].Type
+	require.False(t, future.IsKnown())
+	require.Equal(t, "future_entity", future.String())
+
+	require.Equal(t, EntityType("future_entity"), future)
+
+	raw, err := json.Marshal(upd.Message.Entities)
+	require.NoError(t, err)
+	require.Contains(t, string(raw), `"type":"future_entity"`)
+
+	var zero ChatType
+	require.False(t, zero.IsKnown())
+	require.Equal(t, "", zero.String())
+}
+
+func TestUnknownUpdateKind(t *testing.T) {
+	var args GetUpdatesConfig
+	require.NoError(t, json.Unmarshal([]byte(`{"allowed_updates":["message","future_update"]}`), &args))
+	require.Equal(t, []UpdateKind{UpdateKindMessage, "future_update"}, args.AllowedUpdates)
+	require.True(t, args.AllowedUpdates[0].IsKnown())
+	require.False(t, args.AllowedUpdates[1].IsKnown())
+}
+
+func TestStrictEnums(t *testing.T) {
+	SetStrictEnums(true)
+	defer SetStrictEnums(false)
+
+	var upd Update
+	err := json.Unmarshal([]byte(updateWithUnknownEnums), &upd)
+	var enumErr ErrIncorrectEnum
+	require.True(t, errors.As(err, &enumErr), err)
+	require.Equal(t, "secret", enumErr.Value)
+
+	var args GetUpdatesConfig
+	err = json.Unmarshal([]byte(`{"allowed_updates":["message","future_update"]}`), &args)
+	require.True(t, errors.As(err, &enumErr), err)
+	require.Equal(t, "future_update", enumErr.Value)
+
+	var known EntityType
+	require.NoError(t, json.Unmarshal([]byte(`"bold"`), &known))
+	require.Equal(t, EntityTypeBold, known)
+}
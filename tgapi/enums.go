@@ -2,8 +2,13 @@
 
 package tgapi
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+)
 
+// ErrIncorrectEnum is returned by UnmarshalJSON of the enums for the values which are not listed
+// in the API documentation, if the strict mode is enabled with SetStrictEnums.
 type ErrIncorrectEnum struct {
 	Value string
 }
@@ -12,600 +17,886 @@ func (e ErrIncorrectEnum) Error() string {
 	return fmt.Sprintf("incorrect enum value: %s", e.Value)
 }
 
-type BotType int
+// BotType keeps the raw text, so the values unknown for the library are decoded as is.
+type BotType string
 
 const (
-	_ BotType = iota
+	BotTypeAllChatAdministrators BotType = "all_chat_administrators"
+	BotTypeAllGroupChats         BotType = "all_group_chats"
+	BotTypeAllPrivateChats       BotType = "all_private_chats"
+	BotTypeChat                  BotType = "chat"
+	BotTypeChatAdministrators    BotType = "chat_administrators"
+	BotTypeChatMember            BotType = "chat_member"
+	BotTypeDefault               BotType = "default"
 )
 
-var valueBotType = map[BotType]string{}
-
-var indexBotType = map[string]BotType{}
-
 func (enum BotType) String() string {
-	return valueBotType[enum]
+	return string(enum)
 }
 
-func (enum BotType) MarshalText() ([]byte, error) {
-	return []byte(enum.String()), nil
+// UnmarshalJSON returns ErrIncorrectEnum for the unknown value in the strict mode.
+func (enum *BotType) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*enum = BotType(value)
+	if isStrictEnums() && !enum.IsKnown() {
+		return ErrIncorrectEnum{value}
+	}
+	return nil
 }
 
-func (enum *BotType) UnmarshalText(src []byte) error {
-	value, ok := indexBotType[string(src)]
-	if !ok {
-		return ErrIncorrectEnum{string(src)}
+// IsKnown reports whether the value is listed in the API documentation.
+func (enum BotType) IsKnown() bool {
+	switch enum {
+	case
+		BotTypeAllChatAdministrators,
+		BotTypeAllGroupChats,
+		BotTypeAllPrivateChats,
+		BotTypeChat,
+		BotTypeChatAdministrators,
+		BotTypeChatMember,
+		BotTypeDefault:
+		return true
 	}
-	*enum = value
-	return nil
+	return false
 }
 
-type ChatType int
+// ChatAction keeps the raw text, so the values unknown for the library are decoded as is.
+type ChatAction string
 
 const (
-	_ ChatType = iota
-	ChatTypeChannel
-	ChatTypeGroup
-	ChatTypePrivate
-	ChatTypeSupergroup
+	ChatActionChooseSticker   ChatAction = "choose_sticker"
+	ChatActionFindLocation    ChatAction = "find_location"
+	ChatActionRecordVideo     ChatAction = "record_video"
+	ChatActionRecordVideoNote ChatAction = "record_video_note"
+	ChatActionRecordVoice     ChatAction = "record_voice"
+	ChatActionTyping          ChatAction = "typing"
+	ChatActionUploadDocument  ChatAction = "upload_document"
+	ChatActionUploadPhoto     ChatAction = "upload_photo"
+	ChatActionUploadVideo     ChatAction = "upload_video"
+	ChatActionUploadVideoNote ChatAction = "upload_video_note"
+	ChatActionUploadVoice     ChatAction = "upload_voice"
 )
 
-var valueChatType = map[ChatType]string{
-	ChatTypeChannel:    "channel",
-	ChatTypeGroup:      "group",
-	ChatTypePrivate:    "private",
-	ChatTypeSupergroup: "supergroup",
+func (enum ChatAction) String() string {
+	return string(enum)
 }
 
-var indexChatType = map[string]ChatType{
-	"channel":    ChatTypeChannel,
-	"group":      ChatTypeGroup,
-	"private":    ChatTypePrivate,
-	"supergroup": ChatTypeSupergroup,
+// UnmarshalJSON returns ErrIncorrectEnum for the unknown value in the strict mode.
+func (enum *ChatAction) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*enum = ChatAction(value)
+	if isStrictEnums() && !enum.IsKnown() {
+		return ErrIncorrectEnum{value}
+	}
+	return nil
 }
 
-func (enum ChatType) String() string {
-	return valueChatType[enum]
+// IsKnown reports whether the value is listed in the API documentation.
+func (enum ChatAction) IsKnown() bool {
+	switch enum {
+	case
+		ChatActionChooseSticker,
+		ChatActionFindLocation,
+		ChatActionRecordVideo,
+		ChatActionRecordVideoNote,
+		ChatActionRecordVoice,
+		ChatActionTyping,
+		ChatActionUploadDocument,
+		ChatActionUploadPhoto,
+		ChatActionUploadVideo,
+		ChatActionUploadVideoNote,
+		ChatActionUploadVoice:
+		return true
+	}
+	return false
 }
 
-func (enum ChatType) MarshalText() ([]byte, error) {
-	return []byte(enum.String()), nil
+// ChatMemberStatus keeps the raw text, so the values unknown for the library are decoded as is.
+type ChatMemberStatus string
+
+const (
+	ChatMemberStatusAdministrator ChatMemberStatus = "administrator"
+	ChatMemberStatusCreator       ChatMemberStatus = "creator"
+	ChatMemberStatusKicked        ChatMemberStatus = "kicked"
+	ChatMemberStatusLeft          ChatMemberStatus = "left"
+	ChatMemberStatusMember        ChatMemberStatus = "member"
+	ChatMemberStatusRestricted    ChatMemberStatus = "restricted"
+)
+
+func (enum ChatMemberStatus) String() string {
+	return string(enum)
 }
 
-func (enum *ChatType) UnmarshalText(src []byte) error {
-	value, ok := indexChatType[string(src)]
-	if !ok {
-		return ErrIncorrectEnum{string(src)}
+// UnmarshalJSON returns ErrIncorrectEnum for the unknown value in the strict mode.
+func (enum *ChatMemberStatus) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*enum = ChatMemberStatus(value)
+	if isStrictEnums() && !enum.IsKnown() {
+		return ErrIncorrectEnum{value}
 	}
-	*enum = value
 	return nil
 }
 
-type EncryptedType int
+// IsKnown reports whether the value is listed in the API documentation.
+func (enum ChatMemberStatus) IsKnown() bool {
+	switch enum {
+	case
+		ChatMemberStatusAdministrator,
+		ChatMemberStatusCreator,
+		ChatMemberStatusKicked,
+		ChatMemberStatusLeft,
+		ChatMemberStatusMember,
+		ChatMemberStatusRestricted:
+		return true
+	}
+	return false
+}
+
+// ChatType keeps the raw text, so the values unknown for the library are decoded as is.
+type ChatType string
 
 const (
-	_ EncryptedType = iota
-	EncryptedTypeAddress
-	EncryptedTypeBankStatement
-	EncryptedTypeDriverLicense
-	EncryptedTypeEmail
-	EncryptedTypeIDentityCard
-	EncryptedTypeInternalPassport
-	EncryptedTypePassport
-	EncryptedTypePassportRegistration
-	EncryptedTypePersonalDetails
-	EncryptedTypePhoneNumber
-	EncryptedTypeRentalAgreement
-	EncryptedTypeTemporaryRegistration
-	EncryptedTypeUtilityBill
+	ChatTypeChannel    ChatType = "channel"
+	ChatTypeGroup      ChatType = "group"
+	ChatTypePrivate    ChatType = "private"
+	ChatTypeSender     ChatType = "sender"
+	ChatTypeSupergroup ChatType = "supergroup"
 )
 
-var valueEncryptedType = map[EncryptedType]string{
-	EncryptedTypeAddress:               "address",
-	EncryptedTypeBankStatement:         "bank_statement",
-	EncryptedTypeDriverLicense:         "driver_license",
-	EncryptedTypeEmail:                 "email",
-	EncryptedTypeIDentityCard:          "identity_card",
-	EncryptedTypeInternalPassport:      "internal_passport",
-	EncryptedTypePassport:              "passport",
-	EncryptedTypePassportRegistration:  "passport_registration",
-	EncryptedTypePersonalDetails:       "personal_details",
-	EncryptedTypePhoneNumber:           "phone_number",
-	EncryptedTypeRentalAgreement:       "rental_agreement",
-	EncryptedTypeTemporaryRegistration: "temporary_registration",
-	EncryptedTypeUtilityBill:           "utility_bill",
-}
-
-var indexEncryptedType = map[string]EncryptedType{
-	"address":                EncryptedTypeAddress,
-	"bank_statement":         EncryptedTypeBankStatement,
-	"driver_license":         EncryptedTypeDriverLicense,
-	"email":                  EncryptedTypeEmail,
-	"identity_card":          EncryptedTypeIDentityCard,
-	"internal_passport":      EncryptedTypeInternalPassport,
-	"passport":               EncryptedTypePassport,
-	"passport_registration":  EncryptedTypePassportRegistration,
-	"personal_details":       EncryptedTypePersonalDetails,
-	"phone_number":           EncryptedTypePhoneNumber,
-	"rental_agreement":       EncryptedTypeRentalAgreement,
-	"temporary_registration": EncryptedTypeTemporaryRegistration,
-	"utility_bill":           EncryptedTypeUtilityBill,
-}
-
-func (enum EncryptedType) String() string {
-	return valueEncryptedType[enum]
+func (enum ChatType) String() string {
+	return string(enum)
 }
 
-func (enum EncryptedType) MarshalText() ([]byte, error) {
-	return []byte(enum.String()), nil
+// UnmarshalJSON returns ErrIncorrectEnum for the unknown value in the strict mode.
+func (enum *ChatType) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*enum = ChatType(value)
+	if isStrictEnums() && !enum.IsKnown() {
+		return ErrIncorrectEnum{value}
+	}
+	return nil
 }
 
-func (enum *EncryptedType) UnmarshalText(src []byte) error {
-	value, ok := indexEncryptedType[string(src)]
-	if !ok {
-		return ErrIncorrectEnum{string(src)}
+// IsKnown reports whether the value is listed in the API documentation.
+func (enum ChatType) IsKnown() bool {
+	switch enum {
+	case
+		ChatTypeChannel,
+		ChatTypeGroup,
+		ChatTypePrivate,
+		ChatTypeSender,
+		ChatTypeSupergroup:
+		return true
 	}
-	*enum = value
-	return nil
+	return false
 }
 
-type EntityType int
+// DiceEmoji keeps the raw text, so the values unknown for the library are decoded as is.
+type DiceEmoji string
 
 const (
-	_ EntityType = iota
-	EntityTypeBold
-	EntityTypeBotCommand
-	EntityTypeCashtag
-	EntityTypeCode
-	EntityTypeCustomEmoji
-	EntityTypeEmail
-	EntityTypeHashtag
-	EntityTypeItalic
-	EntityTypeMention
-	EntityTypePhoneNumber
-	EntityTypePre
-	EntityTypeSpoiler
-	EntityTypeStrikethrough
-	EntityTypeTextLink
-	EntityTypeTextMention
-	EntityTypeUnderline
-	EntityTypeURL
+	DiceEmojiDice        DiceEmoji = "🎲"
+	DiceEmojiDarts       DiceEmoji = "🎯"
+	DiceEmojiBowling     DiceEmoji = "🎳"
+	DiceEmojiBasketball  DiceEmoji = "🏀"
+	DiceEmojiFootball    DiceEmoji = "⚽"
+	DiceEmojiSlotMachine DiceEmoji = "🎰"
 )
 
-var valueEntityType = map[EntityType]string{
-	EntityTypeBold:          "bold",
-	EntityTypeBotCommand:    "bot_command",
-	EntityTypeCashtag:       "cashtag",
-	EntityTypeCode:          "code",
-	EntityTypeCustomEmoji:   "custom_emoji",
-	EntityTypeEmail:         "email",
-	EntityTypeHashtag:       "hashtag",
-	EntityTypeItalic:        "italic",
-	EntityTypeMention:       "mention",
-	EntityTypePhoneNumber:   "phone_number",
-	EntityTypePre:           "pre",
-	EntityTypeSpoiler:       "spoiler",
-	EntityTypeStrikethrough: "strikethrough",
-	EntityTypeTextLink:      "text_link",
-	EntityTypeTextMention:   "text_mention",
-	EntityTypeUnderline:     "underline",
-	EntityTypeURL:           "url",
-}
-
-var indexEntityType = map[string]EntityType{
-	"bold":          EntityTypeBold,
-	"bot_command":   EntityTypeBotCommand,
-	"cashtag":       EntityTypeCashtag,
-	"code":          EntityTypeCode,
-	"custom_emoji":  EntityTypeCustomEmoji,
-	"email":         EntityTypeEmail,
-	"hashtag":       EntityTypeHashtag,
-	"italic":        EntityTypeItalic,
-	"mention":       EntityTypeMention,
-	"phone_number":  EntityTypePhoneNumber,
-	"pre":           EntityTypePre,
-	"spoiler":       EntityTypeSpoiler,
-	"strikethrough": EntityTypeStrikethrough,
-	"text_link":     EntityTypeTextLink,
-	"text_mention":  EntityTypeTextMention,
-	"underline":     EntityTypeUnderline,
-	"url":           EntityTypeURL,
+func (enum DiceEmoji) String() string {
+	return string(enum)
 }
 
-func (enum EntityType) String() string {
-	return valueEntityType[enum]
-}
-
-func (enum EntityType) MarshalText() ([]byte, error) {
-	return []byte(enum.String()), nil
+// UnmarshalJSON returns ErrIncorrectEnum for the unknown value in the strict mode.
+func (enum *DiceEmoji) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*enum = DiceEmoji(value)
+	if isStrictEnums() && !enum.IsKnown() {
+		return ErrIncorrectEnum{value}
+	}
+	return nil
 }
 
-func (enum *EntityType) UnmarshalText(src []byte) error {
-	value, ok := indexEntityType[string(src)]
-	if !ok {
-		return ErrIncorrectEnum{string(src)}
+// IsKnown reports whether the value is listed in the API documentation.
+func (enum DiceEmoji) IsKnown() bool {
+	switch enum {
+	case
+		DiceEmojiDice,
+		DiceEmojiDarts,
+		DiceEmojiBowling,
+		DiceEmojiBasketball,
+		DiceEmojiFootball,
+		DiceEmojiSlotMachine:
+		return true
 	}
-	*enum = value
-	return nil
+	return false
 }
 
-type InlineType int
+// EncryptedType keeps the raw text, so the values unknown for the library are decoded as is.
+type EncryptedType string
 
 const (
-	_ InlineType = iota
-	InlineTypeArticle
-	InlineTypeAudio
-	InlineTypeContact
-	InlineTypeDocument
-	InlineTypeGame
-	InlineTypeGif
-	InlineTypeLocation
-	InlineTypeMpeg4Gif
-	InlineTypePhoto
-	InlineTypeSticker
-	InlineTypeVenue
-	InlineTypeVideo
-	InlineTypeVoice
+	EncryptedTypeAddress               EncryptedType = "address"
+	EncryptedTypeBankStatement         EncryptedType = "bank_statement"
+	EncryptedTypeDriverLicense         EncryptedType = "driver_license"
+	EncryptedTypeEmail                 EncryptedType = "email"
+	EncryptedTypeIDentityCard          EncryptedType = "identity_card"
+	EncryptedTypeInternalPassport      EncryptedType = "internal_passport"
+	EncryptedTypePassport              EncryptedType = "passport"
+	EncryptedTypePassportRegistration  EncryptedType = "passport_registration"
+	EncryptedTypePersonalDetails       EncryptedType = "personal_details"
+	EncryptedTypePhoneNumber           EncryptedType = "phone_number"
+	EncryptedTypeRentalAgreement       EncryptedType = "rental_agreement"
+	EncryptedTypeTemporaryRegistration EncryptedType = "temporary_registration"
+	EncryptedTypeUtilityBill           EncryptedType = "utility_bill"
 )
 
-var valueInlineType = map[InlineType]string{
-	InlineTypeArticle:  "article",
-	InlineTypeAudio:    "audio",
-	InlineTypeContact:  "contact",
-	InlineTypeDocument: "document",
-	InlineTypeGame:     "game",
-	InlineTypeGif:      "gif",
-	InlineTypeLocation: "location",
-	InlineTypeMpeg4Gif: "mpeg4_gif",
-	InlineTypePhoto:    "photo",
-	InlineTypeSticker:  "sticker",
-	InlineTypeVenue:    "venue",
-	InlineTypeVideo:    "video",
-	InlineTypeVoice:    "voice",
-}
-
-var indexInlineType = map[string]InlineType{
-	"article":   InlineTypeArticle,
-	"audio":     InlineTypeAudio,
-	"contact":   InlineTypeContact,
-	"document":  InlineTypeDocument,
-	"game":      InlineTypeGame,
-	"gif":       InlineTypeGif,
-	"location":  InlineTypeLocation,
-	"mpeg4_gif": InlineTypeMpeg4Gif,
-	"photo":     InlineTypePhoto,
-	"sticker":   InlineTypeSticker,
-	"venue":     InlineTypeVenue,
-	"video":     InlineTypeVideo,
-	"voice":     InlineTypeVoice,
-}
-
-func (enum InlineType) String() string {
-	return valueInlineType[enum]
+func (enum EncryptedType) String() string {
+	return string(enum)
 }
 
-func (enum InlineType) MarshalText() ([]byte, error) {
-	return []byte(enum.String()), nil
+// UnmarshalJSON returns ErrIncorrectEnum for the unknown value in the strict mode.
+func (enum *EncryptedType) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*enum = EncryptedType(value)
+	if isStrictEnums() && !enum.IsKnown() {
+		return ErrIncorrectEnum{value}
+	}
+	return nil
 }
 
-func (enum *InlineType) UnmarshalText(src []byte) error {
-	value, ok := indexInlineType[string(src)]
-	if !ok {
-		return ErrIncorrectEnum{string(src)}
+// IsKnown reports whether the value is listed in the API documentation.
+func (enum EncryptedType) IsKnown() bool {
+	switch enum {
+	case
+		EncryptedTypeAddress,
+		EncryptedTypeBankStatement,
+		EncryptedTypeDriverLicense,
+		EncryptedTypeEmail,
+		EncryptedTypeIDentityCard,
+		EncryptedTypeInternalPassport,
+		EncryptedTypePassport,
+		EncryptedTypePassportRegistration,
+		EncryptedTypePersonalDetails,
+		EncryptedTypePhoneNumber,
+		EncryptedTypeRentalAgreement,
+		EncryptedTypeTemporaryRegistration,
+		EncryptedTypeUtilityBill:
+		return true
 	}
-	*enum = value
-	return nil
+	return false
 }
 
-type InputType int
+// EntityType keeps the raw text, so the values unknown for the library are decoded as is.
+type EntityType string
 
 const (
-	_ InputType = iota
-	InputTypeAnimation
-	InputTypeAudio
-	InputTypeDocument
-	InputTypePhoto
-	InputTypeVideo
+	EntityTypeBold          EntityType = "bold"
+	EntityTypeBotCommand    EntityType = "bot_command"
+	EntityTypeCashtag       EntityType = "cashtag"
+	EntityTypeCode          EntityType = "code"
+	EntityTypeCustomEmoji   EntityType = "custom_emoji"
+	EntityTypeEmail         EntityType = "email"
+	EntityTypeHashtag       EntityType = "hashtag"
+	EntityTypeItalic        EntityType = "italic"
+	EntityTypeMention       EntityType = "mention"
+	EntityTypePhoneNumber   EntityType = "phone_number"
+	EntityTypePre           EntityType = "pre"
+	EntityTypeSpoiler       EntityType = "spoiler"
+	EntityTypeStrikethrough EntityType = "strikethrough"
+	EntityTypeTextLink      EntityType = "text_link"
+	EntityTypeTextMention   EntityType = "text_mention"
+	EntityTypeUnderline     EntityType = "underline"
+	EntityTypeURL           EntityType = "url"
 )
 
-var valueInputType = map[InputType]string{
-	InputTypeAnimation: "animation",
-	InputTypeAudio:     "audio",
-	InputTypeDocument:  "document",
-	InputTypePhoto:     "photo",
-	InputTypeVideo:     "video",
+func (enum EntityType) String() string {
+	return string(enum)
 }
 
-var indexInputType = map[string]InputType{
-	"animation": InputTypeAnimation,
-	"audio":     InputTypeAudio,
-	"document":  InputTypeDocument,
-	"photo":     InputTypePhoto,
-	"video":     InputTypeVideo,
+// UnmarshalJSON returns ErrIncorrectEnum for the unknown value in the strict mode.
+func (enum *EntityType) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*enum = EntityType(value)
+	if isStrictEnums() && !enum.IsKnown() {
+		return ErrIncorrectEnum{value}
+	}
+	return nil
 }
 
-func (enum InputType) String() string {
-	return valueInputType[enum]
+// IsKnown reports whether the value is listed in the API documentation.
+func (enum EntityType) IsKnown() bool {
+	switch enum {
+	case
+		EntityTypeBold,
+		EntityTypeBotCommand,
+		EntityTypeCashtag,
+		EntityTypeCode,
+		EntityTypeCustomEmoji,
+		EntityTypeEmail,
+		EntityTypeHashtag,
+		EntityTypeItalic,
+		EntityTypeMention,
+		EntityTypePhoneNumber,
+		EntityTypePre,
+		EntityTypeSpoiler,
+		EntityTypeStrikethrough,
+		EntityTypeTextLink,
+		EntityTypeTextMention,
+		EntityTypeUnderline,
+		EntityTypeURL:
+		return true
+	}
+	return false
 }
 
-func (enum InputType) MarshalText() ([]byte, error) {
-	return []byte(enum.String()), nil
+// InlineType keeps the raw text, so the values unknown for the library are decoded as is.
+type InlineType string
+
+const (
+	InlineTypeArticle  InlineType = "article"
+	InlineTypeAudio    InlineType = "audio"
+	InlineTypeContact  InlineType = "contact"
+	InlineTypeDocument InlineType = "document"
+	InlineTypeGame     InlineType = "game"
+	InlineTypeGif      InlineType = "gif"
+	InlineTypeLocation InlineType = "location"
+	InlineTypeMpeg4Gif InlineType = "mpeg4_gif"
+	InlineTypePhoto    InlineType = "photo"
+	InlineTypeSticker  InlineType = "sticker"
+	InlineTypeVenue    InlineType = "venue"
+	InlineTypeVideo    InlineType = "video"
+	InlineTypeVoice    InlineType = "voice"
+)
+
+func (enum InlineType) String() string {
+	return string(enum)
 }
 
-func (enum *InputType) UnmarshalText(src []byte) error {
-	value, ok := indexInputType[string(src)]
-	if !ok {
-		return ErrIncorrectEnum{string(src)}
+// UnmarshalJSON returns ErrIncorrectEnum for the unknown value in the strict mode.
+func (enum *InlineType) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*enum = InlineType(value)
+	if isStrictEnums() && !enum.IsKnown() {
+		return ErrIncorrectEnum{value}
 	}
-	*enum = value
 	return nil
 }
 
-type KeyboardButtonType int
+// IsKnown reports whether the value is listed in the API documentation.
+func (enum InlineType) IsKnown() bool {
+	switch enum {
+	case
+		InlineTypeArticle,
+		InlineTypeAudio,
+		InlineTypeContact,
+		InlineTypeDocument,
+		InlineTypeGame,
+		InlineTypeGif,
+		InlineTypeLocation,
+		InlineTypeMpeg4Gif,
+		InlineTypePhoto,
+		InlineTypeSticker,
+		InlineTypeVenue,
+		InlineTypeVideo,
+		InlineTypeVoice:
+		return true
+	}
+	return false
+}
+
+// InputType keeps the raw text, so the values unknown for the library are decoded as is.
+type InputType string
 
 const (
-	_ KeyboardButtonType = iota
-	KeyboardButtonTypeQuiz
-	KeyboardButtonTypeRegular
+	InputTypeAnimation InputType = "animation"
+	InputTypeAudio     InputType = "audio"
+	InputTypeDocument  InputType = "document"
+	InputTypePhoto     InputType = "photo"
+	InputTypeVideo     InputType = "video"
 )
 
-var valueKeyboardButtonType = map[KeyboardButtonType]string{
-	KeyboardButtonTypeQuiz:    "quiz",
-	KeyboardButtonTypeRegular: "regular",
+func (enum InputType) String() string {
+	return string(enum)
 }
 
-var indexKeyboardButtonType = map[string]KeyboardButtonType{
-	"quiz":    KeyboardButtonTypeQuiz,
-	"regular": KeyboardButtonTypeRegular,
+// UnmarshalJSON returns ErrIncorrectEnum for the unknown value in the strict mode.
+func (enum *InputType) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*enum = InputType(value)
+	if isStrictEnums() && !enum.IsKnown() {
+		return ErrIncorrectEnum{value}
+	}
+	return nil
 }
 
-func (enum KeyboardButtonType) String() string {
-	return valueKeyboardButtonType[enum]
+// IsKnown reports whether the value is listed in the API documentation.
+func (enum InputType) IsKnown() bool {
+	switch enum {
+	case
+		InputTypeAnimation,
+		InputTypeAudio,
+		InputTypeDocument,
+		InputTypePhoto,
+		InputTypeVideo:
+		return true
+	}
+	return false
 }
 
-func (enum KeyboardButtonType) MarshalText() ([]byte, error) {
-	return []byte(enum.String()), nil
+// KeyboardButtonType keeps the raw text, so the values unknown for the library are decoded as is.
+type KeyboardButtonType string
+
+const (
+	KeyboardButtonTypeQuiz    KeyboardButtonType = "quiz"
+	KeyboardButtonTypeRegular KeyboardButtonType = "regular"
+)
+
+func (enum KeyboardButtonType) String() string {
+	return string(enum)
 }
 
-func (enum *KeyboardButtonType) UnmarshalText(src []byte) error {
-	value, ok := indexKeyboardButtonType[string(src)]
-	if !ok {
-		return ErrIncorrectEnum{string(src)}
+// UnmarshalJSON returns ErrIncorrectEnum for the unknown value in the strict mode.
+func (enum *KeyboardButtonType) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*enum = KeyboardButtonType(value)
+	if isStrictEnums() && !enum.IsKnown() {
+		return ErrIncorrectEnum{value}
 	}
-	*enum = value
 	return nil
 }
 
-type MenuType int
+// IsKnown reports whether the value is listed in the API documentation.
+func (enum KeyboardButtonType) IsKnown() bool {
+	switch enum {
+	case
+		KeyboardButtonTypeQuiz,
+		KeyboardButtonTypeRegular:
+		return true
+	}
+	return false
+}
+
+// MaskPoint keeps the raw text, so the values unknown for the library are decoded as is.
+type MaskPoint string
 
 const (
-	_ MenuType = iota
+	MaskPointChin     MaskPoint = "chin"
+	MaskPointEyes     MaskPoint = "eyes"
+	MaskPointForehead MaskPoint = "forehead"
+	MaskPointMouth    MaskPoint = "mouth"
 )
 
-var valueMenuType = map[MenuType]string{}
-
-var indexMenuType = map[string]MenuType{}
-
-func (enum MenuType) String() string {
-	return valueMenuType[enum]
+func (enum MaskPoint) String() string {
+	return string(enum)
 }
 
-func (enum MenuType) MarshalText() ([]byte, error) {
-	return []byte(enum.String()), nil
+// UnmarshalJSON returns ErrIncorrectEnum for the unknown value in the strict mode.
+func (enum *MaskPoint) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*enum = MaskPoint(value)
+	if isStrictEnums() && !enum.IsKnown() {
+		return ErrIncorrectEnum{value}
+	}
+	return nil
 }
 
-func (enum *MenuType) UnmarshalText(src []byte) error {
-	value, ok := indexMenuType[string(src)]
-	if !ok {
-		return ErrIncorrectEnum{string(src)}
+// IsKnown reports whether the value is listed in the API documentation.
+func (enum MaskPoint) IsKnown() bool {
+	switch enum {
+	case
+		MaskPointChin,
+		MaskPointEyes,
+		MaskPointForehead,
+		MaskPointMouth:
+		return true
 	}
-	*enum = value
-	return nil
+	return false
 }
 
-type PassportType int
+// MenuType keeps the raw text, so the values unknown for the library are decoded as is.
+type MenuType string
 
 const (
-	_ PassportType = iota
-	PassportTypeAddress
-	PassportTypeBankStatement
-	PassportTypeDriverLicense
-	PassportTypeIDentityCard
-	PassportTypeInternalPassport
-	PassportTypePassport
-	PassportTypePassportRegistration
-	PassportTypePersonalDetails
-	PassportTypeRentalAgreement
-	PassportTypeTemporaryRegistration
-	PassportTypeUtilityBill
+	MenuTypeCommands MenuType = "commands"
+	MenuTypeDefault  MenuType = "default"
+	MenuTypeWebApp   MenuType = "web_app"
 )
 
-var valuePassportType = map[PassportType]string{
-	PassportTypeAddress:               "address",
-	PassportTypeBankStatement:         "bank_statement",
-	PassportTypeDriverLicense:         "driver_license",
-	PassportTypeIDentityCard:          "identity_card",
-	PassportTypeInternalPassport:      "internal_passport",
-	PassportTypePassport:              "passport",
-	PassportTypePassportRegistration:  "passport_registration",
-	PassportTypePersonalDetails:       "personal_details",
-	PassportTypeRentalAgreement:       "rental_agreement",
-	PassportTypeTemporaryRegistration: "temporary_registration",
-	PassportTypeUtilityBill:           "utility_bill",
-}
-
-var indexPassportType = map[string]PassportType{
-	"address":                PassportTypeAddress,
-	"bank_statement":         PassportTypeBankStatement,
-	"driver_license":         PassportTypeDriverLicense,
-	"identity_card":          PassportTypeIDentityCard,
-	"internal_passport":      PassportTypeInternalPassport,
-	"passport":               PassportTypePassport,
-	"passport_registration":  PassportTypePassportRegistration,
-	"personal_details":       PassportTypePersonalDetails,
-	"rental_agreement":       PassportTypeRentalAgreement,
-	"temporary_registration": PassportTypeTemporaryRegistration,
-	"utility_bill":           PassportTypeUtilityBill,
-}
-
-func (enum PassportType) String() string {
-	return valuePassportType[enum]
+func (enum MenuType) String() string {
+	return string(enum)
 }
 
-func (enum PassportType) MarshalText() ([]byte, error) {
-	return []byte(enum.String()), nil
+// UnmarshalJSON returns ErrIncorrectEnum for the unknown value in the strict mode.
+func (enum *MenuType) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*enum = MenuType(value)
+	if isStrictEnums() && !enum.IsKnown() {
+		return ErrIncorrectEnum{value}
+	}
+	return nil
 }
 
-func (enum *PassportType) UnmarshalText(src []byte) error {
-	value, ok := indexPassportType[string(src)]
-	if !ok {
-		return ErrIncorrectEnum{string(src)}
+// IsKnown reports whether the value is listed in the API documentation.
+func (enum MenuType) IsKnown() bool {
+	switch enum {
+	case
+		MenuTypeCommands,
+		MenuTypeDefault,
+		MenuTypeWebApp:
+		return true
 	}
-	*enum = value
-	return nil
+	return false
 }
 
-type PollType int
+// ParseMode keeps the raw text, so the values unknown for the library are decoded as is.
+type ParseMode string
 
 const (
-	_ PollType = iota
-	PollTypeQuiz
-	PollTypeRegular
+	ParseModeHTML       ParseMode = "HTML"
+	ParseModeMarkdown   ParseMode = "Markdown"
+	ParseModeMarkdownV2 ParseMode = "MarkdownV2"
 )
 
-var valuePollType = map[PollType]string{
-	PollTypeQuiz:    "quiz",
-	PollTypeRegular: "regular",
+func (enum ParseMode) String() string {
+	return string(enum)
 }
 
-var indexPollType = map[string]PollType{
-	"quiz":    PollTypeQuiz,
-	"regular": PollTypeRegular,
+// UnmarshalJSON returns ErrIncorrectEnum for the unknown value in the strict mode.
+func (enum *ParseMode) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*enum = ParseMode(value)
+	if isStrictEnums() && !enum.IsKnown() {
+		return ErrIncorrectEnum{value}
+	}
+	return nil
 }
 
-func (enum PollType) String() string {
-	return valuePollType[enum]
+// IsKnown reports whether the value is listed in the API documentation.
+func (enum ParseMode) IsKnown() bool {
+	switch enum {
+	case
+		ParseModeHTML,
+		ParseModeMarkdown,
+		ParseModeMarkdownV2:
+		return true
+	}
+	return false
 }
 
-func (enum PollType) MarshalText() ([]byte, error) {
-	return []byte(enum.String()), nil
+// PassportType keeps the raw text, so the values unknown for the library are decoded as is.
+type PassportType string
+
+const (
+	PassportTypeAddress               PassportType = "address"
+	PassportTypeBankStatement         PassportType = "bank_statement"
+	PassportTypeDriverLicense         PassportType = "driver_license"
+	PassportTypeIDentityCard          PassportType = "identity_card"
+	PassportTypeInternalPassport      PassportType = "internal_passport"
+	PassportTypePassport              PassportType = "passport"
+	PassportTypePassportRegistration  PassportType = "passport_registration"
+	PassportTypePersonalDetails       PassportType = "personal_details"
+	PassportTypeRentalAgreement       PassportType = "rental_agreement"
+	PassportTypeTemporaryRegistration PassportType = "temporary_registration"
+	PassportTypeUtilityBill           PassportType = "utility_bill"
+)
+
+func (enum PassportType) String() string {
+	return string(enum)
 }
 
-func (enum *PollType) UnmarshalText(src []byte) error {
-	value, ok := indexPollType[string(src)]
-	if !ok {
-		return ErrIncorrectEnum{string(src)}
+// UnmarshalJSON returns ErrIncorrectEnum for the unknown value in the strict mode.
+func (enum *PassportType) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*enum = PassportType(value)
+	if isStrictEnums() && !enum.IsKnown() {
+		return ErrIncorrectEnum{value}
 	}
-	*enum = value
 	return nil
 }
 
-type SendType int
+// IsKnown reports whether the value is listed in the API documentation.
+func (enum PassportType) IsKnown() bool {
+	switch enum {
+	case
+		PassportTypeAddress,
+		PassportTypeBankStatement,
+		PassportTypeDriverLicense,
+		PassportTypeIDentityCard,
+		PassportTypeInternalPassport,
+		PassportTypePassport,
+		PassportTypePassportRegistration,
+		PassportTypePersonalDetails,
+		PassportTypeRentalAgreement,
+		PassportTypeTemporaryRegistration,
+		PassportTypeUtilityBill:
+		return true
+	}
+	return false
+}
+
+// PollType keeps the raw text, so the values unknown for the library are decoded as is.
+type PollType string
 
 const (
-	_ SendType = iota
-	SendTypeQuiz
-	SendTypeRegular
+	PollTypeQuiz    PollType = "quiz"
+	PollTypeRegular PollType = "regular"
 )
 
-var valueSendType = map[SendType]string{
-	SendTypeQuiz:    "quiz",
-	SendTypeRegular: "regular",
+func (enum PollType) String() string {
+	return string(enum)
 }
 
-var indexSendType = map[string]SendType{
-	"quiz":    SendTypeQuiz,
-	"regular": SendTypeRegular,
+// UnmarshalJSON returns ErrIncorrectEnum for the unknown value in the strict mode.
+func (enum *PollType) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*enum = PollType(value)
+	if isStrictEnums() && !enum.IsKnown() {
+		return ErrIncorrectEnum{value}
+	}
+	return nil
 }
 
-func (enum SendType) String() string {
-	return valueSendType[enum]
+// IsKnown reports whether the value is listed in the API documentation.
+func (enum PollType) IsKnown() bool {
+	switch enum {
+	case
+		PollTypeQuiz,
+		PollTypeRegular:
+		return true
+	}
+	return false
 }
 
-func (enum SendType) MarshalText() ([]byte, error) {
-	return []byte(enum.String()), nil
+// SendType keeps the raw text, so the values unknown for the library are decoded as is.
+type SendType string
+
+const (
+	SendTypeQuiz    SendType = "quiz"
+	SendTypeRegular SendType = "regular"
+)
+
+func (enum SendType) String() string {
+	return string(enum)
 }
 
-func (enum *SendType) UnmarshalText(src []byte) error {
-	value, ok := indexSendType[string(src)]
-	if !ok {
-		return ErrIncorrectEnum{string(src)}
+// UnmarshalJSON returns ErrIncorrectEnum for the unknown value in the strict mode.
+func (enum *SendType) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*enum = SendType(value)
+	if isStrictEnums() && !enum.IsKnown() {
+		return ErrIncorrectEnum{value}
 	}
-	*enum = value
 	return nil
 }
 
-type StickerType int
+// IsKnown reports whether the value is listed in the API documentation.
+func (enum SendType) IsKnown() bool {
+	switch enum {
+	case
+		SendTypeQuiz,
+		SendTypeRegular:
+		return true
+	}
+	return false
+}
+
+// StickerFormat keeps the raw text, so the values unknown for the library are decoded as is.
+type StickerFormat string
 
 const (
-	_ StickerType = iota
-	StickerTypeCustomEmoji
-	StickerTypeMask
-	StickerTypeRegular
+	StickerFormatAnimated StickerFormat = "animated"
+	StickerFormatStatic   StickerFormat = "static"
+	StickerFormatVideo    StickerFormat = "video"
 )
 
-var valueStickerType = map[StickerType]string{
-	StickerTypeCustomEmoji: "custom_emoji",
-	StickerTypeMask:        "mask",
-	StickerTypeRegular:     "regular",
+func (enum StickerFormat) String() string {
+	return string(enum)
 }
 
-var indexStickerType = map[string]StickerType{
-	"custom_emoji": StickerTypeCustomEmoji,
-	"mask":         StickerTypeMask,
-	"regular":      StickerTypeRegular,
+// UnmarshalJSON returns ErrIncorrectEnum for the unknown value in the strict mode.
+func (enum *StickerFormat) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*enum = StickerFormat(value)
+	if isStrictEnums() && !enum.IsKnown() {
+		return ErrIncorrectEnum{value}
+	}
+	return nil
 }
 
-func (enum StickerType) String() string {
-	return valueStickerType[enum]
+// IsKnown reports whether the value is listed in the API documentation.
+func (enum StickerFormat) IsKnown() bool {
+	switch enum {
+	case
+		StickerFormatAnimated,
+		StickerFormatStatic,
+		StickerFormatVideo:
+		return true
+	}
+	return false
 }
 
-func (enum StickerType) MarshalText() ([]byte, error) {
-	return []byte(enum.String()), nil
+// StickerType keeps the raw text, so the values unknown for the library are decoded as is.
+type StickerType string
+
+const (
+	StickerTypeCustomEmoji StickerType = "custom_emoji"
+	StickerTypeMask        StickerType = "mask"
+	StickerTypeRegular     StickerType = "regular"
+)
+
+func (enum StickerType) String() string {
+	return string(enum)
 }
 
-func (enum *StickerType) UnmarshalText(src []byte) error {
-	value, ok := indexStickerType[string(src)]
-	if !ok {
-		return ErrIncorrectEnum{string(src)}
+// UnmarshalJSON returns ErrIncorrectEnum for the unknown value in the strict mode.
+func (enum *StickerType) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*enum = StickerType(value)
+	if isStrictEnums() && !enum.IsKnown() {
+		return ErrIncorrectEnum{value}
 	}
-	*enum = value
 	return nil
 }
 
-type StickersType int
+// IsKnown reports whether the value is listed in the API documentation.
+func (enum StickerType) IsKnown() bool {
+	switch enum {
+	case
+		StickerTypeCustomEmoji,
+		StickerTypeMask,
+		StickerTypeRegular:
+		return true
+	}
+	return false
+}
+
+// StickersType keeps the raw text, so the values unknown for the library are decoded as is.
+type StickersType string
 
 const (
-	_ StickersType = iota
-	StickersTypeCustomEmoji
-	StickersTypeMask
-	StickersTypeRegular
+	StickersTypeCustomEmoji StickersType = "custom_emoji"
+	StickersTypeMask        StickersType = "mask"
+	StickersTypeRegular     StickersType = "regular"
 )
 
-var valueStickersType = map[StickersType]string{
-	StickersTypeCustomEmoji: "custom_emoji",
-	StickersTypeMask:        "mask",
-	StickersTypeRegular:     "regular",
+func (enum StickersType) String() string {
+	return string(enum)
 }
 
-var indexStickersType = map[string]StickersType{
-	"custom_emoji": StickersTypeCustomEmoji,
-	"mask":         StickersTypeMask,
-	"regular":      StickersTypeRegular,
+// UnmarshalJSON returns ErrIncorrectEnum for the unknown value in the strict mode.
+func (enum *StickersType) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*enum = StickersType(value)
+	if isStrictEnums() && !enum.IsKnown() {
+		return ErrIncorrectEnum{value}
+	}
+	return nil
 }
 
-func (enum StickersType) String() string {
-	return valueStickersType[enum]
+// IsKnown reports whether the value is listed in the API documentation.
+func (enum StickersType) IsKnown() bool {
+	switch enum {
+	case
+		StickersTypeCustomEmoji,
+		StickersTypeMask,
+		StickersTypeRegular:
+		return true
+	}
+	return false
 }
 
-func (enum StickersType) MarshalText() ([]byte, error) {
-	return []byte(enum.String()), nil
+// ThumbnailMimeType keeps the raw text, so the values unknown for the library are decoded as is.
+type ThumbnailMimeType string
+
+const (
+	ThumbnailMimeTypeImageGif  ThumbnailMimeType = "image/gif"
+	ThumbnailMimeTypeImageJpeg ThumbnailMimeType = "image/jpeg"
+	ThumbnailMimeTypeVideoMp4  ThumbnailMimeType = "video/mp4"
+)
+
+func (enum ThumbnailMimeType) String() string {
+	return string(enum)
 }
 
-func (enum *StickersType) UnmarshalText(src []byte) error {
-	value, ok := indexStickersType[string(src)]
-	if !ok {
-		return ErrIncorrectEnum{string(src)}
+// UnmarshalJSON returns ErrIncorrectEnum for the unknown value in the strict mode.
+func (enum *ThumbnailMimeType) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*enum = ThumbnailMimeType(value)
+	if isStrictEnums() && !enum.IsKnown() {
+		return ErrIncorrectEnum{value}
 	}
-	*enum = value
 	return nil
 }
+
+// IsKnown reports whether the value is listed in the API documentation.
+func (enum ThumbnailMimeType) IsKnown() bool {
+	switch enum {
+	case
+		ThumbnailMimeTypeImageGif,
+		ThumbnailMimeTypeImageJpeg,
+		ThumbnailMimeTypeVideoMp4:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+package tgapi
+
+import (
+	"errors"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Families of API errors. An Error matches them with errors.Is:
+//
+//	if errors.Is(err, tgapi.ErrForbidden) {
+//		// the bot was blocked by the user
+//	}
+var (
+	// ErrBadRequest matches any error with code 400.
+	ErrBadRequest = errors.New("bad request")
+	// ErrForbidden matches errors with code 403: the bot was blocked by the user,
+	// kicked from the chat, the user is deactivated, etc.
+	ErrForbidden = errors.New("forbidden")
+	// ErrTooManyRequests matches flood control errors. The wait time is in Error.RetryAfter.
+	ErrTooManyRequests = errors.New("too many requests")
+	// ErrChatMigrated matches errors for a group which was upgraded to a supergroup.
+	// The new chat id is in Error.MigrateToChatID.
+	ErrChatMigrated = errors.New("chat migrated")
+	// ErrChatNotFound matches "Bad Request: chat not found".
+	ErrChatNotFound = errors.New("chat not found")
+	// ErrMessageNotModified matches "Bad Request: message is not modified".
+	ErrMessageNotModified = errors.New("message is not modified")
+	// ErrMessageToEditNotFound matches "Bad Request: message to edit not found".
+	ErrMessageToEditNotFound = errors.New("message to edit not found")
+	// ErrCantParseEntities matches "Bad Request: can't parse entities".
+	// The offset of the broken entity is returned by Error.EntityOffset.
+	ErrCantParseEntities = errors.New("can't parse entities")
+)
+
+func (e Error) hasMessage(substr string) bool {
+	return strings.Contains(strings.ToLower(e.Message), substr)
+}
+
+// Is reports whether the error belongs to the family of target.
+func (e Error) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.Code == http.StatusBadRequest
+	case ErrForbidden:
+		return e.Code == http.StatusForbidden
+	case ErrTooManyRequests:
+		return e.Code == http.StatusTooManyRequests || e.RetryAfter != nil
+	case ErrChatMigrated:
+		return e.MigrateToChatID != nil
+	case ErrChatNotFound:
+		return e.hasMessage("chat not found")
+	case ErrMessageNotModified:
+		return e.hasMessage("message is not modified")
+	case ErrMessageToEditNotFound:
+		return e.hasMessage("message to edit not found")
+	case ErrCantParseEntities:
+		return e.hasMessage("can't parse entities")
+	}
+	return false
+}
+
+var entityOffsetRe = regexp.MustCompile(`byte offset (\d+)`)
+
+// EntityOffset returns the byte offset of the entity which the API failed to parse.
+// ok is false if the error is not about entities or the offset is unknown.
+func (e Error) EntityOffset() (offset int, ok bool) {
+	if !e.Is(ErrCantParseEntities) {
+		return 0, false
+	}
+	match := entityOffsetRe.FindStringSubmatch(e.Message)
+	if match == nil {
+		return 0, false
+	}
+	offset, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0, false
+	}
+	return offset, true
+}
@@ -0,0 +1,110 @@
+package tgapi
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorIs(t *testing.T) {
+	migrateTo := int64(-1001234567890)
+	retryAfter := int64(5)
+
+	tests := []struct {
+		name    string
+		err     Error
+		matches []error
+	}{
+		{
+			name:    "blocked",
+			err:     Error{Code: 403, Message: "Forbidden: bot was blocked by the user"},
+			matches: []error{ErrForbidden},
+		},
+		{
+			name:    "chat not found",
+			err:     Error{Code: 400, Message: "Bad Request: chat not found"},
+			matches: []error{ErrBadRequest, ErrChatNotFound},
+		},
+		{
+			name: "not modified",
+			err: Error{
+				Code: 400,
+				Message: "Bad Request: message is not modified: specified new message content " +
+					"and reply markup are exactly the same as a current content and reply markup of the message",
+			},
+			matches: []error{ErrBadRequest, ErrMessageNotModified},
+		},
+		{
+			name:    "to edit not found",
+			err:     Error{Code: 400, Message: "Bad Request: message to edit not found"},
+			matches: []error{ErrBadRequest, ErrMessageToEditNotFound},
+		},
+		{
+			name: "flood",
+			err: Error{
+				Code:               429,
+				Message:            "Too Many Requests: retry after 5",
+				ResponseParameters: ResponseParameters{RetryAfter: &retryAfter},
+			},
+			matches: []error{ErrTooManyRequests},
+		},
+		{
+			name: "migrated",
+			err: Error{
+				Code:               400,
+				Message:            "Bad Request: group chat was upgraded to a supergroup chat",
+				ResponseParameters: ResponseParameters{MigrateToChatID: &migrateTo},
+			},
+			matches: []error{ErrBadRequest, ErrChatMigrated},
+		},
+	}
+
+	all := []error{
+		ErrBadRequest,
+		ErrForbidden,
+		ErrTooManyRequests,
+		ErrChatMigrated,
+		ErrChatNotFound,
+		ErrMessageNotModified,
+		ErrMessageToEditNotFound,
+		ErrCantParseEntities,
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			wrapped := fmt.Errorf("handle update: %w", tt.err)
+			for _, target := range all {
+				want := false
+				for _, match := range tt.matches {
+					if match == target {
+						want = true
+					}
+				}
+				require.Equal(t, want, errors.Is(wrapped, target), target.Error())
+			}
+
+			var apiErr Error
+			require.True(t, errors.As(wrapped, &apiErr))
+			require.Equal(t, tt.err.Code, apiErr.Code)
+		})
+	}
+}
+
+func TestErrorEntityOffset(t *testing.T) {
+	err := Error{
+		Method:  "sendMessage",
+		Code:    400,
+		Message: "Bad Request: can't parse entities: Can't find end of the entity starting at byte offset 42",
+	}
+	require.True(t, errors.Is(err, ErrCantParseEntities))
+	offset, ok := err.EntityOffset()
+	require.True(t, ok)
+	require.Equal(t, 42, offset)
+	require.Contains(t, err.Error(), "sendMessage")
+
+	_, ok = Error{Code: 400, Message: "Bad Request: chat not found"}.EntityOffset()
+	require.False(t, ok)
+}
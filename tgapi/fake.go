@@ -0,0 +1,1143 @@
+// Generated by: github.com/Feresey/gen-tgbotapi
+
+package tgapi
+
+import (
+	"context"
+	"sync"
+)
+
+// FakeCall is a method call recorded by FakeAPI.
+type FakeCall struct {
+	// Method is the name of the API method, e.g. "sendMessage".
+	Method string
+	// Args are the method arguments without the context.
+	Args []interface{}
+}
+
+// FakeAPI is the BotAPI implementation for tests.
+// Every method records the call and calls the corresponding *Func field, if it is set,
+// otherwise zero values are returned. FakeAPI is safe for concurrent use,
+// but the *Func fields must be set before the first call.
+type FakeAPI struct {
+	// DoFunc is called by Do. The call is recorded with the method of the request.
+	DoFunc                                func(ctx context.Context, req Request, result interface{}) error
+	AddStickerToSetFunc                   func(ctx context.Context, args *AddStickerToSetConfig) error
+	AnswerCallbackQueryFunc               func(ctx context.Context, args *AnswerCallbackQueryConfig) error
+	AnswerInlineQueryFunc                 func(ctx context.Context, args *AnswerInlineQueryConfig) error
+	AnswerPreCheckoutQueryFunc            func(ctx context.Context, args *AnswerPreCheckoutQueryConfig) error
+	AnswerShippingQueryFunc               func(ctx context.Context, args *AnswerShippingQueryConfig) error
+	AnswerWebAppQueryFunc                 func(ctx context.Context, result InlineQueryResult, webAppQueryID string) (*SentWebAppMessage, error)
+	ApproveChatJoinRequestFunc            func(ctx context.Context, chatID IntStr, userID int64) error
+	BanChatMemberFunc                     func(ctx context.Context, args *BanChatMemberConfig) error
+	BanChatSenderChatFunc                 func(ctx context.Context, chatID IntStr, senderChatID int64) error
+	CloseFunc                             func(ctx context.Context) error
+	CloseForumTopicFunc                   func(ctx context.Context, chatID IntStr, messageThreadID int64) error
+	CloseGeneralForumTopicFunc            func(ctx context.Context, chatID IntStr) error
+	CopyMessageFunc                       func(ctx context.Context, args *CopyMessageConfig) (*MessageID, error)
+	CreateChatInviteLinkFunc              func(ctx context.Context, args *CreateChatInviteLinkConfig) (*ChatInviteLink, error)
+	CreateForumTopicFunc                  func(ctx context.Context, args *CreateForumTopicConfig) (*ForumTopic, error)
+	CreateInvoiceLinkFunc                 func(ctx context.Context, args *CreateInvoiceLinkConfig) (string, error)
+	CreateNewStickerSetFunc               func(ctx context.Context, args *CreateNewStickerSetConfig) error
+	DeclineChatJoinRequestFunc            func(ctx context.Context, chatID IntStr, userID int64) error
+	DeleteChatPhotoFunc                   func(ctx context.Context, chatID IntStr) error
+	DeleteChatStickerSetFunc              func(ctx context.Context, chatID IntStr) error
+	DeleteForumTopicFunc                  func(ctx context.Context, chatID IntStr, messageThreadID int64) error
+	DeleteMessageFunc                     func(ctx context.Context, chatID IntStr, messageID int64) error
+	DeleteMyCommandsFunc                  func(ctx context.Context, languageCode *string, scope *BotCommandScope) error
+	DeleteStickerFromSetFunc              func(ctx context.Context, sticker string) error
+	DeleteStickerSetFunc                  func(ctx context.Context, name string) error
+	DeleteWebhookFunc                     func(ctx context.Context, dropPendingUpdates *bool) error
+	EditChatInviteLinkFunc                func(ctx context.Context, args *EditChatInviteLinkConfig) (*ChatInviteLink, error)
+	EditForumTopicFunc                    func(ctx context.Context, args *EditForumTopicConfig) error
+	EditGeneralForumTopicFunc             func(ctx context.Context, chatID IntStr, name string) error
+	EditMessageCaptionFunc                func(ctx context.Context, args *EditMessageCaptionConfig) (*Message, error)
+	EditMessageLiveLocationFunc           func(ctx context.Context, args *EditMessageLiveLocationConfig) (*Message, error)
+	EditMessageMediaFunc                  func(ctx context.Context, args *EditMessageMediaConfig) (*Message, error)
+	EditMessageReplyMarkupFunc            func(ctx context.Context, args *EditMessageReplyMarkupConfig) (*Message, error)
+	EditMessageTextFunc                   func(ctx context.Context, args *EditMessageTextConfig) (*Message, error)
+	ExportChatInviteLinkFunc              func(ctx context.Context, chatID IntStr) (string, error)
+	ForwardMessageFunc                    func(ctx context.Context, args *ForwardMessageConfig) (*Message, error)
+	GetChatFunc                           func(ctx context.Context, chatID IntStr) (*Chat, error)
+	GetChatAdministratorsFunc             func(ctx context.Context, chatID IntStr) ([]ChatMember, error)
+	GetChatMemberFunc                     func(ctx context.Context, chatID IntStr, userID int64) (*ChatMember, error)
+	GetChatMemberCountFunc                func(ctx context.Context, chatID IntStr) (int64, error)
+	GetChatMenuButtonFunc                 func(ctx context.Context, chatID *int64) (*MenuButton, error)
+	GetCustomEmojiStickersFunc            func(ctx context.Context, customEmojiIDs []string) ([]Sticker, error)
+	GetFileFunc                           func(ctx context.Context, fileID string) (*File, error)
+	GetForumTopicIconStickersFunc         func(ctx context.Context) ([]Sticker, error)
+	GetGameHighScoresFunc                 func(ctx context.Context, args *GetGameHighScoresConfig) ([]GameHighScore, error)
+	GetMeFunc                             func(ctx context.Context) (*User, error)
+	GetMyCommandsFunc                     func(ctx context.Context) ([]BotCommand, error)
+	GetMyDefaultAdministratorRightsFunc   func(ctx context.Context, forChannels *bool) (*ChatAdministratorRights, error)
+	GetMyDescriptionFunc                  func(ctx context.Context, languageCode *string) (*BotDescription, error)
+	GetMyNameFunc                         func(ctx context.Context, languageCode *string) (*BotName, error)
+	GetMyShortDescriptionFunc             func(ctx context.Context, languageCode *string) (*BotShortDescription, error)
+	GetStickerSetFunc                     func(ctx context.Context, name string) (*StickerSet, error)
+	GetUpdatesFunc                        func(ctx context.Context, args *GetUpdatesConfig) ([]Update, error)
+	GetUserProfilePhotosFunc              func(ctx context.Context, args *GetUserProfilePhotosConfig) (*UserProfilePhotos, error)
+	GetWebhookInfoFunc                    func(ctx context.Context) (*WebhookInfo, error)
+	HideGeneralForumTopicFunc             func(ctx context.Context, chatID IntStr) error
+	LeaveChatFunc                         func(ctx context.Context, chatID IntStr) error
+	LogOutFunc                            func(ctx context.Context) error
+	PinChatMessageFunc                    func(ctx context.Context, args *PinChatMessageConfig) error
+	PromoteChatMemberFunc                 func(ctx context.Context, args *PromoteChatMemberConfig) error
+	ReopenForumTopicFunc                  func(ctx context.Context, chatID IntStr, messageThreadID int64) error
+	ReopenGeneralForumTopicFunc           func(ctx context.Context, chatID IntStr) error
+	RestrictChatMemberFunc                func(ctx context.Context, args *RestrictChatMemberConfig) error
+	RevokeChatInviteLinkFunc              func(ctx context.Context, chatID IntStr, inviteLink string) (*ChatInviteLink, error)
+	SendAnimationFunc                     func(ctx context.Context, args *SendAnimationConfig) (*Message, error)
+	SendAudioFunc                         func(ctx context.Context, args *SendAudioConfig) (*Message, error)
+	SendChatActionFunc                    func(ctx context.Context, args *SendChatActionConfig) error
+	SendContactFunc                       func(ctx context.Context, args *SendContactConfig) (*Message, error)
+	SendDiceFunc                          func(ctx context.Context, args *SendDiceConfig) (*Message, error)
+	SendDocumentFunc                      func(ctx context.Context, args *SendDocumentConfig) (*Message, error)
+	SendGameFunc                          func(ctx context.Context, args *SendGameConfig) (*Message, error)
+	SendInvoiceFunc                       func(ctx context.Context, args *SendInvoiceConfig) (*Message, error)
+	SendLocationFunc                      func(ctx context.Context, args *SendLocationConfig) (*Message, error)
+	SendMediaGroupFunc                    func(ctx context.Context, args *SendMediaGroupConfig) ([]Message, error)
+	SendMessageFunc                       func(ctx context.Context, args *SendMessageConfig) (*Message, error)
+	SendPhotoFunc                         func(ctx context.Context, args *SendPhotoConfig) (*Message, error)
+	SendPollFunc                          func(ctx context.Context, args *SendPollConfig) (*Message, error)
+	SendStickerFunc                       func(ctx context.Context, args *SendStickerConfig) (*Message, error)
+	SendVenueFunc                         func(ctx context.Context, args *SendVenueConfig) (*Message, error)
+	SendVideoFunc                         func(ctx context.Context, args *SendVideoConfig) (*Message, error)
+	SendVideoNoteFunc                     func(ctx context.Context, args *SendVideoNoteConfig) (*Message, error)
+	SendVoiceFunc                         func(ctx context.Context, args *SendVoiceConfig) (*Message, error)
+	SetChatAdministratorCustomTitleFunc   func(ctx context.Context, args *SetChatAdministratorCustomTitleConfig) error
+	SetChatDescriptionFunc                func(ctx context.Context, chatID IntStr, description *string) error
+	SetChatMenuButtonFunc                 func(ctx context.Context, chatID *int64, menuButton *MenuButton) error
+	SetChatPermissionsFunc                func(ctx context.Context, args *SetChatPermissionsConfig) error
+	SetChatPhotoFunc                      func(ctx context.Context, chatID IntStr, photo InputFile) error
+	SetChatStickerSetFunc                 func(ctx context.Context, chatID IntStr, stickerSetName string) error
+	SetChatTitleFunc                      func(ctx context.Context, chatID IntStr, title string) error
+	SetCustomEmojiStickerSetThumbnailFunc func(ctx context.Context, customEmojiID *string, name string) error
+	SetGameScoreFunc                      func(ctx context.Context, args *SetGameScoreConfig) (*Message, error)
+	SetMyCommandsFunc                     func(ctx context.Context, args *SetMyCommandsConfig) error
+	SetMyDefaultAdministratorRightsFunc   func(ctx context.Context, forChannels *bool, rights *ChatAdministratorRights) error
+	SetMyDescriptionFunc                  func(ctx context.Context, description *string, languageCode *string) error
+	SetMyNameFunc                         func(ctx context.Context, languageCode *string, name *string) error
+	SetMyShortDescriptionFunc             func(ctx context.Context, languageCode *string, shortDescription *string) error
+	SetPassportDataErrorsFunc             func(ctx context.Context, errors []PassportElementError, userID int64) error
+	SetStickerEmojiListFunc               func(ctx context.Context, emojiList []string, sticker string) error
+	SetStickerKeywordsFunc                func(ctx context.Context, keywords []string, sticker string) error
+	SetStickerMaskPositionFunc            func(ctx context.Context, maskPosition *MaskPosition, sticker string) error
+	SetStickerPositionInSetFunc           func(ctx context.Context, position int64, sticker string) error
+	SetStickerSetThumbnailFunc            func(ctx context.Context, args *SetStickerSetThumbnailConfig) error
+	SetStickerSetTitleFunc                func(ctx context.Context, name string, title string) error
+	SetWebhookFunc                        func(ctx context.Context, args *SetWebhookConfig) error
+	StopMessageLiveLocationFunc           func(ctx context.Context, args *StopMessageLiveLocationConfig) (*Message, error)
+	StopPollFunc                          func(ctx context.Context, args *StopPollConfig) (*Poll, error)
+	UnbanChatMemberFunc                   func(ctx context.Context, args *UnbanChatMemberConfig) error
+	UnbanChatSenderChatFunc               func(ctx context.Context, chatID IntStr, senderChatID int64) error
+	UnhideGeneralForumTopicFunc           func(ctx context.Context, chatID IntStr) error
+	UnpinAllChatMessagesFunc              func(ctx context.Context, chatID IntStr) error
+	UnpinAllForumTopicMessagesFunc        func(ctx context.Context, chatID IntStr, messageThreadID int64) error
+	UnpinChatMessageFunc                  func(ctx context.Context, chatID IntStr, messageID *int64) error
+	UploadStickerFileFunc                 func(ctx context.Context, args *UploadStickerFileConfig) (*File, error)
+
+	mu    sync.Mutex
+	calls []FakeCall
+}
+
+var _ BotAPI = (*FakeAPI)(nil)
+
+func (f *FakeAPI) record(method string, args ...interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, FakeCall{Method: method, Args: args})
+}
+
+// Calls returns all recorded calls in the order they were made.
+func (f *FakeAPI) Calls() []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeCall(nil), f.calls...)
+}
+
+// CallsOf returns the recorded calls of the API method, e.g. CallsOf("sendMessage").
+func (f *FakeAPI) CallsOf(method string) []FakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var res []FakeCall
+	for _, call := range f.calls {
+		if call.Method == method {
+			res = append(res, call)
+		}
+	}
+	return res
+}
+
+// Reset forgets the recorded calls.
+func (f *FakeAPI) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = nil
+}
+
+// Do records the call with the method of the request and req as the only argument.
+func (f *FakeAPI) Do(ctx context.Context, req Request, result interface{}) error {
+	f.record(req.Method(), req)
+	if f.DoFunc != nil {
+		return f.DoFunc(ctx, req, result)
+	}
+	return nil
+}
+
+func (f *FakeAPI) AddStickerToSet(ctx context.Context, args *AddStickerToSetConfig) error {
+	f.record("addStickerToSet", args)
+	if f.AddStickerToSetFunc != nil {
+		return f.AddStickerToSetFunc(ctx, args)
+	}
+	return nil
+}
+
+func (f *FakeAPI) AnswerCallbackQuery(ctx context.Context, args *AnswerCallbackQueryConfig) error {
+	f.record("answerCallbackQuery", args)
+	if f.AnswerCallbackQueryFunc != nil {
+		return f.AnswerCallbackQueryFunc(ctx, args)
+	}
+	return nil
+}
+
+func (f *FakeAPI) AnswerInlineQuery(ctx context.Context, args *AnswerInlineQueryConfig) error {
+	f.record("answerInlineQuery", args)
+	if f.AnswerInlineQueryFunc != nil {
+		return f.AnswerInlineQueryFunc(ctx, args)
+	}
+	return nil
+}
+
+func (f *FakeAPI) AnswerPreCheckoutQuery(ctx context.Context, args *AnswerPreCheckoutQueryConfig) error {
+	f.record("answerPreCheckoutQuery", args)
+	if f.AnswerPreCheckoutQueryFunc != nil {
+		return f.AnswerPreCheckoutQueryFunc(ctx, args)
+	}
+	return nil
+}
+
+func (f *FakeAPI) AnswerShippingQuery(ctx context.Context, args *AnswerShippingQueryConfig) error {
+	f.record("answerShippingQuery", args)
+	if f.AnswerShippingQueryFunc != nil {
+		return f.AnswerShippingQueryFunc(ctx, args)
+	}
+	return nil
+}
+
+func (f *FakeAPI) AnswerWebAppQuery(ctx context.Context, result InlineQueryResult, webAppQueryID string) (*SentWebAppMessage, error) {
+	f.record("answerWebAppQuery", result, webAppQueryID)
+	if f.AnswerWebAppQueryFunc != nil {
+		return f.AnswerWebAppQueryFunc(ctx, result, webAppQueryID)
+	}
+	var res *SentWebAppMessage
+	return res, nil
+}
+
+func (f *FakeAPI) ApproveChatJoinRequest(ctx context.Context, chatID IntStr, userID int64) error {
+	f.record("approveChatJoinRequest", chatID, userID)
+	if f.ApproveChatJoinRequestFunc != nil {
+		return f.ApproveChatJoinRequestFunc(ctx, chatID, userID)
+	}
+	return nil
+}
+
+func (f *FakeAPI) BanChatMember(ctx context.Context, args *BanChatMemberConfig) error {
+	f.record("banChatMember", args)
+	if f.BanChatMemberFunc != nil {
+		return f.BanChatMemberFunc(ctx, args)
+	}
+	return nil
+}
+
+func (f *FakeAPI) BanChatSenderChat(ctx context.Context, chatID IntStr, senderChatID int64) error {
+	f.record("banChatSenderChat", chatID, senderChatID)
+	if f.BanChatSenderChatFunc != nil {
+		return f.BanChatSenderChatFunc(ctx, chatID, senderChatID)
+	}
+	return nil
+}
+
+func (f *FakeAPI) Close(ctx context.Context) error {
+	f.record("close")
+	if f.CloseFunc != nil {
+		return f.CloseFunc(ctx)
+	}
+	return nil
+}
+
+func (f *FakeAPI) CloseForumTopic(ctx context.Context, chatID IntStr, messageThreadID int64) error {
+	f.record("closeForumTopic", chatID, messageThreadID)
+	if f.CloseForumTopicFunc != nil {
+		return f.CloseForumTopicFunc(ctx, chatID, messageThreadID)
+	}
+	return nil
+}
+
+func (f *FakeAPI) CloseGeneralForumTopic(ctx context.Context, chatID IntStr) error {
+	f.record("closeGeneralForumTopic", chatID)
+	if f.CloseGeneralForumTopicFunc != nil {
+		return f.CloseGeneralForumTopicFunc(ctx, chatID)
+	}
+	return nil
+}
+
+func (f *FakeAPI) CopyMessage(ctx context.Context, args *CopyMessageConfig) (*MessageID, error) {
+	f.record("copyMessage", args)
+	if f.CopyMessageFunc != nil {
+		return f.CopyMessageFunc(ctx, args)
+	}
+	var res *MessageID
+	return res, nil
+}
+
+func (f *FakeAPI) CreateChatInviteLink(ctx context.Context, args *CreateChatInviteLinkConfig) (*ChatInviteLink, error) {
+	f.record("createChatInviteLink", args)
+	if f.CreateChatInviteLinkFunc != nil {
+		return f.CreateChatInviteLinkFunc(ctx, args)
+	}
+	var res *ChatInviteLink
+	return res, nil
+}
+
+func (f *FakeAPI) CreateForumTopic(ctx context.Context, args *CreateForumTopicConfig) (*ForumTopic, error) {
+	f.record("createForumTopic", args)
+	if f.CreateForumTopicFunc != nil {
+		return f.CreateForumTopicFunc(ctx, args)
+	}
+	var res *ForumTopic
+	return res, nil
+}
+
+func (f *FakeAPI) CreateInvoiceLink(ctx context.Context, args *CreateInvoiceLinkConfig) (string, error) {
+	f.record("createInvoiceLink", args)
+	if f.CreateInvoiceLinkFunc != nil {
+		return f.CreateInvoiceLinkFunc(ctx, args)
+	}
+	var res string
+	return res, nil
+}
+
+func (f *FakeAPI) CreateNewStickerSet(ctx context.Context, args *CreateNewStickerSetConfig) error {
+	f.record("createNewStickerSet", args)
+	if f.CreateNewStickerSetFunc != nil {
+		return f.CreateNewStickerSetFunc(ctx, args)
+	}
+	return nil
+}
+
+func (f *FakeAPI) DeclineChatJoinRequest(ctx context.Context, chatID IntStr, userID int64) error {
+	f.record("declineChatJoinRequest", chatID, userID)
+	if f.DeclineChatJoinRequestFunc != nil {
+		return f.DeclineChatJoinRequestFunc(ctx, chatID, userID)
+	}
+	return nil
+}
+
+func (f *FakeAPI) DeleteChatPhoto(ctx context.Context, chatID IntStr) error {
+	f.record("deleteChatPhoto", chatID)
+	if f.DeleteChatPhotoFunc != nil {
+		return f.DeleteChatPhotoFunc(ctx, chatID)
+	}
+	return nil
+}
+
+func (f *FakeAPI) DeleteChatStickerSet(ctx context.Context, chatID IntStr) error {
+	f.record("deleteChatStickerSet", chatID)
+	if f.DeleteChatStickerSetFunc != nil {
+		return f.DeleteChatStickerSetFunc(ctx, chatID)
+	}
+	return nil
+}
+
+func (f *FakeAPI) DeleteForumTopic(ctx context.Context, chatID IntStr, messageThreadID int64) error {
+	f.record("deleteForumTopic", chatID, messageThreadID)
+	if f.DeleteForumTopicFunc != nil {
+		return f.DeleteForumTopicFunc(ctx, chatID, messageThreadID)
+	}
+	return nil
+}
+
+func (f *FakeAPI) DeleteMessage(ctx context.Context, chatID IntStr, messageID int64) error {
+	f.record("deleteMessage", chatID, messageID)
+	if f.DeleteMessageFunc != nil {
+		return f.DeleteMessageFunc(ctx, chatID, messageID)
+	}
+	return nil
+}
+
+func (f *FakeAPI) DeleteMyCommands(ctx context.Context, languageCode *string, scope *BotCommandScope) error {
+	f.record("deleteMyCommands", languageCode, scope)
+	if f.DeleteMyCommandsFunc != nil {
+		return f.DeleteMyCommandsFunc(ctx, languageCode, scope)
+	}
+	return nil
+}
+
+func (f *FakeAPI) DeleteStickerFromSet(ctx context.Context, sticker string) error {
+	f.record("deleteStickerFromSet", sticker)
+	if f.DeleteStickerFromSetFunc != nil {
+		return f.DeleteStickerFromSetFunc(ctx, sticker)
+	}
+	return nil
+}
+
+func (f *FakeAPI) DeleteStickerSet(ctx context.Context, name string) error {
+	f.record("deleteStickerSet", name)
+	if f.DeleteStickerSetFunc != nil {
+		return f.DeleteStickerSetFunc(ctx, name)
+	}
+	return nil
+}
+
+func (f *FakeAPI) DeleteWebhook(ctx context.Context, dropPendingUpdates *bool) error {
+	f.record("deleteWebhook", dropPendingUpdates)
+	if f.DeleteWebhookFunc != nil {
+		return f.DeleteWebhookFunc(ctx, dropPendingUpdates)
+	}
+	return nil
+}
+
+func (f *FakeAPI) EditChatInviteLink(ctx context.Context, args *EditChatInviteLinkConfig) (*ChatInviteLink, error) {
+	f.record("editChatInviteLink", args)
+	if f.EditChatInviteLinkFunc != nil {
+		return f.EditChatInviteLinkFunc(ctx, args)
+	}
+	var res *ChatInviteLink
+	return res, nil
+}
+
+func (f *FakeAPI) EditForumTopic(ctx context.Context, args *EditForumTopicConfig) error {
+	f.record("editForumTopic", args)
+	if f.EditForumTopicFunc != nil {
+		return f.EditForumTopicFunc(ctx, args)
+	}
+	return nil
+}
+
+func (f *FakeAPI) EditGeneralForumTopic(ctx context.Context, chatID IntStr, name string) error {
+	f.record("editGeneralForumTopic", chatID, name)
+	if f.EditGeneralForumTopicFunc != nil {
+		return f.EditGeneralForumTopicFunc(ctx, chatID, name)
+	}
+	return nil
+}
+
+func (f *FakeAPI) EditMessageCaption(ctx context.Context, args *EditMessageCaptionConfig) (*Message, error) {
+	f.record("editMessageCaption", args)
+	if f.EditMessageCaptionFunc != nil {
+		return f.EditMessageCaptionFunc(ctx, args)
+	}
+	var res *Message
+	return res, nil
+}
+
+func (f *FakeAPI) EditMessageLiveLocation(ctx context.Context, args *EditMessageLiveLocationConfig) (*Message, error) {
+	f.record("editMessageLiveLocation", args)
+	if f.EditMessageLiveLocationFunc != nil {
+		return f.EditMessageLiveLocationFunc(ctx, args)
+	}
+	var res *Message
+	return res, nil
+}
+
+func (f *FakeAPI) EditMessageMedia(ctx context.Context, args *EditMessageMediaConfig) (*Message, error) {
+	f.record("editMessageMedia", args)
+	if f.EditMessageMediaFunc != nil {
+		return f.EditMessageMediaFunc(ctx, args)
+	}
+	var res *Message
+	return res, nil
+}
+
+func (f *FakeAPI) EditMessageReplyMarkup(ctx context.Context, args *EditMessageReplyMarkupConfig) (*Message, error) {
+	f.record("editMessageReplyMarkup", args)
+	if f.EditMessageReplyMarkupFunc != nil {
+		return f.EditMessageReplyMarkupFunc(ctx, args)
+	}
+	var res *Message
+	return res, nil
+}
+
+func (f *FakeAPI) EditMessageText(ctx context.Context, args *EditMessageTextConfig) (*Message, error) {
+	f.record("editMessageText", args)
+	if f.EditMessageTextFunc != nil {
+		return f.EditMessageTextFunc(ctx, args)
+	}
+	var res *Message
+	return res, nil
+}
+
+func (f *FakeAPI) ExportChatInviteLink(ctx context.Context, chatID IntStr) (string, error) {
+	f.record("exportChatInviteLink", chatID)
+	if f.ExportChatInviteLinkFunc != nil {
+		return f.ExportChatInviteLinkFunc(ctx, chatID)
+	}
+	var res string
+	return res, nil
+}
+
+func (f *FakeAPI) ForwardMessage(ctx context.Context, args *ForwardMessageConfig) (*Message, error) {
+	f.record("forwardMessage", args)
+	if f.ForwardMessageFunc != nil {
+		return f.ForwardMessageFunc(ctx, args)
+	}
+	var res *Message
+	return res, nil
+}
+
+func (f *FakeAPI) GetChat(ctx context.Context, chatID IntStr) (*Chat, error) {
+	f.record("getChat", chatID)
+	if f.GetChatFunc != nil {
+		return f.GetChatFunc(ctx, chatID)
+	}
+	var res *Chat
+	return res, nil
+}
+
+func (f *FakeAPI) GetChatAdministrators(ctx context.Context, chatID IntStr) ([]ChatMember, error) {
+	f.record("getChatAdministrators", chatID)
+	if f.GetChatAdministratorsFunc != nil {
+		return f.GetChatAdministratorsFunc(ctx, chatID)
+	}
+	var res []ChatMember
+	return res, nil
+}
+
+func (f *FakeAPI) GetChatMember(ctx context.Context, chatID IntStr, userID int64) (*ChatMember, error) {
+	f.record("getChatMember", chatID, userID)
+	if f.GetChatMemberFunc != nil {
+		return f.GetChatMemberFunc(ctx, chatID, userID)
+	}
+	var res *ChatMember
+	return res, nil
+}
+
+func (f *FakeAPI) GetChatMemberCount(ctx context.Context, chatID IntStr) (int64, error) {
+	f.record("getChatMemberCount", chatID)
+	if f.GetChatMemberCountFunc != nil {
+		return f.GetChatMemberCountFunc(ctx, chatID)
+	}
+	var res int64
+	return res, nil
+}
+
+func (f *FakeAPI) GetChatMenuButton(ctx context.Context, chatID *int64) (*MenuButton, error) {
+	f.record("getChatMenuButton", chatID)
+	if f.GetChatMenuButtonFunc != nil {
+		return f.GetChatMenuButtonFunc(ctx, chatID)
+	}
+	var res *MenuButton
+	return res, nil
+}
+
+func (f *FakeAPI) GetCustomEmojiStickers(ctx context.Context, customEmojiIDs []string) ([]Sticker, error) {
+	f.record("getCustomEmojiStickers", customEmojiIDs)
+	if f.GetCustomEmojiStickersFunc != nil {
+		return f.GetCustomEmojiStickersFunc(ctx, customEmojiIDs)
+	}
+	var res []Sticker
+	return res, nil
+}
+
+func (f *FakeAPI) GetFile(ctx context.Context, fileID string) (*File, error) {
+	f.record("getFile", fileID)
+	if f.GetFileFunc != nil {
+		return f.GetFileFunc(ctx, fileID)
+	}
+	var res *File
+	return res, nil
+}
+
+func (f *FakeAPI) GetForumTopicIconStickers(ctx context.Context) ([]Sticker, error) {
+	f.record("getForumTopicIconStickers")
+	if f.GetForumTopicIconStickersFunc != nil {
+		return f.GetForumTopicIconStickersFunc(ctx)
+	}
+	var res []Sticker
+	return res, nil
+}
+
+func (f *FakeAPI) GetGameHighScores(ctx context.Context, args *GetGameHighScoresConfig) ([]GameHighScore, error) {
+	f.record("getGameHighScores", args)
+	if f.GetGameHighScoresFunc != nil {
+		return f.GetGameHighScoresFunc(ctx, args)
+	}
+	var res []GameHighScore
+	return res, nil
+}
+
+func (f *FakeAPI) GetMe(ctx context.Context) (*User, error) {
+	f.record("getMe")
+	if f.GetMeFunc != nil {
+		return f.GetMeFunc(ctx)
+	}
+	var res *User
+	return res, nil
+}
+
+func (f *FakeAPI) GetMyCommands(ctx context.Context) ([]BotCommand, error) {
+	f.record("getMyCommands")
+	if f.GetMyCommandsFunc != nil {
+		return f.GetMyCommandsFunc(ctx)
+	}
+	var res []BotCommand
+	return res, nil
+}
+
+func (f *FakeAPI) GetMyDefaultAdministratorRights(ctx context.Context, forChannels *bool) (*ChatAdministratorRights, error) {
+	f.record("getMyDefaultAdministratorRights", forChannels)
+	if f.GetMyDefaultAdministratorRightsFunc != nil {
+		return f.GetMyDefaultAdministratorRightsFunc(ctx, forChannels)
+	}
+	var res *ChatAdministratorRights
+	return res, nil
+}
+
+func (f *FakeAPI) GetMyDescription(ctx context.Context, languageCode *string) (*BotDescription, error) {
+	f.record("getMyDescription", languageCode)
+	if f.GetMyDescriptionFunc != nil {
+		return f.GetMyDescriptionFunc(ctx, languageCode)
+	}
+	var res *BotDescription
+	return res, nil
+}
+
+func (f *FakeAPI) GetMyName(ctx context.Context, languageCode *string) (*BotName, error) {
+	f.record("getMyName", languageCode)
+	if f.GetMyNameFunc != nil {
+		return f.GetMyNameFunc(ctx, languageCode)
+	}
+	var res *BotName
+	return res, nil
+}
+
+func (f *FakeAPI) GetMyShortDescription(ctx context.Context, languageCode *string) (*BotShortDescription, error) {
+	f.record("getMyShortDescription", languageCode)
+	if f.GetMyShortDescriptionFunc != nil {
+		return f.GetMyShortDescriptionFunc(ctx, languageCode)
+	}
+	var res *BotShortDescription
+	return res, nil
+}
+
+func (f *FakeAPI) GetStickerSet(ctx context.Context, name string) (*StickerSet, error) {
+	f.record("getStickerSet", name)
+	if f.GetStickerSetFunc != nil {
+		return f.GetStickerSetFunc(ctx, name)
+	}
+	var res *StickerSet
+	return res, nil
+}
+
+func (f *FakeAPI) GetUpdates(ctx context.Context, args *GetUpdatesConfig) ([]Update, error) {
+	f.record("getUpdates", args)
+	if f.GetUpdatesFunc != nil {
+		return f.GetUpdatesFunc(ctx, args)
+	}
+	var res []Update
+	return res, nil
+}
+
+func (f *FakeAPI) GetUserProfilePhotos(ctx context.Context, args *GetUserProfilePhotosConfig) (*UserProfilePhotos, error) {
+	f.record("getUserProfilePhotos", args)
+	if f.GetUserProfilePhotosFunc != nil {
+		return f.GetUserProfilePhotosFunc(ctx, args)
+	}
+	var res *UserProfilePhotos
+	return res, nil
+}
+
+func (f *FakeAPI) GetWebhookInfo(ctx context.Context) (*WebhookInfo, error) {
+	f.record("getWebhookInfo")
+	if f.GetWebhookInfoFunc != nil {
+		return f.GetWebhookInfoFunc(ctx)
+	}
+	var res *WebhookInfo
+	return res, nil
+}
+
+func (f *FakeAPI) HideGeneralForumTopic(ctx context.Context, chatID IntStr) error {
+	f.record("hideGeneralForumTopic", chatID)
+	if f.HideGeneralForumTopicFunc != nil {
+		return f.HideGeneralForumTopicFunc(ctx, chatID)
+	}
+	return nil
+}
+
+func (f *FakeAPI) LeaveChat(ctx context.Context, chatID IntStr) error {
+	f.record("leaveChat", chatID)
+	if f.LeaveChatFunc != nil {
+		return f.LeaveChatFunc(ctx, chatID)
+	}
+	return nil
+}
+
+func (f *FakeAPI) LogOut(ctx context.Context) error {
+	f.record("logOut")
+	if f.LogOutFunc != nil {
+		return f.LogOutFunc(ctx)
+	}
+	return nil
+}
+
+func (f *FakeAPI) PinChatMessage(ctx context.Context, args *PinChatMessageConfig) error {
+	f.record("pinChatMessage", args)
+	if f.PinChatMessageFunc != nil {
+		return f.PinChatMessageFunc(ctx, args)
+	}
+	return nil
+}
+
+func (f *FakeAPI) PromoteChatMember(ctx context.Context, args *PromoteChatMemberConfig) error {
+	f.record("promoteChatMember", args)
+	if f.PromoteChatMemberFunc != nil {
+		return f.PromoteChatMemberFunc(ctx, args)
+	}
+	return nil
+}
+
+func (f *FakeAPI) ReopenForumTopic(ctx context.Context, chatID IntStr, messageThreadID int64) error {
+	f.record("reopenForumTopic", chatID, messageThreadID)
+	if f.ReopenForumTopicFunc != nil {
+		return f.ReopenForumTopicFunc(ctx, chatID, messageThreadID)
+	}
+	return nil
+}
+
+func (f *FakeAPI) ReopenGeneralForumTopic(ctx context.Context, chatID IntStr) error {
+	f.record("reopenGeneralForumTopic", chatID)
+	if f.ReopenGeneralForumTopicFunc != nil {
+		return f.ReopenGeneralForumTopicFunc(ctx, chatID)
+	}
+	return nil
+}
+
+func (f *FakeAPI) RestrictChatMember(ctx context.Context, args *RestrictChatMemberConfig) error {
+	f.record("restrictChatMember", args)
+	if f.RestrictChatMemberFunc != nil {
+		return f.RestrictChatMemberFunc(ctx, args)
+	}
+	return nil
+}
+
+func (f *FakeAPI) RevokeChatInviteLink(ctx context.Context, chatID IntStr, inviteLink string) (*ChatInviteLink, error) {
+	f.record("revokeChatInviteLink", chatID, inviteLink)
+	if f.RevokeChatInviteLinkFunc != nil {
+		return f.RevokeChatInviteLinkFunc(ctx, chatID, inviteLink)
+	}
+	var res *ChatInviteLink
+	return res, nil
+}
+
+func (f *FakeAPI) SendAnimation(ctx context.Context, args *SendAnimationConfig) (*Message, error) {
+	f.record("sendAnimation", args)
+	if f.SendAnimationFunc != nil {
+		return f.SendAnimationFunc(ctx, args)
+	}
+	var res *Message
+	return res, nil
+}
+
+func (f *FakeAPI) SendAudio(ctx context.Context, args *SendAudioConfig) (*Message, error) {
+	f.record("sendAudio", args)
+	if f.SendAudioFunc != nil {
+		return f.SendAudioFunc(ctx, args)
+	}
+	var res *Message
+	return res, nil
+}
+
+func (f *FakeAPI) SendChatAction(ctx context.Context, args *SendChatActionConfig) error {
+	f.record("sendChatAction", args)
+	if f.SendChatActionFunc != nil {
+		return f.SendChatActionFunc(ctx, args)
+	}
+	return nil
+}
+
+func (f *FakeAPI) SendContact(ctx context.Context, args *SendContactConfig) (*Message, error) {
+	f.record("sendContact", args)
+	if f.SendContactFunc != nil {
+		return f.SendContactFunc(ctx, args)
+	}
+	var res *Message
+	return res, nil
+}
+
+func (f *FakeAPI) SendDice(ctx context.Context, args *SendDiceConfig) (*Message, error) {
+	f.record("sendDice", args)
+	if f.SendDiceFunc != nil {
+		return f.SendDiceFunc(ctx, args)
+	}
+	var res *Message
+	return res, nil
+}
+
+func (f *FakeAPI) SendDocument(ctx context.Context, args *SendDocumentConfig) (*Message, error) {
+	f.record("sendDocument", args)
+	if f.SendDocumentFunc != nil {
+		return f.SendDocumentFunc(ctx, args)
+	}
+	var res *Message
+	return res, nil
+}
+
+func (f *FakeAPI) SendGame(ctx context.Context, args *SendGameConfig) (*Message, error) {
+	f.record("sendGame", args)
+	if f.SendGameFunc != nil {
+		return f.SendGameFunc(ctx, args)
+	}
+	var res *Message
+	return res, nil
+}
+
+func (f *FakeAPI) SendInvoice(ctx context.Context, args *SendInvoiceConfig) (*Message, error) {
+	f.record("sendInvoice", args)
+	if f.SendInvoiceFunc != nil {
+		return f.SendInvoiceFunc(ctx, args)
+	}
+	var res *Message
+	return res, nil
+}
+
+func (f *FakeAPI) SendLocation(ctx context.Context, args *SendLocationConfig) (*Message, error) {
+	f.record("sendLocation", args)
+	if f.SendLocationFunc != nil {
+		return f.SendLocationFunc(ctx, args)
+	}
+	var res *Message
+	return res, nil
+}
+
+func (f *FakeAPI) SendMediaGroup(ctx context.Context, args *SendMediaGroupConfig) ([]Message, error) {
+	f.record("sendMediaGroup", args)
+	if f.SendMediaGroupFunc != nil {
+		return f.SendMediaGroupFunc(ctx, args)
+	}
+	var res []Message
+	return res, nil
+}
+
+func (f *FakeAPI) SendMessage(ctx context.Context, args *SendMessageConfig) (*Message, error) {
+	f.record("sendMessage", args)
+	if f.SendMessageFunc != nil {
+		return f.SendMessageFunc(ctx, args)
+	}
+	var res *Message
+	return res, nil
+}
+
+func (f *FakeAPI) SendPhoto(ctx context.Context, args *SendPhotoConfig) (*Message, error) {
+	f.record("sendPhoto", args)
+	if f.SendPhotoFunc != nil {
+		return f.SendPhotoFunc(ctx, args)
+	}
+	var res *Message
+	return res, nil
+}
+
+func (f *FakeAPI) SendPoll(ctx context.Context, args *SendPollConfig) (*Message, error) {
+	f.record("sendPoll", args)
+	if f.SendPollFunc != nil {
+		return f.SendPollFunc(ctx, args)
+	}
+	var res *Message
+	return res, nil
+}
+
+func (f *FakeAPI) SendSticker(ctx context.Context, args *SendStickerConfig) (*Message, error) {
+	f.record("sendSticker", args)
+	if f.SendStickerFunc != nil {
+		return f.SendStickerFunc(ctx, args)
+	}
+	var res *Message
+	return res, nil
+}
+
+func (f *FakeAPI) SendVenue(ctx context.Context, args *SendVenueConfig) (*Message, error) {
+	f.record("sendVenue", args)
+	if f.SendVenueFunc != nil {
+		return f.SendVenueFunc(ctx, args)
+	}
+	var res *Message
+	return res, nil
+}
+
+func (f *FakeAPI) SendVideo(ctx context.Context, args *SendVideoConfig) (*Message, error) {
+	f.record("sendVideo", args)
+	if f.SendVideoFunc != nil {
+		return f.SendVideoFunc(ctx, args)
+	}
+	var res *Message
+	return res, nil
+}
+
+func (f *FakeAPI) SendVideoNote(ctx context.Context, args *SendVideoNoteConfig) (*Message, error) {
+	f.record("sendVideoNote", args)
+	if f.SendVideoNoteFunc != nil {
+		return f.SendVideoNoteFunc(ctx, args)
+	}
+	var res *Message
+	return res, nil
+}
+
+func (f *FakeAPI) SendVoice(ctx context.Context, args *SendVoiceConfig) (*Message, error) {
+	f.record("sendVoice", args)
+	if f.SendVoiceFunc != nil {
+		return f.SendVoiceFunc(ctx, args)
+	}
+	var res *Message
+	return res, nil
+}
+
+func (f *FakeAPI) SetChatAdministratorCustomTitle(ctx context.Context, args *SetChatAdministratorCustomTitleConfig) error {
+	f.record("setChatAdministratorCustomTitle", args)
+	if f.SetChatAdministratorCustomTitleFunc != nil {
+		return f.SetChatAdministratorCustomTitleFunc(ctx, args)
+	}
+	return nil
+}
+
+func (f *FakeAPI) SetChatDescription(ctx context.Context, chatID IntStr, description *string) error {
+	f.record("setChatDescription", chatID, description)
+	if f.SetChatDescriptionFunc != nil {
+		return f.SetChatDescriptionFunc(ctx, chatID, description)
+	}
+	return nil
+}
+
+func (f *FakeAPI) SetChatMenuButton(ctx context.Context, chatID *int64, menuButton *MenuButton) error {
+	f.record("setChatMenuButton", chatID, menuButton)
+	if f.SetChatMenuButtonFunc != nil {
+		return f.SetChatMenuButtonFunc(ctx, chatID, menuButton)
+	}
+	return nil
+}
+
+func (f *FakeAPI) SetChatPermissions(ctx context.Context, args *SetChatPermissionsConfig) error {
+	f.record("setChatPermissions", args)
+	if f.SetChatPermissionsFunc != nil {
+		return f.SetChatPermissionsFunc(ctx, args)
+	}
+	return nil
+}
+
+func (f *FakeAPI) SetChatPhoto(ctx context.Context, chatID IntStr, photo InputFile) error {
+	f.record("setChatPhoto", chatID, photo)
+	if f.SetChatPhotoFunc != nil {
+		return f.SetChatPhotoFunc(ctx, chatID, photo)
+	}
+	return nil
+}
+
+func (f *FakeAPI) SetChatStickerSet(ctx context.Context, chatID IntStr, stickerSetName string) error {
+	f.record("setChatStickerSet", chatID, stickerSetName)
+	if f.SetChatStickerSetFunc != nil {
+		return f.SetChatStickerSetFunc(ctx, chatID, stickerSetName)
+	}
+	return nil
+}
+
+func (f *FakeAPI) SetChatTitle(ctx context.Context, chatID IntStr, title string) error {
+	f.record("setChatTitle", chatID, title)
+	if f.SetChatTitleFunc != nil {
+		return f.SetChatTitleFunc(ctx, chatID, title)
+	}
+	return nil
+}
+
+func (f *FakeAPI) SetCustomEmojiStickerSetThumbnail(ctx context.Context, customEmojiID *string, name string) error {
+	f.record("setCustomEmojiStickerSetThumbnail", customEmojiID, name)
+	if f.SetCustomEmojiStickerSetThumbnailFunc != nil {
+		return f.SetCustomEmojiStickerSetThumbnailFunc(ctx, customEmojiID, name)
+	}
+	return nil
+}
+
+func (f *FakeAPI) SetGameScore(ctx context.Context, args *SetGameScoreConfig) (*Message, error) {
+	f.record("setGameScore", args)
+	if f.SetGameScoreFunc != nil {
+		return f.SetGameScoreFunc(ctx, args)
+	}
+	var res *Message
+	return res, nil
+}
+
+func (f *FakeAPI) SetMyCommands(ctx context.Context, args *SetMyCommandsConfig) error {
+	f.record("setMyCommands", args)
+	if f.SetMyCommandsFunc != nil {
+		return f.SetMyCommandsFunc(ctx, args)
+	}
+	return nil
+}
+
+func (f *FakeAPI) SetMyDefaultAdministratorRights(ctx context.Context, forChannels *bool, rights *ChatAdministratorRights) error {
+	f.record("setMyDefaultAdministratorRights", forChannels, rights)
+	if f.SetMyDefaultAdministratorRightsFunc != nil {
+		return f.SetMyDefaultAdministratorRightsFunc(ctx, forChannels, rights)
+	}
+	return nil
+}
+
+func (f *FakeAPI) SetMyDescription(ctx context.Context, description *string, languageCode *string) error {
+	f.record("setMyDescription", description, languageCode)
+	if f.SetMyDescriptionFunc != nil {
+		return f.SetMyDescriptionFunc(ctx, description, languageCode)
+	}
+	return nil
+}
+
+func (f *FakeAPI) SetMyName(ctx context.Context, languageCode *string, name *string) error {
+	f.record("setMyName", languageCode, name)
+	if f.SetMyNameFunc != nil {
+		return f.SetMyNameFunc(ctx, languageCode, name)
+	}
+	return nil
+}
+
+func (f *FakeAPI) SetMyShortDescription(ctx context.Context, languageCode *string, shortDescription *string) error {
+	f.record("setMyShortDescription", languageCode, shortDescription)
+	if f.SetMyShortDescriptionFunc != nil {
+		return f.SetMyShortDescriptionFunc(ctx, languageCode, shortDescription)
+	}
+	return nil
+}
+
+func (f *FakeAPI) SetPassportDataErrors(ctx context.Context, errors []PassportElementError, userID int64) error {
+	f.record("setPassportDataErrors", errors, userID)
+	if f.SetPassportDataErrorsFunc != nil {
+		return f.SetPassportDataErrorsFunc(ctx, errors, userID)
+	}
+	return nil
+}
+
+func (f *FakeAPI) SetStickerEmojiList(ctx context.Context, emojiList []string, sticker string) error {
+	f.record("setStickerEmojiList", emojiList, sticker)
+	if f.SetStickerEmojiListFunc != nil {
+		return f.SetStickerEmojiListFunc(ctx, emojiList, sticker)
+	}
+	return nil
+}
+
+func (f *FakeAPI) SetStickerKeywords(ctx context.Context, keywords []string, sticker string) error {
+	f.record("setStickerKeywords", keywords, sticker)
+	if f.SetStickerKeywordsFunc != nil {
+		return f.SetStickerKeywordsFunc(ctx, keywords, sticker)
+	}
+	return nil
+}
+
+func (f *FakeAPI) SetStickerMaskPosition(ctx context.Context, maskPosition *MaskPosition, sticker string) error {
+	f.record("setStickerMaskPosition", maskPosition, sticker)
+	if f.SetStickerMaskPositionFunc != nil {
+		return f.SetStickerMaskPositionFunc(ctx, maskPosition, sticker)
+	}
+	return nil
+}
+
+func (f *FakeAPI) SetStickerPositionInSet(ctx context.Context, position int64, sticker string) error {
+	f.record("setStickerPositionInSet", position, sticker)
+	if f.SetStickerPositionInSetFunc != nil {
+		return f.SetStickerPositionInSetFunc(ctx, position, sticker)
+	}
+	return nil
+}
+
+func (f *FakeAPI) SetStickerSetThumbnail(ctx context.Context, args *SetStickerSetThumbnailConfig) error {
+	f.record("setStickerSetThumbnail", args)
+	if f.SetStickerSetThumbnailFunc != nil {
+		return f.SetStickerSetThumbnailFunc(ctx, args)
+	}
+	return nil
+}
+
+func (f *FakeAPI) SetStickerSetTitle(ctx context.Context, name string, title string) error {
+	f.record("setStickerSetTitle", name, title)
+	if f.SetStickerSetTitleFunc != nil {
+		return f.SetStickerSetTitleFunc(ctx, name, title)
+	}
+	return nil
+}
+
+func (f *FakeAPI) SetWebhook(ctx context.Context, args *SetWebhookConfig) error {
+	f.record("setWebhook", args)
+	if f.SetWebhookFunc != nil {
+		return f.SetWebhookFunc(ctx, args)
+	}
+	return nil
+}
+
+func (f *FakeAPI) StopMessageLiveLocation(ctx context.Context, args *StopMessageLiveLocationConfig) (*Message, error) {
+	f.record("stopMessageLiveLocation", args)
+	if f.StopMessageLiveLocationFunc != nil {
+		return f.StopMessageLiveLocationFunc(ctx, args)
+	}
+	var res *Message
+	return res, nil
+}
+
+func (f *FakeAPI) StopPoll(ctx context.Context, args *StopPollConfig) (*Poll, error) {
+	f.record("stopPoll", args)
+	if f.StopPollFunc != nil {
+		return f.StopPollFunc(ctx, args)
+	}
+	var res *Poll
+	return res, nil
+}
+
+func (f *FakeAPI) UnbanChatMember(ctx context.Context, args *UnbanChatMemberConfig) error {
+	f.record("unbanChatMember", args)
+	if f.UnbanChatMemberFunc != nil {
+		return f.UnbanChatMemberFunc(ctx, args)
+	}
+	return nil
+}
+
+func (f *FakeAPI) UnbanChatSenderChat(ctx context.Context, chatID IntStr, senderChatID int64) error {
+	f.record("unbanChatSenderChat", chatID, senderChatID)
+	if f.UnbanChatSenderChatFunc != nil {
+		return f.UnbanChatSenderChatFunc(ctx, chatID, senderChatID)
+	}
+	return nil
+}
+
+func (f *FakeAPI) UnhideGeneralForumTopic(ctx context.Context, chatID IntStr) error {
+	f.record("unhideGeneralForumTopic", chatID)
+	if f.UnhideGeneralForumTopicFunc != nil {
+		return f.UnhideGeneralForumTopicFunc(ctx, chatID)
+	}
+	return nil
+}
+
+func (f *FakeAPI) UnpinAllChatMessages(ctx context.Context, chatID IntStr) error {
+	f.record("unpinAllChatMessages", chatID)
+	if f.UnpinAllChatMessagesFunc != nil {
+		return f.UnpinAllChatMessagesFunc(ctx, chatID)
+	}
+	return nil
+}
+
+func (f *FakeAPI) UnpinAllForumTopicMessages(ctx context.Context, chatID IntStr, messageThreadID int64) error {
+	f.record("unpinAllForumTopicMessages", chatID, messageThreadID)
+	if f.UnpinAllForumTopicMessagesFunc != nil {
+		return f.UnpinAllForumTopicMessagesFunc(ctx, chatID, messageThreadID)
+	}
+	return nil
+}
+
+func (f *FakeAPI) UnpinChatMessage(ctx context.Context, chatID IntStr, messageID *int64) error {
+	f.record("unpinChatMessage", chatID, messageID)
+	if f.UnpinChatMessageFunc != nil {
+		return f.UnpinChatMessageFunc(ctx, chatID, messageID)
+	}
+	return nil
+}
+
+func (f *FakeAPI) UploadStickerFile(ctx context.Context, args *UploadStickerFileConfig) (*File, error) {
+	f.record("uploadStickerFile", args)
+	if f.UploadStickerFileFunc != nil {
+		return f.UploadStickerFileFunc(ctx, args)
+	}
+	var res *File
+	return res, nil
+}
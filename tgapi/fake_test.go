@@ -0,0 +1,48 @@
+package tgapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// greet depends only on BotAPI, so it can be tested with FakeAPI.
+func greet(ctx context.Context, api BotAPI, chatID int64) error {
+	me, err := api.GetMe(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = api.SendMessage(ctx, &SendMessageConfig{
+		ChatID: IntStr{Int: chatID},
+		Text:   "Hello from " + me.FirstName,
+	})
+	return err
+}
+
+func TestFakeAPI(t *testing.T) {
+	ctx := context.Background()
+	fake := &FakeAPI{
+		GetMeFunc: func(ctx context.Context) (*User, error) {
+			return &User{ID: 1, FirstName: "bot"}, nil
+		},
+	}
+
+	require.NoError(t, greet(ctx, fake, 42))
+
+	calls := fake.Calls()
+	require.Len(t, calls, 2)
+	require.Equal(t, "getMe", calls[0].Method)
+	require.Empty(t, calls[0].Args)
+
+	sent := fake.CallsOf("sendMessage")
+	require.Len(t, sent, 1)
+	args := sent[0].Args[0].(*SendMessageConfig)
+	require.Equal(t, "Hello from bot", args.Text)
+
+	require.NoError(t, fake.DeleteMessage(ctx, IntStr{Int: 42}, 7))
+	require.Equal(t, []interface{}{IntStr{Int: 42}, int64(7)}, fake.CallsOf("deleteMessage")[0].Args)
+
+	fake.Reset()
+	require.Empty(t, fake.Calls())
+}
@@ -0,0 +1,228 @@
+package tgapi
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ReneKroon/ttlcache"
+)
+
+const (
+	// MaxInlineResults is the maximum number of the results in the answer to the inline query.
+	MaxInlineResults = 50
+	// DefaultInlineCacheTime is the time the results are cached by Telegram by default.
+	DefaultInlineCacheTime = 300 * time.Second
+)
+
+// InlineSource computes all results of the inline query. It is called once per query
+// (or per user and query, see InlinePersonal) within the cache time,
+// InlineHandler caches the results and slices them into the pages.
+// The context is canceled when the user sends the next query.
+type InlineSource func(ctx context.Context, query *InlineQuery) ([]InlineQueryResult, error)
+
+// InlineOption is used to customize the InlineHandler.
+type InlineOption func(*InlineHandler)
+
+// InlinePageSize sets the number of the results per answer, MaxInlineResults by default.
+// The size is clamped to the range from 1 to MaxInlineResults.
+func InlinePageSize(size int) InlineOption {
+	return func(h *InlineHandler) {
+		switch {
+		case size < 1:
+			size = 1
+		case size > MaxInlineResults:
+			size = MaxInlineResults
+		}
+		h.pageSize = size
+	}
+}
+
+// InlineCacheTime sets the time the results are cached by the handler and by Telegram.
+// DefaultInlineCacheTime is used by default. The zero cache time is not sent to Telegram,
+// it means the default of 300 seconds, so the cache time shorter than a second is sent as 1 second.
+func InlineCacheTime(cacheTime time.Duration) InlineOption {
+	return func(h *InlineHandler) {
+		h.cacheTime = cacheTime
+	}
+}
+
+// InlinePersonal caches the results for every user separately and sets AnswerInlineQueryConfig.IsPersonal.
+func InlinePersonal() InlineOption {
+	return func(h *InlineHandler) {
+		h.personal = true
+	}
+}
+
+// InlineOnChosen sets the callback for the ChosenInlineResult updates, e.g. to count the chosen results.
+// The inline feedback must be enabled with @BotFather to receive them.
+func InlineOnChosen(f func(context.Context, *ChosenInlineResult)) InlineOption {
+	return func(h *InlineHandler) {
+		h.onChosen = f
+	}
+}
+
+// InlineErrorListener sets up a listener for the errors of the source and of the answers.
+func InlineErrorListener(listener ErrorCallback) InlineOption {
+	return func(h *InlineHandler) {
+		h.onError = listener
+	}
+}
+
+// InlineHandler answers the InlineQuery updates with the pages of the results
+// and passes the ChosenInlineResult updates to the InlineOnChosen callback. The other updates are ignored.
+// HandleUpdate can be used as the HandlerFunc of the CallTree.
+type InlineHandler struct {
+	api    BotAPI
+	source InlineSource
+
+	pageSize  int
+	cacheTime time.Duration
+	personal  bool
+	onChosen  func(context.Context, *ChosenInlineResult)
+	onError   ErrorCallback
+
+	cache *ttlcache.Cache
+
+	mu sync.Mutex
+	// queries are the queries being computed by the user ID.
+	queries map[int64]*inlineCall
+}
+
+var _ Handler = (*InlineHandler)(nil)
+
+type inlineCall struct {
+	cancel context.CancelFunc
+}
+
+// NewInlineHandler creates the handler answering with the results of source.
+// Stop must be called to release the cache.
+func NewInlineHandler(api BotAPI, source InlineSource, options ...InlineOption) *InlineHandler {
+	h := &InlineHandler{
+		api:       api,
+		source:    source,
+		pageSize:  MaxInlineResults,
+		cacheTime: DefaultInlineCacheTime,
+		onError: func(err error) {
+			log.Printf("inline query: %v", err)
+		},
+		cache:   ttlcache.NewCache(),
+		queries: make(map[int64]*inlineCall),
+	}
+	for _, option := range options {
+		option(h)
+	}
+	// the results are not refreshed for longer than the cache time.
+	h.cache.SkipTtlExtensionOnHit(true)
+	return h
+}
+
+// Stop releases the cache.
+func (h *InlineHandler) Stop() {
+	h.cache.Close()
+}
+
+// HandleUpdate is the implementation method for the Handler interface.
+func (h *InlineHandler) HandleUpdate(ctx context.Context, update *Update) {
+	switch {
+	case update.InlineQuery != nil:
+		h.handleQuery(ctx, update.InlineQuery)
+	case update.ChosenInlineResult != nil:
+		if h.onChosen != nil {
+			h.onChosen(ctx, update.ChosenInlineResult)
+		}
+	}
+}
+
+func (h *InlineHandler) handleQuery(ctx context.Context, query *InlineQuery) {
+	ctx, call := h.begin(ctx, query.From.ID)
+	defer h.end(query.From.ID, call)
+
+	err := h.answer(ctx, query)
+	if err != nil && ctx.Err() == nil && h.onError != nil {
+		h.onError(err)
+	}
+}
+
+// begin cancels the previous query of the user.
+func (h *InlineHandler) begin(ctx context.Context, userID int64) (context.Context, *inlineCall) {
+	ctx, cancel := context.WithCancel(ctx)
+	call := &inlineCall{cancel: cancel}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if prev, ok := h.queries[userID]; ok {
+		prev.cancel()
+	}
+	h.queries[userID] = call
+	return ctx, call
+}
+
+func (h *InlineHandler) end(userID int64, call *inlineCall) {
+	call.cancel()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.queries[userID] == call {
+		delete(h.queries, userID)
+	}
+}
+
+// answer sends the page of the results starting from the query offset.
+func (h *InlineHandler) answer(ctx context.Context, query *InlineQuery) error {
+	results, err := h.results(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	var start int
+	if query.Offset != "" {
+		// the offset is the index of the first result of the page.
+		start, err = strconv.Atoi(query.Offset)
+		if err != nil || start < 0 || start > len(results) {
+			start = len(results)
+		}
+	}
+	end := start + h.pageSize
+	if end > len(results) {
+		end = len(results)
+	}
+
+	args := NewAnswerInlineQueryConfig(query.ID, results[start:end])
+	args.CacheTime = int64(h.cacheTime / time.Second)
+	if args.CacheTime == 0 {
+		// the omitted cache time means the default one.
+		args.CacheTime = 1
+	}
+	args.IsPersonal = h.personal
+	if end < len(results) {
+		args.NextOffset = strconv.Itoa(end)
+	}
+	if err := ctx.Err(); err != nil {
+		// the query is superseded.
+		return err
+	}
+	return h.api.AnswerInlineQuery(ctx, args)
+}
+
+// results returns the cached results or computes them.
+func (h *InlineHandler) results(ctx context.Context, query *InlineQuery) ([]InlineQueryResult, error) {
+	key := query.Query
+	if h.personal {
+		key = strconv.FormatInt(query.From.ID, 10) + ":" + key
+	}
+	if cached, ok := h.cache.Get(key); ok {
+		return cached.([]InlineQueryResult), nil
+	}
+
+	results, err := h.source(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if h.cacheTime > 0 {
+		h.cache.SetWithTTL(key, results, h.cacheTime)
+	}
+	return results, nil
+}
@@ -0,0 +1,133 @@
+package tgapi
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func inlineQuery(userID int64, query, offset string) *Update {
+	return &Update{InlineQuery: NewInlineQuery(User{ID: userID}, "id"+query+offset, offset, query)}
+}
+
+func testResults(n int) []InlineQueryResult {
+	res := make([]InlineQueryResult, 0, n)
+	for i := 0; i < n; i++ {
+		res = append(res, *NewInlineQueryResult(strconv.Itoa(i), InputMessageContent{MessageText: "text"}, "title"))
+	}
+	return res
+}
+
+func TestInlineHandler(t *testing.T) {
+	fake := &FakeAPI{}
+	calls := 0
+	h := NewInlineHandler(fake, func(ctx context.Context, query *InlineQuery) ([]InlineQueryResult, error) {
+		calls++
+		return testResults(120), nil
+	})
+	defer h.Stop()
+
+	ctx := context.Background()
+	h.HandleUpdate(ctx, inlineQuery(1, "cats", ""))
+	h.HandleUpdate(ctx, inlineQuery(2, "cats", "50"))
+	h.HandleUpdate(ctx, inlineQuery(1, "cats", "100"))
+	h.HandleUpdate(ctx, inlineQuery(1, "cats", "bad"))
+	require.Equal(t, 1, calls)
+
+	answers := fake.CallsOf("answerInlineQuery")
+	require.Len(t, answers, 4)
+	var pages [][2]string
+	for _, call := range answers {
+		args := call.Args[0].(*AnswerInlineQueryConfig)
+		require.Equal(t, int64(300), args.CacheTime)
+		require.False(t, args.IsPersonal)
+		first := ""
+		if len(args.Results) != 0 {
+			first = args.Results[0].ID
+		}
+		pages = append(pages, [2]string{first, args.NextOffset})
+	}
+	require.Equal(t, [][2]string{{"0", "50"}, {"50", "100"}, {"100", ""}, {"", ""}}, pages)
+	require.Len(t, answers[2].Args[0].(*AnswerInlineQueryConfig).Results, 20)
+}
+
+func TestInlinePageSize(t *testing.T) {
+	for size, want := range map[int]int{-1: 1, 0: 1, 10: 10, MaxInlineResults + 1: MaxInlineResults} {
+		h := NewInlineHandler(nil, nil, InlinePageSize(size))
+		require.Equal(t, want, h.pageSize, size)
+		h.Stop()
+	}
+}
+
+func TestInlineHandlerPersonal(t *testing.T) {
+	fake := &FakeAPI{}
+	calls := 0
+	var reported []error
+	h := NewInlineHandler(fake, func(ctx context.Context, query *InlineQuery) ([]InlineQueryResult, error) {
+		calls++
+		if query.Query == "fail" {
+			return nil, errors.New("source")
+		}
+		return testResults(1), nil
+	}, InlinePersonal(), InlinePageSize(10), InlineCacheTime(0),
+		InlineErrorListener(func(err error) { reported = append(reported, err) }))
+	defer h.Stop()
+
+	ctx := context.Background()
+	h.HandleUpdate(ctx, inlineQuery(1, "dogs", ""))
+	h.HandleUpdate(ctx, inlineQuery(2, "dogs", ""))
+	h.HandleUpdate(ctx, inlineQuery(2, "fail", ""))
+	require.Equal(t, 3, calls)
+	require.Len(t, reported, 1)
+	require.True(t, fake.CallsOf("answerInlineQuery")[0].Args[0].(*AnswerInlineQueryConfig).IsPersonal)
+	// the results are not cached and the zero cache time is not omitted.
+	h.HandleUpdate(ctx, inlineQuery(1, "dogs", ""))
+	require.Equal(t, 4, calls)
+	require.Equal(t, int64(1), fake.CallsOf("answerInlineQuery")[2].Args[0].(*AnswerInlineQueryConfig).CacheTime)
+	require.Len(t, fake.CallsOf("answerInlineQuery"), 3)
+}
+
+func TestInlineHandlerSuperseded(t *testing.T) {
+	fake := &FakeAPI{}
+	started := make(chan struct{})
+	h := NewInlineHandler(fake, func(ctx context.Context, query *InlineQuery) ([]InlineQueryResult, error) {
+		if query.Query == "c" {
+			close(started)
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		return testResults(1), nil
+	}, InlineErrorListener(func(err error) { t.Errorf("unexpected error: %v", err) }))
+	defer h.Stop()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		h.HandleUpdate(context.Background(), inlineQuery(1, "c", ""))
+	}()
+	<-started
+	h.HandleUpdate(context.Background(), inlineQuery(1, "ca", ""))
+	wg.Wait()
+
+	answers := fake.CallsOf("answerInlineQuery")
+	require.Len(t, answers, 1)
+	require.Equal(t, "idca", answers[0].Args[0].(*AnswerInlineQueryConfig).InlineQueryID)
+	require.Empty(t, h.queries)
+}
+
+func TestInlineHandlerChosen(t *testing.T) {
+	var chosen []string
+	h := NewInlineHandler(&FakeAPI{}, nil, InlineOnChosen(func(ctx context.Context, result *ChosenInlineResult) {
+		chosen = append(chosen, result.Query)
+	}))
+	defer h.Stop()
+
+	h.HandleUpdate(context.Background(), &Update{ChosenInlineResult: NewChosenInlineResult(User{ID: 1}, "cats", "7")})
+	h.HandleUpdate(context.Background(), &Update{ChosenInlineResult: NewChosenInlineResult(User{ID: 2}, "cat", "7")})
+	require.Equal(t, []string{"cats", "cat"}, chosen)
+}
@@ -0,0 +1,555 @@
+// Generated by: github.com/Feresey/gen-tgbotapi
+
+package tgapi
+
+import "context"
+
+// BotAPI contains all methods of the Bot API.
+// It is implemented by *API and by FakeAPI, so the handlers depending on it can be tested without HTTP.
+type BotAPI interface {
+	// Do calls the API method of the request and decodes the result into result, if it is not nil.
+	Do(ctx context.Context, req Request, result interface{}) error
+	// AddStickerToSet
+	// Use this method to add a new sticker to a set created by the bot. The format of the added
+	// sticker must match the format of the other stickers in the set. Emoji sticker sets can have
+	// up to 200 stickers. Animated and video sticker sets can have up to 50 stickers. Static
+	// sticker sets can have up to 120 stickers. Returns True on success.
+	AddStickerToSet(ctx context.Context, args *AddStickerToSetConfig) error
+	// AnswerCallbackQuery
+	// Use this method to send answers to callback queries sent from inline keyboards. The answer
+	// will be displayed to the user as a notification at the top of the chat screen or as an
+	// alert. On success, True is returned.
+	AnswerCallbackQuery(ctx context.Context, args *AnswerCallbackQueryConfig) error
+	// AnswerInlineQuery
+	// Use this method to send answers to an inline query. On success, True is returned.No more
+	// than 50 results per query are allowed.
+	AnswerInlineQuery(ctx context.Context, args *AnswerInlineQueryConfig) error
+	// AnswerPreCheckoutQuery
+	// Once the user has confirmed their payment and shipping details, the Bot API sends the final
+	// confirmation in the form of an Update with the field pre_checkout_query. Use this method to
+	// respond to such pre-checkout queries. On success, True is returned. Note: The Bot API must
+	// receive an answer within 10 seconds after the pre-checkout query was sent.
+	AnswerPreCheckoutQuery(ctx context.Context, args *AnswerPreCheckoutQueryConfig) error
+	// AnswerShippingQuery
+	// If you sent an invoice requesting a shipping address and the parameter is_flexible was
+	// specified, the Bot API will send an Update with a shipping_query field to the bot. Use this
+	// method to reply to shipping queries. On success, True is returned.
+	AnswerShippingQuery(ctx context.Context, args *AnswerShippingQueryConfig) error
+	// AnswerWebAppQuery
+	// Use this method to set the result of an interaction with a Web App and send a corresponding
+	// message on behalf of the user to the chat from which the query originated. On success, a
+	// SentWebAppMessage object is returned.
+	AnswerWebAppQuery(ctx context.Context, result InlineQueryResult, webAppQueryID string) (*SentWebAppMessage, error)
+	// ApproveChatJoinRequest
+	// Use this method to approve a chat join request. The bot must be an administrator in the chat
+	// for this to work and must have the can_invite_users administrator right. Returns True on
+	// success.
+	ApproveChatJoinRequest(ctx context.Context, chatID IntStr, userID int64) error
+	// BanChatMember
+	// Use this method to ban a user in a group, a supergroup or a channel. In the case of
+	// supergroups and channels, the user will not be able to return to the chat on their own using
+	// invite links, etc., unless unbanned first. The bot must be an administrator in the chat for
+	// this to work and must have the appropriate administrator rights. Returns True on success.
+	BanChatMember(ctx context.Context, args *BanChatMemberConfig) error
+	// BanChatSenderChat
+	// Use this method to ban a channel chat in a supergroup or a channel. Until the chat is
+	// unbanned, the owner of the banned chat won't be able to send messages on behalf of any of
+	// their channels. The bot must be an administrator in the supergroup or channel for this to
+	// work and must have the appropriate administrator rights. Returns True on success.
+	BanChatSenderChat(ctx context.Context, chatID IntStr, senderChatID int64) error
+	// Close
+	// Use this method to close the bot instance before moving it from one local server to another.
+	// You need to delete the webhook before calling this method to ensure that the bot isn't
+	// launched again after server restart. The method will return error 429 in the first 10
+	// minutes after the bot is launched. Returns True on success. Requires no parameters.
+	Close(ctx context.Context) error
+	// CloseForumTopic
+	// Use this method to close an open topic in a forum supergroup chat. The bot must be an
+	// administrator in the chat for this to work and must have the can_manage_topics administrator
+	// rights, unless it is the creator of the topic. Returns True on success.
+	CloseForumTopic(ctx context.Context, chatID IntStr, messageThreadID int64) error
+	// CloseGeneralForumTopic
+	// Use this method to close an open 'General' topic in a forum supergroup chat. The bot must be
+	// an administrator in the chat for this to work and must have the can_manage_topics
+	// administrator rights. Returns True on success.
+	CloseGeneralForumTopic(ctx context.Context, chatID IntStr) error
+	// CopyMessage
+	// Use this method to copy messages of any kind. Service messages and invoice messages can't be
+	// copied. A quiz poll can be copied only if the value of the field correct_option_id is known
+	// to the bot. The method is analogous to the method forwardMessage, but the copied message
+	// doesn't have a link to the original message. Returns the MessageId of the sent message on
+	// success.
+	CopyMessage(ctx context.Context, args *CopyMessageConfig) (*MessageID, error)
+	// CreateChatInviteLink
+	// Use this method to create an additional invite link for a chat. The bot must be an
+	// administrator in the chat for this to work and must have the appropriate administrator
+	// rights. The link can be revoked using the method revokeChatInviteLink. Returns the new
+	// invite link as ChatInviteLink object.
+	CreateChatInviteLink(ctx context.Context, args *CreateChatInviteLinkConfig) (*ChatInviteLink, error)
+	// CreateForumTopic
+	// Use this method to create a topic in a forum supergroup chat. The bot must be an
+	// administrator in the chat for this to work and must have the can_manage_topics administrator
+	// rights. Returns information about the created topic as a ForumTopic object.
+	CreateForumTopic(ctx context.Context, args *CreateForumTopicConfig) (*ForumTopic, error)
+	// CreateInvoiceLink
+	// Use this method to create a link for an invoice. Returns the created invoice link as String
+	// on success.
+	CreateInvoiceLink(ctx context.Context, args *CreateInvoiceLinkConfig) (string, error)
+	// CreateNewStickerSet
+	// Use this method to create a new sticker set owned by a user. The bot will be able to edit
+	// the sticker set thus created. Returns True on success.
+	CreateNewStickerSet(ctx context.Context, args *CreateNewStickerSetConfig) error
+	// DeclineChatJoinRequest
+	// Use this method to decline a chat join request. The bot must be an administrator in the chat
+	// for this to work and must have the can_invite_users administrator right. Returns True on
+	// success.
+	DeclineChatJoinRequest(ctx context.Context, chatID IntStr, userID int64) error
+	// DeleteChatPhoto
+	// Use this method to delete a chat photo. Photos can't be changed for private chats. The bot
+	// must be an administrator in the chat for this to work and must have the appropriate
+	// administrator rights. Returns True on success.
+	DeleteChatPhoto(ctx context.Context, chatID IntStr) error
+	// DeleteChatStickerSet
+	// Use this method to delete a group sticker set from a supergroup. The bot must be an
+	// administrator in the chat for this to work and must have the appropriate administrator
+	// rights. Use the field can_set_sticker_set optionally returned in getChat requests to check
+	// if the bot can use this method. Returns True on success.
+	DeleteChatStickerSet(ctx context.Context, chatID IntStr) error
+	// DeleteForumTopic
+	// Use this method to delete a forum topic along with all its messages in a forum supergroup
+	// chat. The bot must be an administrator in the chat for this to work and must have the
+	// can_delete_messages administrator rights. Returns True on success.
+	DeleteForumTopic(ctx context.Context, chatID IntStr, messageThreadID int64) error
+	// DeleteMessage
+	// Use this method to delete a message, including service messages, with the following
+	// limitations:- A message can only be deleted if it was sent less than 48 hours ago.- Service
+	// messages about a supergroup, channel, or forum topic creation can't be deleted.- A dice
+	// message in a private chat can only be deleted if it was sent more than 24 hours ago.- Bots
+	// can delete outgoing messages in private chats, groups, and supergroups.- Bots can delete
+	// incoming messages in private chats.- Bots granted can_post_messages permissions can delete
+	// outgoing messages in channels.- If the bot is an administrator of a group, it can delete any
+	// message there.- If the bot has can_delete_messages permission in a supergroup or a channel,
+	// it can delete any message there.Returns True on success.
+	DeleteMessage(ctx context.Context, chatID IntStr, messageID int64) error
+	// DeleteMyCommands
+	// Use this method to delete the list of the bot's commands for the given scope and user
+	// language. After deletion, higher level commands will be shown to affected users. Returns
+	// True on success.
+	DeleteMyCommands(ctx context.Context, languageCode *string, scope *BotCommandScope) error
+	// DeleteStickerFromSet
+	// Use this method to delete a sticker from a set created by the bot. Returns True on success.
+	DeleteStickerFromSet(ctx context.Context, sticker string) error
+	// DeleteStickerSet
+	// Use this method to delete a sticker set that was created by the bot. Returns True on
+	// success.
+	DeleteStickerSet(ctx context.Context, name string) error
+	// DeleteWebhook
+	// Use this method to remove webhook integration if you decide to switch back to getUpdates.
+	// Returns True on success.
+	DeleteWebhook(ctx context.Context, dropPendingUpdates *bool) error
+	// EditChatInviteLink
+	// Use this method to edit a non-primary invite link created by the bot. The bot must be an
+	// administrator in the chat for this to work and must have the appropriate administrator
+	// rights. Returns the edited invite link as a ChatInviteLink object.
+	EditChatInviteLink(ctx context.Context, args *EditChatInviteLinkConfig) (*ChatInviteLink, error)
+	// EditForumTopic
+	// Use this method to edit name and icon of a topic in a forum supergroup chat. The bot must be
+	// an administrator in the chat for this to work and must have can_manage_topics administrator
+	// rights, unless it is the creator of the topic. Returns True on success.
+	EditForumTopic(ctx context.Context, args *EditForumTopicConfig) error
+	// EditGeneralForumTopic
+	// Use this method to edit the name of the 'General' topic in a forum supergroup chat. The bot
+	// must be an administrator in the chat for this to work and must have can_manage_topics
+	// administrator rights. Returns True on success.
+	EditGeneralForumTopic(ctx context.Context, chatID IntStr, name string) error
+	// EditMessageCaption
+	// Use this method to edit captions of messages. On success, if the edited message is not an
+	// inline message, the edited Message is returned, otherwise True is returned.
+	EditMessageCaption(ctx context.Context, args *EditMessageCaptionConfig) (*Message, error)
+	// EditMessageLiveLocation
+	// Use this method to edit live location messages. A location can be edited until its
+	// live_period expires or editing is explicitly disabled by a call to stopMessageLiveLocation.
+	// On success, if the edited message is not an inline message, the edited Message is returned,
+	// otherwise True is returned.
+	EditMessageLiveLocation(ctx context.Context, args *EditMessageLiveLocationConfig) (*Message, error)
+	// EditMessageMedia
+	// Use this method to edit animation, audio, document, photo, or video messages. If a message
+	// is part of a message album, then it can be edited only to an audio for audio albums, only to
+	// a document for document albums and to a photo or a video otherwise. When an inline message
+	// is edited, a new file can't be uploaded; use a previously uploaded file via its file_id or
+	// specify a URL. On success, if the edited message is not an inline message, the edited
+	// Message is returned, otherwise True is returned.
+	EditMessageMedia(ctx context.Context, args *EditMessageMediaConfig) (*Message, error)
+	// EditMessageReplyMarkup
+	// Use this method to edit only the reply markup of messages. On success, if the edited message
+	// is not an inline message, the edited Message is returned, otherwise True is returned.
+	EditMessageReplyMarkup(ctx context.Context, args *EditMessageReplyMarkupConfig) (*Message, error)
+	// EditMessageText
+	// Use this method to edit text and game messages. On success, if the edited message is not an
+	// inline message, the edited Message is returned, otherwise True is returned.
+	EditMessageText(ctx context.Context, args *EditMessageTextConfig) (*Message, error)
+	// ExportChatInviteLink
+	// Use this method to generate a new primary invite link for a chat; any previously generated
+	// primary link is revoked. The bot must be an administrator in the chat for this to work and
+	// must have the appropriate administrator rights. Returns the new invite link as String on
+	// success.
+	ExportChatInviteLink(ctx context.Context, chatID IntStr) (string, error)
+	// ForwardMessage
+	// Use this method to forward messages of any kind. Service messages can't be forwarded. On
+	// success, the sent Message is returned.
+	ForwardMessage(ctx context.Context, args *ForwardMessageConfig) (*Message, error)
+	// GetChat
+	// Use this method to get up to date information about the chat (current name of the user for
+	// one-on-one conversations, current username of a user, group or channel, etc.). Returns a
+	// Chat object on success.
+	GetChat(ctx context.Context, chatID IntStr) (*Chat, error)
+	// GetChatAdministrators
+	// Use this method to get a list of administrators in a chat, which aren't bots. Returns an
+	// Array of ChatMember objects.
+	GetChatAdministrators(ctx context.Context, chatID IntStr) ([]ChatMember, error)
+	// GetChatMember
+	// Use this method to get information about a member of a chat. The method is only guaranteed
+	// to work for other users if the bot is an administrator in the chat. Returns a ChatMember
+	// object on success.
+	GetChatMember(ctx context.Context, chatID IntStr, userID int64) (*ChatMember, error)
+	// GetChatMemberCount
+	// Use this method to get the number of members in a chat. Returns Int on success.
+	GetChatMemberCount(ctx context.Context, chatID IntStr) (int64, error)
+	// GetChatMenuButton
+	// Use this method to get the current value of the bot's menu button in a private chat, or the
+	// default menu button. Returns MenuButton on success.
+	GetChatMenuButton(ctx context.Context, chatID *int64) (*MenuButton, error)
+	// GetCustomEmojiStickers
+	// Use this method to get information about custom emoji stickers by their identifiers. Returns
+	// an Array of Sticker objects.
+	GetCustomEmojiStickers(ctx context.Context, customEmojiIDs []string) ([]Sticker, error)
+	// GetFile
+	// Use this method to get basic information about a file and prepare it for downloading. For
+	// the moment, bots can download files of up to 20MB in size. On success, a File object is
+	// returned. The file can then be downloaded via the link
+	// https://api.telegram.org/file/bot<token>/<file_path>, where <file_path> is taken from the
+	// response. It is guaranteed that the link will be valid for at least 1 hour. When the link
+	// expires, a new one can be requested by calling getFile again.
+	GetFile(ctx context.Context, fileID string) (*File, error)
+	// GetForumTopicIconStickers
+	// Use this method to get custom emoji stickers, which can be used as a forum topic icon by any
+	// user. Requires no parameters. Returns an Array of Sticker objects.
+	GetForumTopicIconStickers(ctx context.Context) ([]Sticker, error)
+	// GetGameHighScores
+	// Use this method to get data for high score tables. Will return the score of the specified
+	// user and several of their neighbors in a game. Returns an Array of GameHighScore objects.
+	GetGameHighScores(ctx context.Context, args *GetGameHighScoresConfig) ([]GameHighScore, error)
+	// GetMe
+	// A simple method for testing your bot's authentication token. Requires no parameters. Returns
+	// basic information about the bot in form of a User object.
+	GetMe(ctx context.Context) (*User, error)
+	// GetMyCommands
+	// Use this method to get the current list of the bot's commands for the given scope and user
+	// language. Returns an Array of BotCommand objects. If commands aren't set, an empty list is
+	// returned.
+	GetMyCommands(ctx context.Context) ([]BotCommand, error)
+	// GetMyDefaultAdministratorRights
+	// Use this method to get the current default administrator rights of the bot. Returns
+	// ChatAdministratorRights on success.
+	GetMyDefaultAdministratorRights(ctx context.Context, forChannels *bool) (*ChatAdministratorRights, error)
+	// GetMyDescription
+	// Use this method to get the current bot description for the given user language. Returns
+	// BotDescription on success.
+	GetMyDescription(ctx context.Context, languageCode *string) (*BotDescription, error)
+	// GetMyName
+	// Use this method to get the current bot name for the given user language. Returns BotName on
+	// success.
+	GetMyName(ctx context.Context, languageCode *string) (*BotName, error)
+	// GetMyShortDescription
+	// Use this method to get the current bot short description for the given user language.
+	// Returns BotShortDescription on success.
+	GetMyShortDescription(ctx context.Context, languageCode *string) (*BotShortDescription, error)
+	// GetStickerSet
+	// Use this method to get a sticker set. On success, a StickerSet object is returned.
+	GetStickerSet(ctx context.Context, name string) (*StickerSet, error)
+	// GetUpdates
+	// Use this method to receive incoming updates using long polling (wiki). Returns an Array of
+	// Update objects.
+	GetUpdates(ctx context.Context, args *GetUpdatesConfig) ([]Update, error)
+	// GetUserProfilePhotos
+	// Use this method to get a list of profile pictures for a user. Returns a UserProfilePhotos
+	// object.
+	GetUserProfilePhotos(ctx context.Context, args *GetUserProfilePhotosConfig) (*UserProfilePhotos, error)
+	// GetWebhookInfo
+	// Use this method to get current webhook status. Requires no parameters. On success, returns a
+	// WebhookInfo object. If the bot is using getUpdates, will return an object with the url field
+	// empty.
+	GetWebhookInfo(ctx context.Context) (*WebhookInfo, error)
+	// HideGeneralForumTopic
+	// Use this method to hide the 'General' topic in a forum supergroup chat. The bot must be an
+	// administrator in the chat for this to work and must have the can_manage_topics administrator
+	// rights. The topic will be automatically closed if it was open. Returns True on success.
+	HideGeneralForumTopic(ctx context.Context, chatID IntStr) error
+	// LeaveChat
+	// Use this method for your bot to leave a group, supergroup or channel. Returns True on
+	// success.
+	LeaveChat(ctx context.Context, chatID IntStr) error
+	// LogOut
+	// Use this method to log out from the cloud Bot API server before launching the bot locally.
+	// You must log out the bot before running it locally, otherwise there is no guarantee that the
+	// bot will receive updates. After a successful call, you can immediately log in on a local
+	// server, but will not be able to log in back to the cloud Bot API server for 10 minutes.
+	// Returns True on success. Requires no parameters.
+	LogOut(ctx context.Context) error
+	// PinChatMessage
+	// Use this method to add a message to the list of pinned messages in a chat. If the chat is
+	// not a private chat, the bot must be an administrator in the chat for this to work and must
+	// have the 'can_pin_messages' administrator right in a supergroup or 'can_edit_messages'
+	// administrator right in a channel. Returns True on success.
+	PinChatMessage(ctx context.Context, args *PinChatMessageConfig) error
+	// PromoteChatMember
+	// Use this method to promote or demote a user in a supergroup or a channel. The bot must be an
+	// administrator in the chat for this to work and must have the appropriate administrator
+	// rights. Pass False for all boolean parameters to demote a user. Returns True on success.
+	PromoteChatMember(ctx context.Context, args *PromoteChatMemberConfig) error
+	// ReopenForumTopic
+	// Use this method to reopen a closed topic in a forum supergroup chat. The bot must be an
+	// administrator in the chat for this to work and must have the can_manage_topics administrator
+	// rights, unless it is the creator of the topic. Returns True on success.
+	ReopenForumTopic(ctx context.Context, chatID IntStr, messageThreadID int64) error
+	// ReopenGeneralForumTopic
+	// Use this method to reopen a closed 'General' topic in a forum supergroup chat. The bot must
+	// be an administrator in the chat for this to work and must have the can_manage_topics
+	// administrator rights. The topic will be automatically unhidden if it was hidden. Returns
+	// True on success.
+	ReopenGeneralForumTopic(ctx context.Context, chatID IntStr) error
+	// RestrictChatMember
+	// Use this method to restrict a user in a supergroup. The bot must be an administrator in the
+	// supergroup for this to work and must have the appropriate administrator rights. Pass True
+	// for all permissions to lift restrictions from a user. Returns True on success.
+	RestrictChatMember(ctx context.Context, args *RestrictChatMemberConfig) error
+	// RevokeChatInviteLink
+	// Use this method to revoke an invite link created by the bot. If the primary link is revoked,
+	// a new link is automatically generated. The bot must be an administrator in the chat for this
+	// to work and must have the appropriate administrator rights. Returns the revoked invite link
+	// as ChatInviteLink object.
+	RevokeChatInviteLink(ctx context.Context, chatID IntStr, inviteLink string) (*ChatInviteLink, error)
+	// SendAnimation
+	// Use this method to send animation files (GIF or H.264/MPEG-4 AVC video without sound). On
+	// success, the sent Message is returned. Bots can currently send animation files of up to 50
+	// MB in size, this limit may be changed in the future.
+	SendAnimation(ctx context.Context, args *SendAnimationConfig) (*Message, error)
+	// SendAudio
+	// Use this method to send audio files, if you want Telegram clients to display them in the
+	// music player. Your audio must be in the .MP3 or .M4A format. On success, the sent Message is
+	// returned. Bots can currently send audio files of up to 50 MB in size, this limit may be
+	// changed in the future.
+	SendAudio(ctx context.Context, args *SendAudioConfig) (*Message, error)
+	// SendChatAction
+	// Use this method when you need to tell the user that something is happening on the bot's
+	// side. The status is set for 5 seconds or less (when a message arrives from your bot,
+	// Telegram clients clear its typing status). Returns True on success.
+	SendChatAction(ctx context.Context, args *SendChatActionConfig) error
+	// SendContact
+	// Use this method to send phone contacts. On success, the sent Message is returned.
+	SendContact(ctx context.Context, args *SendContactConfig) (*Message, error)
+	// SendDice
+	// Use this method to send an animated emoji that will display a random value. On success, the
+	// sent Message is returned.
+	SendDice(ctx context.Context, args *SendDiceConfig) (*Message, error)
+	// SendDocument
+	// Use this method to send general files. On success, the sent Message is returned. Bots can
+	// currently send files of any type of up to 50 MB in size, this limit may be changed in the
+	// future.
+	SendDocument(ctx context.Context, args *SendDocumentConfig) (*Message, error)
+	// SendGame
+	// Use this method to send a game. On success, the sent Message is returned.
+	SendGame(ctx context.Context, args *SendGameConfig) (*Message, error)
+	// SendInvoice
+	// Use this method to send invoices. On success, the sent Message is returned.
+	SendInvoice(ctx context.Context, args *SendInvoiceConfig) (*Message, error)
+	// SendLocation
+	// Use this method to send point on the map. On success, the sent Message is returned.
+	SendLocation(ctx context.Context, args *SendLocationConfig) (*Message, error)
+	// SendMediaGroup
+	// Use this method to send a group of photos, videos, documents or audios as an album.
+	// Documents and audio files can be only grouped in an album with messages of the same type. On
+	// success, an array of Messages that were sent is returned.
+	SendMediaGroup(ctx context.Context, args *SendMediaGroupConfig) ([]Message, error)
+	// SendMessage
+	// Use this method to send text messages. On success, the sent Message is returned.
+	SendMessage(ctx context.Context, args *SendMessageConfig) (*Message, error)
+	// SendPhoto
+	// Use this method to send photos. On success, the sent Message is returned.
+	SendPhoto(ctx context.Context, args *SendPhotoConfig) (*Message, error)
+	// SendPoll
+	// Use this method to send a native poll. On success, the sent Message is returned.
+	SendPoll(ctx context.Context, args *SendPollConfig) (*Message, error)
+	// SendSticker
+	// Use this method to send static .WEBP, animated .TGS, or video .WEBM stickers. On success,
+	// the sent Message is returned.
+	SendSticker(ctx context.Context, args *SendStickerConfig) (*Message, error)
+	// SendVenue
+	// Use this method to send information about a venue. On success, the sent Message is returned.
+	SendVenue(ctx context.Context, args *SendVenueConfig) (*Message, error)
+	// SendVideo
+	// Use this method to send video files, Telegram clients support MPEG4 videos (other formats
+	// may be sent as Document). On success, the sent Message is returned. Bots can currently send
+	// video files of up to 50 MB in size, this limit may be changed in the future.
+	SendVideo(ctx context.Context, args *SendVideoConfig) (*Message, error)
+	// SendVideoNote
+	// As of v.4.0, Telegram clients support rounded square MPEG4 videos of up to 1 minute long.
+	// Use this method to send video messages. On success, the sent Message is returned.
+	SendVideoNote(ctx context.Context, args *SendVideoNoteConfig) (*Message, error)
+	// SendVoice
+	// Use this method to send audio files, if you want Telegram clients to display the file as a
+	// playable voice message. For this to work, your audio must be in an .OGG file encoded with
+	// OPUS (other formats may be sent as Audio or Document). On success, the sent Message is
+	// returned. Bots can currently send voice messages of up to 50 MB in size, this limit may be
+	// changed in the future.
+	SendVoice(ctx context.Context, args *SendVoiceConfig) (*Message, error)
+	// SetChatAdministratorCustomTitle
+	// Use this method to set a custom title for an administrator in a supergroup promoted by the
+	// bot. Returns True on success.
+	SetChatAdministratorCustomTitle(ctx context.Context, args *SetChatAdministratorCustomTitleConfig) error
+	// SetChatDescription
+	// Use this method to change the description of a group, a supergroup or a channel. The bot
+	// must be an administrator in the chat for this to work and must have the appropriate
+	// administrator rights. Returns True on success.
+	SetChatDescription(ctx context.Context, chatID IntStr, description *string) error
+	// SetChatMenuButton
+	// Use this method to change the bot's menu button in a private chat, or the default menu
+	// button. Returns True on success.
+	SetChatMenuButton(ctx context.Context, chatID *int64, menuButton *MenuButton) error
+	// SetChatPermissions
+	// Use this method to set default chat permissions for all members. The bot must be an
+	// administrator in the group or a supergroup for this to work and must have the
+	// can_restrict_members administrator rights. Returns True on success.
+	SetChatPermissions(ctx context.Context, args *SetChatPermissionsConfig) error
+	// SetChatPhoto
+	// Use this method to set a new profile photo for the chat. Photos can't be changed for private
+	// chats. The bot must be an administrator in the chat for this to work and must have the
+	// appropriate administrator rights. Returns True on success.
+	SetChatPhoto(ctx context.Context, chatID IntStr, photo InputFile) error
+	// SetChatStickerSet
+	// Use this method to set a new group sticker set for a supergroup. The bot must be an
+	// administrator in the chat for this to work and must have the appropriate administrator
+	// rights. Use the field can_set_sticker_set optionally returned in getChat requests to check
+	// if the bot can use this method. Returns True on success.
+	SetChatStickerSet(ctx context.Context, chatID IntStr, stickerSetName string) error
+	// SetChatTitle
+	// Use this method to change the title of a chat. Titles can't be changed for private chats.
+	// The bot must be an administrator in the chat for this to work and must have the appropriate
+	// administrator rights. Returns True on success.
+	SetChatTitle(ctx context.Context, chatID IntStr, title string) error
+	// SetCustomEmojiStickerSetThumbnail
+	// Use this method to set the thumbnail of a custom emoji sticker set. Returns True on success.
+	SetCustomEmojiStickerSetThumbnail(ctx context.Context, customEmojiID *string, name string) error
+	// SetGameScore
+	// Use this method to set the score of the specified user in a game message. On success, if the
+	// message is not an inline message, the Message is returned, otherwise True is returned.
+	// Returns an error, if the new score is not greater than the user's current score in the chat
+	// and force is False.
+	SetGameScore(ctx context.Context, args *SetGameScoreConfig) (*Message, error)
+	// SetMyCommands
+	// Use this method to change the list of the bot's commands. See this manual for more details
+	// about bot commands. Returns True on success.
+	SetMyCommands(ctx context.Context, args *SetMyCommandsConfig) error
+	// SetMyDefaultAdministratorRights
+	// Use this method to change the default administrator rights requested by the bot when it's
+	// added as an administrator to groups or channels. These rights will be suggested to users,
+	// but they are free to modify the list before adding the bot. Returns True on success.
+	SetMyDefaultAdministratorRights(ctx context.Context, forChannels *bool, rights *ChatAdministratorRights) error
+	// SetMyDescription
+	// Use this method to change the bot's description, which is shown in the chat with the bot if
+	// the chat is empty. Returns True on success.
+	SetMyDescription(ctx context.Context, description *string, languageCode *string) error
+	// SetMyName
+	// Use this method to change the bot's name. Returns True on success.
+	SetMyName(ctx context.Context, languageCode *string, name *string) error
+	// SetMyShortDescription
+	// Use this method to change the bot's short description, which is shown on the bot's profile
+	// page and is sent together with the link when users share the bot. Returns True on success.
+	SetMyShortDescription(ctx context.Context, languageCode *string, shortDescription *string) error
+	// SetPassportDataErrors
+	// Informs a user that some of the Telegram Passport elements they provided contains errors.
+	// The user will not be able to re-submit their Passport to you until the errors are fixed (the
+	// contents of the field for which you returned the error must change). Returns True on
+	// success.
+	SetPassportDataErrors(ctx context.Context, errors []PassportElementError, userID int64) error
+	// SetStickerEmojiList
+	// Use this method to change the list of emoji assigned to a regular or custom emoji sticker.
+	// The sticker must belong to a sticker set created by the bot. Returns True on success.
+	SetStickerEmojiList(ctx context.Context, emojiList []string, sticker string) error
+	// SetStickerKeywords
+	// Use this method to change search keywords assigned to a regular or custom emoji sticker. The
+	// sticker must belong to a sticker set created by the bot. Returns True on success.
+	SetStickerKeywords(ctx context.Context, keywords []string, sticker string) error
+	// SetStickerMaskPosition
+	// Use this method to change the mask position of a mask sticker. The sticker must belong to a
+	// sticker set that was created by the bot. Returns True on success.
+	SetStickerMaskPosition(ctx context.Context, maskPosition *MaskPosition, sticker string) error
+	// SetStickerPositionInSet
+	// Use this method to move a sticker in a set created by the bot to a specific position.
+	// Returns True on success.
+	SetStickerPositionInSet(ctx context.Context, position int64, sticker string) error
+	// SetStickerSetThumbnail
+	// Use this method to set the thumbnail of a regular or mask sticker set. The format of the
+	// thumbnail file must match the format of the stickers in the set. Returns True on success.
+	SetStickerSetThumbnail(ctx context.Context, args *SetStickerSetThumbnailConfig) error
+	// SetStickerSetTitle
+	// Use this method to set the title of a created sticker set. Returns True on success.
+	SetStickerSetTitle(ctx context.Context, name string, title string) error
+	// SetWebhook
+	// Use this method to specify a URL and receive incoming updates via an outgoing webhook.
+	// Whenever there is an update for the bot, we will send an HTTPS POST request to the specified
+	// URL, containing a JSON-serialized Update. In case of an unsuccessful request, we will give
+	// up after a reasonable amount of attempts. Returns True on success.
+	SetWebhook(ctx context.Context, args *SetWebhookConfig) error
+	// StopMessageLiveLocation
+	// Use this method to stop updating a live location message before live_period expires. On
+	// success, if the message is not an inline message, the edited Message is returned, otherwise
+	// True is returned.
+	StopMessageLiveLocation(ctx context.Context, args *StopMessageLiveLocationConfig) (*Message, error)
+	// StopPoll
+	// Use this method to stop a poll which was sent by the bot. On success, the stopped Poll is
+	// returned.
+	StopPoll(ctx context.Context, args *StopPollConfig) (*Poll, error)
+	// UnbanChatMember
+	// Use this method to unban a previously banned user in a supergroup or channel. The user will
+	// not return to the group or channel automatically, but will be able to join via link, etc.
+	// The bot must be an administrator for this to work. By default, this method guarantees that
+	// after the call the user is not a member of the chat, but will be able to join it. So if the
+	// user is a member of the chat they will also be removed from the chat. If you don't want
+	// this, use the parameter only_if_banned. Returns True on success.
+	UnbanChatMember(ctx context.Context, args *UnbanChatMemberConfig) error
+	// UnbanChatSenderChat
+	// Use this method to unban a previously banned channel chat in a supergroup or channel. The
+	// bot must be an administrator for this to work and must have the appropriate administrator
+	// rights. Returns True on success.
+	UnbanChatSenderChat(ctx context.Context, chatID IntStr, senderChatID int64) error
+	// UnhideGeneralForumTopic
+	// Use this method to unhide the 'General' topic in a forum supergroup chat. The bot must be an
+	// administrator in the chat for this to work and must have the can_manage_topics administrator
+	// rights. Returns True on success.
+	UnhideGeneralForumTopic(ctx context.Context, chatID IntStr) error
+	// UnpinAllChatMessages
+	// Use this method to clear the list of pinned messages in a chat. If the chat is not a private
+	// chat, the bot must be an administrator in the chat for this to work and must have the
+	// 'can_pin_messages' administrator right in a supergroup or 'can_edit_messages' administrator
+	// right in a channel. Returns True on success.
+	UnpinAllChatMessages(ctx context.Context, chatID IntStr) error
+	// UnpinAllForumTopicMessages
+	// Use this method to clear the list of pinned messages in a forum topic. The bot must be an
+	// administrator in the chat for this to work and must have the can_pin_messages administrator
+	// right in the supergroup. Returns True on success.
+	UnpinAllForumTopicMessages(ctx context.Context, chatID IntStr, messageThreadID int64) error
+	// UnpinChatMessage
+	// Use this method to remove a message from the list of pinned messages in a chat. If the chat
+	// is not a private chat, the bot must be an administrator in the chat for this to work and
+	// must have the 'can_pin_messages' administrator right in a supergroup or 'can_edit_messages'
+	// administrator right in a channel. Returns True on success.
+	UnpinChatMessage(ctx context.Context, chatID IntStr, messageID *int64) error
+	// UploadStickerFile
+	// Use this method to upload a file with a sticker for later use in the createNewStickerSet and
+	// addStickerToSet methods (the file can be used multiple times). Returns the uploaded File on
+	// success.
+	UploadStickerFile(ctx context.Context, args *UploadStickerFileConfig) (*File, error)
+}
+
+var _ BotAPI = (*API)(nil)
@@ -0,0 +1,253 @@
+package tgapi
+
+import (
+	"context"
+	"errors"
+	"unicode/utf16"
+)
+
+const (
+	// MaxMessageLength is the maximum length of the message text in UTF-16 code units.
+	MaxMessageLength = 4096
+	// MaxCaptionLength is the maximum length of the media caption in UTF-16 code units.
+	MaxCaptionLength = 1024
+)
+
+// ErrSplitParseMode is returned when the text formatted with ParseMode is too long.
+// The markup cannot be split safely, pass the formatting in the entities instead.
+var ErrSplitParseMode = errors.New("cannot split the text formatted with parse mode, use entities")
+
+// TextPart is a part of the split text with the entities rebased to the part.
+type TextPart struct {
+	Text     string
+	Entities []MessageEntity
+}
+
+// SplitText splits the text into the parts of at most limit UTF-16 code units.
+// The text is split on the paragraph, line, sentence or word boundaries if possible,
+// the entities are cut to the parts and their offsets are rebased.
+// The entities like URLs and mentions, whose meaning depends on the whole text, are never split.
+// The limit less than 1 is treated as 1. A character encoded with a surrogate pair is never split,
+// so with the limit 1 it makes a part of 2 code units.
+func SplitText(text string, entities []MessageEntity, limit int) []TextPart {
+	if limit < 1 {
+		limit = 1
+	}
+	var res []TextPart
+	rest := TextPart{Text: text, Entities: entities}
+	for rest.Text != "" {
+		var head TextPart
+		head, rest = cutText(rest, limit)
+		if head.Text != "" {
+			res = append(res, head)
+		}
+	}
+	return res
+}
+
+// cutText cuts the head of at most limit UTF-16 code units from the text.
+// The whitespace around the cut is dropped.
+func cutText(part TextPart, limit int) (head, rest TextPart) {
+	units := utf16.Encode([]rune(part.Text))
+	if len(units) <= limit {
+		return part, TextPart{}
+	}
+
+	cut := cutPosition(units, part.Entities, limit)
+	headEnd := cut
+	for headEnd > 0 && isSpaceUnit(units[headEnd-1]) {
+		headEnd--
+	}
+	restStart := cut
+	for restStart < len(units) && isSpaceUnit(units[restStart]) {
+		restStart++
+	}
+
+	head = TextPart{
+		Text:     string(utf16.Decode(units[:headEnd])),
+		Entities: clipEntities(part.Entities, 0, headEnd),
+	}
+	rest = TextPart{
+		Text:     string(utf16.Decode(units[restStart:])),
+		Entities: clipEntities(part.Entities, restStart, len(units)),
+	}
+	return head, rest
+}
+
+// cutPosition returns the length of the head. len(units) must be greater than limit.
+func cutPosition(units []uint16, entities []MessageEntity, limit int) int {
+	// the boundaries in the first half of the text produce too short parts.
+	minCut := limit / 2
+	boundaries := []func(i int) bool{
+		// paragraph
+		func(i int) bool { return units[i] == '\n' && i > 0 && units[i-1] == '\n' },
+		// line
+		func(i int) bool { return units[i] == '\n' },
+		// sentence, the punctuation stays in the head.
+		func(i int) bool { return isSpaceUnit(units[i]) && i > 0 && isSentenceEnd(units[i-1]) },
+		// word
+		func(i int) bool { return isSpaceUnit(units[i]) },
+	}
+	for _, boundary := range boundaries {
+		for i := limit; i > minCut; i-- {
+			if boundary(i) && !insideAtomicEntity(entities, i) {
+				return i
+			}
+		}
+	}
+
+	cut := limit
+	for _, entity := range entities {
+		start, end := int(entity.Offset), int(entity.Offset+entity.Length)
+		if isAtomicEntity(entity) && 0 < start && start < cut && cut < end {
+			cut = start
+		}
+	}
+	if utf16.IsSurrogate(rune(units[cut-1])) && units[cut-1] < 0xDC00 {
+		// do not split the surrogate pair, the pair longer than the limit is the whole head.
+		if cut > 1 {
+			cut--
+		} else {
+			cut++
+		}
+	}
+	return cut
+}
+
+func isSpaceUnit(u uint16) bool {
+	switch u {
+	case ' ', '\n', '\t', '\r':
+		return true
+	}
+	return false
+}
+
+func isSentenceEnd(u uint16) bool {
+	switch u {
+	case '.', '!', '?', '…':
+		return true
+	}
+	return false
+}
+
+// isAtomicEntity reports whether the entity is detected by the text, so a part of it has another meaning.
+func isAtomicEntity(entity MessageEntity) bool {
+	switch entity.Type {
+	case EntityTypeMention, EntityTypeHashtag, EntityTypeCashtag, EntityTypeBotCommand,
+		EntityTypeURL, EntityTypeEmail, EntityTypePhoneNumber, EntityTypeCustomEmoji:
+		return true
+	}
+	return false
+}
+
+func insideAtomicEntity(entities []MessageEntity, i int) bool {
+	for _, entity := range entities {
+		if isAtomicEntity(entity) && int(entity.Offset) < i && i < int(entity.Offset+entity.Length) {
+			return true
+		}
+	}
+	return false
+}
+
+// clipEntities returns the entities cut to the range [start, end) with the offsets relative to start.
+func clipEntities(entities []MessageEntity, start, end int) []MessageEntity {
+	var res []MessageEntity
+	for _, entity := range entities {
+		from, to := int(entity.Offset), int(entity.Offset+entity.Length)
+		if from < start {
+			from = start
+		}
+		if to > end {
+			to = end
+		}
+		if from >= to {
+			continue
+		}
+		clipped := *entity.Clone()
+		clipped.Offset = int64(from - start)
+		clipped.Length = int64(to - from)
+		res = append(res, clipped)
+	}
+	return res
+}
+
+// SendLongMessage sends the text which may be longer than MaxMessageLength.
+// The text is split with SplitText, every next part is sent as a reply to the previous one
+// and the reply markup is attached to the last part.
+// The sent messages are returned even if sending of a part fails.
+func SendLongMessage(ctx context.Context, api BotAPI, args *SendMessageConfig) ([]*Message, error) {
+	if lengthUTF16(args.Text) <= MaxMessageLength {
+		msg, err := api.SendMessage(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		return []*Message{msg}, nil
+	}
+	if args.ParseMode != "" {
+		return nil, ErrSplitParseMode
+	}
+
+	return sendParts(ctx, api, args, SplitText(args.Text, args.Entities, MaxMessageLength), nil)
+}
+
+// SendLongPhoto sends the photo with the caption which may be longer than MaxCaptionLength.
+// The overflow of the caption is sent in the text messages with SendLongMessage rules,
+// the first of them replies to the photo. The reply markup is attached to the last message.
+func SendLongPhoto(ctx context.Context, api BotAPI, args *SendPhotoConfig) ([]*Message, error) {
+	if lengthUTF16(args.Caption) <= MaxCaptionLength {
+		msg, err := api.SendPhoto(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		return []*Message{msg}, nil
+	}
+	if args.ParseMode != "" {
+		return nil, ErrSplitParseMode
+	}
+
+	caption, rest := cutText(TextPart{Text: args.Caption, Entities: args.CaptionEntities}, MaxCaptionLength)
+	photo := *args
+	photo.Caption = caption.Text
+	photo.CaptionEntities = caption.Entities
+	if rest.Text != "" {
+		photo.ReplyMarkup = nil
+	}
+	msg, err := api.SendPhoto(ctx, &photo)
+	if err != nil {
+		return nil, err
+	}
+
+	text := NewSendMessageConfig(args.ChatID, "")
+	text.MessageThreadID = args.MessageThreadID
+	text.DisableNotification = args.DisableNotification
+	text.ProtectContent = args.ProtectContent
+	text.ReplyMarkup = args.ReplyMarkup
+	return sendParts(ctx, api, text, SplitText(rest.Text, rest.Entities, MaxMessageLength), msg)
+}
+
+// sendParts sends the parts with the arguments of args. If prev is not nil, the first part replies to it.
+func sendParts(ctx context.Context, api BotAPI, args *SendMessageConfig, parts []TextPart, prev *Message) ([]*Message, error) {
+	var res []*Message
+	if prev != nil {
+		res = append(res, prev)
+	}
+	for i, part := range parts {
+		cfg := *args
+		cfg.Text = part.Text
+		cfg.Entities = part.Entities
+		if prev != nil {
+			cfg.ReplyToMessageID = prev.MessageID
+		}
+		if i != len(parts)-1 {
+			cfg.ReplyMarkup = nil
+		}
+
+		msg, err := api.SendMessage(ctx, &cfg)
+		if err != nil {
+			return res, err
+		}
+		res = append(res, msg)
+		prev = msg
+	}
+	return res, nil
+}
@@ -0,0 +1,208 @@
+package tgapi
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitText(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		limit int
+		want  []string
+	}{
+		{
+			name:  "short",
+			text:  "hello",
+			limit: 10,
+			want:  []string{"hello"},
+		},
+		{
+			name:  "paragraph",
+			text:  "first line\nsecond\n\nnext paragraph",
+			limit: 25,
+			want:  []string{"first line\nsecond", "next paragraph"},
+		},
+		{
+			name:  "sentence",
+			text:  "One sentence. Two sentence here",
+			limit: 20,
+			want:  []string{"One sentence.", "Two sentence here"},
+		},
+		{
+			name:  "word",
+			text:  "aaaa bbbb cccc dddd",
+			limit: 12,
+			want:  []string{"aaaa bbbb", "cccc dddd"},
+		},
+		{
+			name:  "hard",
+			text:  "abcdefghij",
+			limit: 4,
+			want:  []string{"abcd", "efgh", "ij"},
+		},
+		{
+			name:  "surrogate pair",
+			text:  "ab😀cd",
+			limit: 3,
+			want:  []string{"ab", "😀c", "d"},
+		},
+		{
+			name:  "surrogate pair over limit",
+			text:  "😀ab",
+			limit: 1,
+			want:  []string{"😀", "a", "b"},
+		},
+		{
+			name:  "non-positive limit",
+			text:  "abc",
+			limit: 0,
+			want:  []string{"a", "b", "c"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			limit := tt.limit
+			if limit < 1 {
+				limit = 1
+			}
+			var got []string
+			for _, part := range SplitText(tt.text, nil, tt.limit) {
+				if len([]rune(part.Text)) > 1 {
+					require.LessOrEqual(t, lengthUTF16(part.Text), limit)
+				}
+				got = append(got, part.Text)
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSplitTextEntities(t *testing.T) {
+	// the emoji takes two UTF-16 code units.
+	text := "😀 bold text https://example.com"
+	entities := []MessageEntity{
+		*NewMessageEntity(9, 3, EntityTypeBold),
+		*NewMessageEntity(19, 13, EntityTypeURL),
+	}
+
+	parts := SplitText(text, entities, 20)
+	require.Len(t, parts, 2)
+
+	require.Equal(t, "😀 bold text", parts[0].Text)
+	require.Equal(t, []MessageEntity{*NewMessageEntity(9, 3, EntityTypeBold)}, parts[0].Entities)
+	require.Equal(t, "bold text", parts[0].Entities[0].Extract(parts[0].Text))
+
+	require.Equal(t, "https://example.com", parts[1].Text)
+	require.Equal(t, []MessageEntity{*NewMessageEntity(19, 0, EntityTypeURL)}, parts[1].Entities)
+
+	// the bold entity is cut in two parts.
+	parts = SplitText("bold text", []MessageEntity{*NewMessageEntity(9, 0, EntityTypeBold)}, 5)
+	require.Equal(t, []TextPart{
+		{Text: "bold", Entities: []MessageEntity{*NewMessageEntity(4, 0, EntityTypeBold)}},
+		{Text: "text", Entities: []MessageEntity{*NewMessageEntity(4, 0, EntityTypeBold)}},
+	}, parts)
+
+	// the part ends before the URL, the URL longer than the limit is cut anyway.
+	parts = SplitText("go https://example.com", []MessageEntity{*NewMessageEntity(19, 3, EntityTypeURL)}, 10)
+	require.Equal(t, "go", parts[0].Text)
+	require.Equal(t, "https://ex", parts[1].Text)
+}
+
+func fakeSender() *FakeAPI {
+	var id int64
+	fake := &FakeAPI{}
+	fake.SendMessageFunc = func(ctx context.Context, args *SendMessageConfig) (*Message, error) {
+		id++
+		return &Message{MessageID: id, Text: &args.Text}, nil
+	}
+	fake.SendPhotoFunc = func(ctx context.Context, args *SendPhotoConfig) (*Message, error) {
+		id++
+		return &Message{MessageID: id, Caption: &args.Caption}, nil
+	}
+	return fake
+}
+
+func TestSendLongMessage(t *testing.T) {
+	ctx := context.Background()
+	fake := fakeSender()
+	keyboard := testKeyboard()
+
+	paragraph := strings.Repeat("word ", 300)
+	text := paragraph + "\n\n" + paragraph + "\n\n" + paragraph
+	args := NewSendMessageConfig(NewInt(1), text).SetReplyToMessageID(100).SetReplyMarkup(keyboard)
+	msgs, err := SendLongMessage(ctx, fake, args)
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+
+	calls := fake.CallsOf("sendMessage")
+	require.Len(t, calls, 2)
+	first := calls[0].Args[0].(*SendMessageConfig)
+	second := calls[1].Args[0].(*SendMessageConfig)
+	require.Equal(t, strings.TrimSpace(paragraph+"\n\n"+paragraph), first.Text)
+	require.Equal(t, int64(100), first.ReplyToMessageID)
+	require.Nil(t, first.ReplyMarkup)
+	require.Equal(t, paragraph, second.Text)
+	require.Equal(t, msgs[0].MessageID, second.ReplyToMessageID)
+	require.Equal(t, keyboard, second.ReplyMarkup)
+
+	args.ParseMode = ParseModeHTML
+	_, err = SendLongMessage(ctx, fake, args)
+	require.True(t, errors.Is(err, ErrSplitParseMode))
+}
+
+func TestSendLongMessageShort(t *testing.T) {
+	fake := fakeSender()
+	msgs, err := SendLongMessage(context.Background(), fake, &SendMessageConfig{
+		ChatID:    NewInt(1),
+		Text:      "<b>short</b>",
+		ParseMode: ParseModeHTML,
+	})
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+}
+
+func TestSendLongPhoto(t *testing.T) {
+	fake := fakeSender()
+
+	caption := strings.Repeat("word ", 300)
+	args := NewSendPhotoConfig(NewInt(1), InputFile{FileID: "photo"}).
+		SetCaption(caption).
+		SetCaptionEntities([]MessageEntity{*NewMessageEntity(1500, 0, EntityTypeItalic)})
+	msgs, err := SendLongPhoto(context.Background(), fake, args)
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+
+	photo := fake.CallsOf("sendPhoto")[0].Args[0].(*SendPhotoConfig)
+	require.LessOrEqual(t, lengthUTF16(photo.Caption), MaxCaptionLength)
+	require.Equal(t, int64(lengthUTF16(photo.Caption)), photo.CaptionEntities[0].Length)
+
+	text := fake.CallsOf("sendMessage")[0].Args[0].(*SendMessageConfig)
+	require.Equal(t, caption, photo.Caption+" "+text.Text)
+	require.Equal(t, msgs[0].MessageID, text.ReplyToMessageID)
+	require.Equal(t, int64(lengthUTF16(text.Text)), text.Entities[0].Length)
+	require.Equal(t, int64(0), text.Entities[0].Offset)
+}
+
+func TestSendLongPhotoSpaceOverflow(t *testing.T) {
+	fake := fakeSender()
+	keyboard := testKeyboard()
+
+	// only the whitespace overflows the caption, so no text messages are sent.
+	caption := strings.Repeat("a", MaxCaptionLength) + "   "
+	args := NewSendPhotoConfig(NewInt(1), InputFile{FileID: "photo"}).SetCaption(caption).SetReplyMarkup(keyboard)
+	msgs, err := SendLongPhoto(context.Background(), fake, args)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	require.Empty(t, fake.CallsOf("sendMessage"))
+
+	photo := fake.CallsOf("sendPhoto")[0].Args[0].(*SendPhotoConfig)
+	require.Equal(t, strings.Repeat("a", MaxCaptionLength), photo.Caption)
+	require.Equal(t, keyboard, photo.ReplyMarkup)
+}
@@ -2,27 +2,45 @@ package tgapi
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
-	"sync/atomic"
+	"sync"
 	"time"
 )
 
 const (
-	defaultPollTimeout   = 3 * time.Second
-	shutdownPollInterval = time.Millisecond
+	defaultMinBackoff   = time.Second
+	defaultMaxBackoff   = time.Minute
+	defaultDrainTimeout = 10 * time.Second
+	// longPollMargin is added to GetUpdatesConfig.Timeout to get the deadline of the request.
+	longPollMargin = 10 * time.Second
+)
+
+var (
+	// ErrPollerClosed is returned by Run after Shutdown.
+	ErrPollerClosed = errors.New("long poller closed")
+	// ErrPollerRunning is returned by Run if the poller is already running.
+	ErrPollerRunning = errors.New("long poller is already running")
 )
 
 // ErrorCallback is a function that is called when an error occurs during an HTTP request on get updates.
 type ErrorCallback func(error)
 
 type pollerOptions struct {
-	pollTimeout         time.Duration
+	minBackoff          time.Duration
+	maxBackoff          time.Duration
+	drainTimeout        time.Duration
 	listenErrorCallback ErrorCallback
+	offsetStore         OffsetStore
+	atLeastOnce         bool
 }
 
 func getDefaultPollerOptions() pollerOptions {
 	return pollerOptions{
-		pollTimeout: defaultPollTimeout,
+		minBackoff:   defaultMinBackoff,
+		maxBackoff:   defaultMaxBackoff,
+		drainTimeout: defaultDrainTimeout,
 		listenErrorCallback: func(err error) {
 			log.Printf("listen updates: %v", err)
 		},
@@ -33,16 +51,57 @@ func getDefaultPollerOptions() pollerOptions {
 type LongPollerOption func(*pollerOptions)
 
 // LongPollerErrorListener sets up a listener for polling errors.
+// The listener may be called from the handler goroutines, e.g. with *PanicError.
 func LongPollerErrorListener(listener ErrorCallback) LongPollerOption {
 	return func(options *pollerOptions) {
 		options.listenErrorCallback = listener
 	}
 }
 
-// LongPollerErrorListener sets the timeout after an error occurs during polling.
+// LongPollerPollTimeout sets the timeout after an error occurs during polling.
+//
+// Deprecated: the timeout grows after every error, use LongPollerBackoff.
 func LongPollerPollTimeout(timeout time.Duration) LongPollerOption {
+	return LongPollerBackoff(timeout, timeout)
+}
+
+// LongPollerBackoff sets the timeouts after the polling errors. The timeout starts from min,
+// doubles after every next error up to max and is reset after a successful request.
+// The longer Error.RetryAfter of the flood errors is respected.
+func LongPollerBackoff(min, max time.Duration) LongPollerOption {
+	return func(options *pollerOptions) {
+		options.minBackoff = min
+		options.maxBackoff = max
+	}
+}
+
+// LongPollerDrainTimeout sets how long Run waits for the running handlers after its context is canceled.
+// Then the contexts of the handlers are canceled.
+func LongPollerDrainTimeout(timeout time.Duration) LongPollerOption {
+	return func(options *pollerOptions) {
+		options.drainTimeout = timeout
+	}
+}
+
+// LongPollerOffsetStore sets up the store of the update offset, e.g. FileOffsetStore.
+// Run resumes from the saved offset if it is greater than GetUpdatesConfig.Offset.
+// By default the offset is saved after the updates are dispatched to the handlers,
+// so the updates being handled are lost on crash, see LongPollerAtLeastOnce.
+// The errors of the store are reported to the error listener.
+func LongPollerOffsetStore(store OffsetStore) LongPollerOption {
+	return func(options *pollerOptions) {
+		options.offsetStore = store
+	}
+}
+
+// LongPollerAtLeastOnce commits the offset only after the handlers of the update
+// and of all earlier updates have finished. The uncommitted updates are neither saved to
+// the store nor confirmed to Telegram, so they are delivered again after a crash or restart.
+// Handlers must be idempotent. The panic of a handler is recovered and reported as *PanicError,
+// the update is committed as handled, so it is not delivered again.
+func LongPollerAtLeastOnce() LongPollerOption {
 	return func(options *pollerOptions) {
-		options.pollTimeout = timeout
+		options.atLeastOnce = true
 	}
 }
 
@@ -50,17 +109,38 @@ type Handler interface {
 	HandleUpdate(context.Context, *Update)
 }
 
+// LongPoller requests the updates with getUpdates and handles every update in a new goroutine.
 type LongPoller struct {
 	opts pollerOptions
 
 	api     *API
 	handler Handler
-	ctx     context.Context
-	cancel  context.CancelFunc
-	// running goroutines.
-	running int32
-	// graceful stop. Closing a channel prevents requests for new updates.
-	stop chan struct{}
+
+	mu  sync.Mutex
+	run *pollerRun
+	// closed is set by Shutdown, the next calls of Run return ErrPollerClosed.
+	closed bool
+}
+
+// pollerRun is the state of a single Run call.
+type pollerRun struct {
+	// stop is closed by Shutdown to prevent requests for new updates.
+	stop     chan struct{}
+	stopOnce sync.Once
+	// cancelPoll cancels the in-flight getUpdates request.
+	cancelPoll context.CancelFunc
+	// cancelHandlers cancels the contexts of the running handlers.
+	cancelHandlers context.CancelFunc
+	handlers       sync.WaitGroup
+	// done is closed when Run returns.
+	done chan struct{}
+}
+
+func (r *pollerRun) shutdown() {
+	r.stopOnce.Do(func() {
+		close(r.stop)
+		r.cancelPoll()
+	})
 }
 
 func NewPoller(api *API, handler Handler, options ...LongPollerOption) *LongPoller {
@@ -68,74 +148,273 @@ func NewPoller(api *API, handler Handler, options ...LongPollerOption) *LongPoll
 	for _, option := range options {
 		option(&opts)
 	}
-	ctx, cancel := context.WithCancel(context.Background())
-	poller := &LongPoller{
+	return &LongPoller{
 		api:     api,
 		opts:    opts,
 		handler: handler,
-		ctx:     ctx,
-		cancel:  cancel,
-		stop:    make(chan struct{}),
 	}
-
-	return poller
 }
 
+// Listen calls Run with the background context and reports its error to the error listener.
+//
+// Deprecated: use Run.
 func (lp *LongPoller) Listen(updatesConfig *GetUpdatesConfig) {
+	if err := lp.Run(context.Background(), updatesConfig); err != nil && !errors.Is(err, ErrPollerClosed) {
+		lp.reportError(err)
+	}
+}
+
+// Run requests the updates and handles every update in a new goroutine
+// until the context is canceled or Shutdown is called. updatesConfig may be nil,
+// its Offset is advanced to the committed offset.
+//
+// The request is sent with the deadline of updatesConfig.Timeout plus a margin,
+// the timeout of the HTTP client is extended for the long polling if needed.
+// After the polling errors Run waits with the exponential backoff, see LongPollerBackoff.
+//
+// When the context is canceled, the in-flight request is canceled at once and Run waits
+// for the running handlers up to LongPollerDrainTimeout, then cancels their contexts,
+// waits for them to return and returns the context error.
+// Run returns ErrPollerClosed after Shutdown, even if Shutdown was called before Run.
+// Otherwise the poller can be run again after Run returns.
+// The values of the context are passed to the handlers.
+func (lp *LongPoller) Run(ctx context.Context, updatesConfig *GetUpdatesConfig) error {
+	if updatesConfig == nil {
+		updatesConfig = &GetUpdatesConfig{}
+	}
+
+	pollCtx, cancelPoll := context.WithCancel(ctx)
+	defer cancelPoll()
+	handlersCtx, cancelHandlers := context.WithCancel(valuesContext{ctx})
+	defer cancelHandlers()
+	run := &pollerRun{
+		stop:           make(chan struct{}),
+		cancelPoll:     cancelPoll,
+		cancelHandlers: cancelHandlers,
+		done:           make(chan struct{}),
+	}
+
+	lp.mu.Lock()
+	if lp.closed {
+		lp.mu.Unlock()
+		return ErrPollerClosed
+	}
+	if lp.run != nil {
+		lp.mu.Unlock()
+		return ErrPollerRunning
+	}
+	lp.run = run
+	lp.mu.Unlock()
+	defer func() {
+		lp.mu.Lock()
+		lp.run = nil
+		lp.mu.Unlock()
+		close(run.done)
+	}()
+
+	if lp.opts.offsetStore != nil {
+		offset, err := lp.opts.offsetStore.Load()
+		if err != nil {
+			return fmt.Errorf("load offset: %w", err)
+		}
+		if offset > updatesConfig.Offset {
+			updatesConfig.Offset = offset
+		}
+	}
+	offsets := newOffsetTracker(updatesConfig.Offset, lp.opts)
+
+	lp.poll(pollCtx, handlersCtx, run, offsets, updatesConfig)
+
+	select {
+	case <-run.stop:
+		// Shutdown waits for the handlers with its own deadline.
+		run.handlers.Wait()
+		return ErrPollerClosed
+	default:
+	}
+
+	drained := make(chan struct{})
+	go func() {
+		run.handlers.Wait()
+		close(drained)
+	}()
+	timer := time.NewTimer(lp.opts.drainTimeout)
+	defer timer.Stop()
+	select {
+	case <-drained:
+	case <-timer.C:
+		cancelHandlers()
+		<-drained
+	}
+	return ctx.Err()
+}
+
+// poll requests and dispatches the updates until ctx is canceled or run is stopped.
+func (lp *LongPoller) poll(
+	ctx, handlersCtx context.Context,
+	run *pollerRun,
+	offsets *offsetTracker,
+	updatesConfig *GetUpdatesConfig,
+) {
+	api := lp.pollAPI(updatesConfig)
+	var backoff time.Duration
 	for {
 		select {
-		case <-lp.stop:
+		case <-run.stop:
 			return
-		case <-lp.ctx.Done():
+		case <-ctx.Done():
 			return
 		default:
 		}
 
-		updates, err := lp.api.GetUpdates(lp.ctx, updatesConfig)
+		updatesConfig.Offset = offsets.committed()
+		reqCtx, cancel := context.WithTimeout(ctx, pollDeadline(updatesConfig))
+		updates, err := api.GetUpdates(reqCtx, updatesConfig)
+		cancel()
 		if err != nil {
-			if lp.opts.listenErrorCallback != nil {
-				lp.opts.listenErrorCallback(err)
+			if ctx.Err() != nil {
+				return
+			}
+			lp.reportError(err)
+			backoff = lp.nextBackoff(backoff, err)
+			if !sleep(ctx, run.stop, backoff) {
+				return
 			}
-			time.Sleep(lp.opts.pollTimeout)
 			continue
 		}
+		backoff = 0
 
+		dispatched := false
 		for _, upd := range updates {
 			upd := upd
-			if upd.UpdateID >= updatesConfig.Offset {
-				updatesConfig.Offset = upd.UpdateID + 1
-
-				atomic.AddInt32(&lp.running, 1)
-				cctx, cancel := context.WithCancel(lp.ctx)
-				go func() {
-					lp.handler.HandleUpdate(cctx, &upd)
-					cancel()
-					atomic.AddInt32(&lp.running, -1)
-				}()
+			if !offsets.dispatch(upd.UpdateID) {
+				// the update is still being handled.
+				continue
+			}
+			dispatched = true
+
+			run.handlers.Add(1)
+			go func() {
+				defer run.handlers.Done()
+				if err := observeUpdate(handlersCtx, api.observer, lp.handler, &upd); err != nil {
+					lp.reportError(err)
+				}
+				offsets.finish(upd.UpdateID)
+			}()
+		}
+		offsets.save()
+		updatesConfig.Offset = offsets.committed()
+
+		if len(updates) != 0 && !dispatched {
+			// Telegram returns the unconfirmed updates immediately, wait for a handler to avoid busy polling.
+			if !wait(ctx, run.stop, offsets.finished) {
+				return
 			}
 		}
 	}
 }
 
-// Shutdown a-la http.Server.
-func (lp *LongPoller) Shutdown(ctx context.Context) error {
-	close(lp.stop)
-	defer lp.cancel()
+// wait reports whether the event happened before ctx was canceled or the poller was stopped.
+func wait(ctx context.Context, stop <-chan struct{}, event <-chan struct{}) bool {
+	select {
+	case <-stop:
+		return false
+	case <-ctx.Done():
+		return false
+	case <-event:
+		return true
+	}
+}
 
-	ticker := time.NewTicker(shutdownPollInterval)
-	defer ticker.Stop()
-	for {
-		if atomic.LoadInt32(&lp.running) == 0 {
-			return nil
-		}
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-ticker.C:
+// sleep reports whether the timeout passed before ctx was canceled or the poller was stopped.
+func sleep(ctx context.Context, stop <-chan struct{}, timeout time.Duration) bool {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-stop:
+		return false
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// pollDeadline returns the deadline of the getUpdates request.
+func pollDeadline(updatesConfig *GetUpdatesConfig) time.Duration {
+	return time.Duration(updatesConfig.Timeout)*time.Second + longPollMargin
+}
+
+// pollAPI returns the API whose HTTP client timeout does not interrupt the long polling.
+func (lp *LongPoller) pollAPI(updatesConfig *GetUpdatesConfig) *API {
+	deadline := pollDeadline(updatesConfig)
+	if lp.api.cli.Timeout == 0 || lp.api.cli.Timeout >= deadline {
+		return lp.api
+	}
+	cli := *lp.api.cli
+	cli.Timeout = deadline
+	api := *lp.api
+	api.cli = &cli
+	return &api
+}
+
+// nextBackoff doubles the backoff within the limits, the flood errors wait for RetryAfter at least.
+func (lp *LongPoller) nextBackoff(backoff time.Duration, err error) time.Duration {
+	backoff *= 2
+	if backoff < lp.opts.minBackoff {
+		backoff = lp.opts.minBackoff
+	}
+	if backoff > lp.opts.maxBackoff {
+		backoff = lp.opts.maxBackoff
+	}
+	var apiErr Error
+	if errors.As(err, &apiErr) {
+		if retryAfter := time.Duration(apiErr.GetRetryAfter()) * time.Second; retryAfter > backoff {
+			backoff = retryAfter
 		}
 	}
+	return backoff
 }
 
+func (lp *LongPoller) reportError(err error) {
+	if lp.opts.listenErrorCallback != nil {
+		lp.opts.listenErrorCallback(err)
+	}
+}
+
+// Shutdown a-la http.Server: stops requesting the updates, cancels the in-flight request
+// and waits for the running handlers until ctx is done. Then the contexts of the handlers
+// are canceled and ctx.Err() is returned. Shutdown stops the current Run, if any,
+// and can be called several times. Once Shutdown is called, Run returns ErrPollerClosed.
+func (lp *LongPoller) Shutdown(ctx context.Context) error {
+	lp.mu.Lock()
+	lp.closed = true
+	run := lp.run
+	lp.mu.Unlock()
+	if run == nil {
+		return nil
+	}
+
+	run.shutdown()
+	select {
+	case <-run.done:
+		return nil
+	case <-ctx.Done():
+		run.cancelHandlers()
+		return ctx.Err()
+	}
+}
+
+// valuesContext keeps the values of the parent context but not its cancellation,
+// so the handlers are not interrupted as soon as Run is canceled.
+type valuesContext struct {
+	context.Context
+}
+
+func (valuesContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (valuesContext) Done() <-chan struct{}       { return nil }
+func (valuesContext) Err() error                  { return nil }
+
 // AcceptFunc is a function for validating incoming Update, similar to the path prefix in http.
 // This function must be non-blocking.
 type AcceptFunc func(*Update) bool
@@ -145,17 +424,26 @@ type HandlerFunc func(context.Context, *Update)
 
 // CallTree is a type for handling the incoming updates.
 type CallTree struct {
+	name    string
 	accept  AcceptFunc
 	handler HandlerFunc
 	childs  []*CallTree
 }
 
+// Name sets the name of the node reported to the Observer.
+// By default it is the name of the handler function.
+func (c *CallTree) Name(name string) *CallTree {
+	c.name = name
+	return c
+}
+
 // NewCallTree creates a new instance of the call tree with the given handler.
 func NewCallTree(defaultHandler HandlerFunc) *CallTree {
 	return &CallTree{
 		// first accept must be true.
 		accept:  func(*Update) bool { return true },
 		handler: defaultHandler,
+		name:    funcName(defaultHandler),
 	}
 }
 
@@ -169,6 +457,7 @@ func (c *CallTree) NewChild(accept AcceptFunc, handler HandlerFunc) *CallTree {
 	child := &CallTree{
 		accept:  accept,
 		handler: handler,
+		name:    funcName(handler),
 	}
 	c.childs = append(c.childs, child)
 	return child
@@ -197,6 +486,7 @@ func (c *CallTree) walk(ctx context.Context, update *Update) (stop bool) {
 
 	if c.handler != nil {
 		// leaf
+		setHandlerName(ctx, c.name)
 		c.handler(ctx, update)
 		return true
 	}
@@ -0,0 +1,313 @@
+package tgapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type memoryOffsetStore struct {
+	mu    sync.Mutex
+	saved []int64
+}
+
+func (m *memoryOffsetStore) Load() (int64, error) { return 0, nil }
+
+func (m *memoryOffsetStore) Save(offset int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.saved = append(m.saved, offset)
+	return nil
+}
+
+func (m *memoryOffsetStore) Saved() []int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]int64(nil), m.saved...)
+}
+
+// newUpdatesServer returns the updates starting from the requested offset up to last
+// and sends the requested offsets to the channel.
+func newUpdatesServer(t *testing.T, last int64, offsets chan<- int64) *API {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/getUpdates") {
+			http.NotFound(w, r)
+			return
+		}
+		var config GetUpdatesConfig
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&config))
+		offset := config.Offset
+		select {
+		case offsets <- offset:
+		default:
+		}
+
+		var updates []string
+		for id := offset; id <= last; id++ {
+			if id > 0 {
+				updates = append(updates, fmt.Sprintf(`{"update_id":%d,"message":{"message_id":%d,`+
+					`"date":0,"chat":{"id":1,"type":"private"},"text":"hi"}}`, id, id))
+			}
+		}
+		if len(updates) == 0 {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-time.After(5*time.Millisecond + time.Duration(config.Timeout)*time.Second):
+			}
+		}
+		fmt.Fprintf(w, `{"ok":true,"result":[%s]}`, strings.Join(updates, ","))
+	}))
+	t.Cleanup(server.Close)
+	return NewWithEndpointAndClient("token", server.URL, server.URL, server.Client())
+}
+
+func TestLongPollerAtLeastOnce(t *testing.T) {
+	offsets := make(chan int64, 100)
+	api := newUpdatesServer(t, 2, offsets)
+
+	var (
+		mu      sync.Mutex
+		handled = make(map[int64]int)
+	)
+	release := make(chan struct{})
+	secondDone := make(chan struct{})
+	handler := NewCallTree(func(ctx context.Context, upd *Update) {
+		mu.Lock()
+		handled[upd.UpdateID]++
+		mu.Unlock()
+		if upd.UpdateID == 1 {
+			<-release
+			return
+		}
+		close(secondDone)
+	})
+
+	store := &memoryOffsetStore{}
+	poller := NewPoller(api, handler, LongPollerOffsetStore(store), LongPollerAtLeastOnce())
+	go poller.Listen(&GetUpdatesConfig{})
+
+	<-secondDone
+	// the first update is in flight, so the second one is not committed.
+	require.Equal(t, []int64{1}, store.Saved())
+	for len(offsets) < 3 {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+
+	for offset := range offsets {
+		if offset == 3 {
+			break
+		}
+		require.LessOrEqual(t, offset, int64(1))
+	}
+	require.NoError(t, poller.Shutdown(context.Background()))
+
+	require.Equal(t, []int64{1, 3}, store.Saved())
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, map[int64]int{1: 1, 2: 1}, handled)
+}
+
+type blockingOffsetStore struct {
+	memoryOffsetStore
+	release chan struct{}
+}
+
+func (b *blockingOffsetStore) Save(offset int64) error {
+	<-b.release
+	return b.memoryOffsetStore.Save(offset)
+}
+
+func TestOffsetTrackerCommit(t *testing.T) {
+	store := &blockingOffsetStore{release: make(chan struct{})}
+	tracker := newOffsetTracker(1, pollerOptions{offsetStore: store, atLeastOnce: true})
+	require.True(t, tracker.dispatch(1))
+	require.True(t, tracker.dispatch(2))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		tracker.finish(1)
+	}()
+	for tracker.committed() != 2 {
+		time.Sleep(time.Millisecond)
+	}
+	// the store is saving the offset, but the tracker is not locked.
+	require.True(t, tracker.dispatch(3))
+	require.Equal(t, int64(2), tracker.committed())
+	close(store.release)
+	<-done
+	tracker.finish(2)
+	tracker.finish(3)
+	require.Equal(t, []int64{2, 3, 4}, store.Saved())
+
+	var errs []error
+	failing := newOffsetTracker(1, pollerOptions{
+		offsetStore: FileOffsetStore(filepath.Join(t.TempDir(), "missing", "offset")),
+	})
+	failing.onError = func(err error) {
+		// the callback may use the tracker.
+		require.Equal(t, int64(2), failing.committed())
+		errs = append(errs, err)
+	}
+	require.True(t, failing.dispatch(1))
+	failing.save()
+	require.Len(t, errs, 1)
+}
+
+func TestLongPollerResume(t *testing.T) {
+	dir, err := ioutil.TempDir("", "offset")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	store := FileOffsetStore(filepath.Join(dir, "offset"))
+
+	offset, err := store.Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(0), offset)
+	require.NoError(t, store.Save(5))
+
+	offsets := make(chan int64, 100)
+	api := newUpdatesServer(t, 5, offsets)
+	handled := make(chan int64, 10)
+	poller := NewPoller(api, NewCallTree(func(ctx context.Context, upd *Update) {
+		handled <- upd.UpdateID
+	}), LongPollerOffsetStore(store))
+
+	config := &GetUpdatesConfig{}
+	go poller.Listen(config)
+	require.Equal(t, int64(5), <-offsets)
+	require.Equal(t, int64(5), <-handled)
+	require.Equal(t, int64(6), <-offsets)
+	require.NoError(t, poller.Shutdown(context.Background()))
+
+	offset, err = store.Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(6), offset)
+	require.Empty(t, handled)
+}
+
+type testKey struct{}
+
+func TestLongPollerRun(t *testing.T) {
+	offsets := make(chan int64, 100)
+	api := newUpdatesServer(t, 1, offsets)
+	handled := make(chan error, 1)
+	poller := NewPoller(api, NewCallTree(func(ctx context.Context, upd *Update) {
+		require.Equal(t, "value", ctx.Value(testKey{}))
+		// the handler is canceled after the drain timeout.
+		<-ctx.Done()
+		handled <- ctx.Err()
+	}), LongPollerDrainTimeout(10*time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), testKey{}, "value"))
+	done := make(chan error)
+	config := &GetUpdatesConfig{Timeout: 60}
+	go func() { done <- poller.Run(ctx, config) }()
+
+	for offset := range offsets {
+		if offset == 2 {
+			break
+		}
+	}
+	require.True(t, errors.Is(poller.Run(ctx, config), ErrPollerRunning))
+	// the long poll is interrupted at once.
+	cancel()
+	select {
+	case err := <-done:
+		require.True(t, errors.Is(err, context.Canceled))
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run is not canceled")
+	}
+	require.True(t, errors.Is(<-handled, context.Canceled))
+}
+
+func TestLongPollerPanic(t *testing.T) {
+	offsets := make(chan int64, 100)
+	api := newUpdatesServer(t, 2, offsets)
+	handled := make(chan int64, 2)
+	errs := make(chan error, 10)
+	poller := NewPoller(api, NewCallTree(func(ctx context.Context, upd *Update) {
+		handled <- upd.UpdateID
+		if upd.UpdateID == 1 {
+			panic("boom")
+		}
+	}), LongPollerErrorListener(func(err error) { errs <- err }))
+	go poller.Listen(&GetUpdatesConfig{})
+
+	// the panic does not stop the poller and the next update is handled.
+	require.ElementsMatch(t, []int64{1, 2}, []int64{<-handled, <-handled})
+	require.NoError(t, poller.Shutdown(context.Background()))
+
+	var panicErr *PanicError
+	require.True(t, errors.As(<-errs, &panicErr))
+	require.Equal(t, int64(1), panicErr.UpdateID)
+	require.Equal(t, "boom", panicErr.Value)
+	require.NotEmpty(t, panicErr.Stack)
+}
+
+func TestLongPollerShutdown(t *testing.T) {
+	offsets := make(chan int64, 100)
+	api := newUpdatesServer(t, 0, offsets)
+	poller := NewPoller(api, NewCallTree(nil))
+
+	done := make(chan error)
+	go func() { done <- poller.Run(context.Background(), &GetUpdatesConfig{Timeout: 60}) }()
+	<-offsets
+
+	start := time.Now()
+	require.NoError(t, poller.Shutdown(context.Background()))
+	require.NoError(t, poller.Shutdown(context.Background()))
+	require.Less(t, int64(time.Since(start)), int64(5*time.Second))
+	require.True(t, errors.Is(<-done, ErrPollerClosed))
+	require.True(t, errors.Is(poller.Run(context.Background(), nil), ErrPollerClosed))
+}
+
+func TestLongPollerShutdownBeforeRun(t *testing.T) {
+	offsets := make(chan int64, 100)
+	api := newUpdatesServer(t, 0, offsets)
+	poller := NewPoller(api, NewCallTree(nil))
+	require.NoError(t, poller.Shutdown(context.Background()))
+
+	require.True(t, errors.Is(poller.Run(context.Background(), &GetUpdatesConfig{Timeout: 60}), ErrPollerClosed))
+	require.Len(t, offsets, 0)
+}
+
+func TestLongPollerBackoff(t *testing.T) {
+	poller := NewPoller(nil, nil, LongPollerBackoff(time.Second, 4*time.Second))
+	err := errors.New("network")
+	var got []time.Duration
+	var backoff time.Duration
+	for i := 0; i < 4; i++ {
+		backoff = poller.nextBackoff(backoff, err)
+		got = append(got, backoff)
+	}
+	require.Equal(t, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second}, got)
+
+	retryAfter := int64(10)
+	flood := Error{Code: 429, ResponseParameters: ResponseParameters{RetryAfter: &retryAfter}}
+	require.Equal(t, 10*time.Second, poller.nextBackoff(0, flood))
+}
+
+func TestLongPollerClientTimeout(t *testing.T) {
+	cli := &http.Client{Timeout: 5 * time.Second}
+	poller := NewPoller(NewWithEndpointAndClient("token", APIEndpoint, FileEndpoint, cli), nil)
+
+	api := poller.pollAPI(&GetUpdatesConfig{Timeout: 30})
+	require.Equal(t, 40*time.Second, api.cli.Timeout)
+	require.Equal(t, 5*time.Second, cli.Timeout)
+	cli.Timeout = time.Minute
+	require.True(t, poller.api == poller.pollAPI(&GetUpdatesConfig{Timeout: 30}))
+}
@@ -0,0 +1,172 @@
+package tgapi
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultMediaGroupWindow is the time to wait for the next message of the album.
+	DefaultMediaGroupWindow = time.Second
+	// MaxMediaGroupSize is the maximum number of the messages in the album.
+	MaxMediaGroupSize = 10
+)
+
+// Album is the media group: the new messages or channel posts sharing Message.MediaGroupID.
+type Album struct {
+	MediaGroupID string
+	// Updates are sorted by the message ID.
+	Updates []*Update
+}
+
+// Messages returns the messages of the album in order.
+func (a *Album) Messages() []*Message {
+	res := make([]*Message, 0, len(a.Updates))
+	for _, upd := range a.Updates {
+		res = append(res, upd.EffectiveMessage())
+	}
+	return res
+}
+
+// AlbumHandlerFunc is called once for the whole album.
+type AlbumHandlerFunc func(context.Context, *Album)
+
+// MediaGroupOption is used to customize the MediaGroupHandler.
+type MediaGroupOption func(*MediaGroupHandler)
+
+// MediaGroupWindow sets the time to wait for the next message of the album,
+// the window starts again after every message. DefaultMediaGroupWindow is used by default.
+func MediaGroupWindow(window time.Duration) MediaGroupOption {
+	return func(h *MediaGroupHandler) {
+		h.window = window
+	}
+}
+
+// MediaGroupHandler collects the messages of the albums and passes the other updates to the next handler.
+// The album is delivered when no message of it arrives within the window or when it has MaxMediaGroupSize messages.
+//
+// HandleUpdate of every message of the album returns after the album is handled,
+// so LongPoller commits the offset and drains the handlers correctly.
+// The album is handled with the context of its first update.
+type MediaGroupHandler struct {
+	next   Handler
+	album  AlbumHandlerFunc
+	window time.Duration
+
+	mu     sync.Mutex
+	groups map[string]*pendingAlbum
+}
+
+var _ Handler = (*MediaGroupHandler)(nil)
+
+type pendingAlbum struct {
+	album    Album
+	deadline time.Time
+	// full is closed when the album has MaxMediaGroupSize messages.
+	full chan struct{}
+	// done is closed when the album is handled.
+	done chan struct{}
+}
+
+// NewMediaGroupHandler creates the handler delivering the albums to album and the other updates to next.
+func NewMediaGroupHandler(next Handler, album AlbumHandlerFunc, options ...MediaGroupOption) *MediaGroupHandler {
+	h := &MediaGroupHandler{
+		next:   next,
+		album:  album,
+		window: DefaultMediaGroupWindow,
+		groups: make(map[string]*pendingAlbum),
+	}
+	for _, option := range options {
+		option(h)
+	}
+	return h
+}
+
+// HandleUpdate is the implementation method for the Handler interface.
+func (h *MediaGroupHandler) HandleUpdate(ctx context.Context, update *Update) {
+	key, ok := mediaGroupKey(update)
+	if !ok {
+		h.next.HandleUpdate(ctx, update)
+		return
+	}
+
+	h.mu.Lock()
+	pending, exists := h.groups[key]
+	if !exists {
+		pending = &pendingAlbum{
+			album: Album{MediaGroupID: update.EffectiveMessage().GetMediaGroupID()},
+			full:  make(chan struct{}),
+			done:  make(chan struct{}),
+		}
+		h.groups[key] = pending
+	}
+	pending.album.Updates = append(pending.album.Updates, update)
+	pending.deadline = time.Now().Add(h.window)
+	if len(pending.album.Updates) == MaxMediaGroupSize {
+		close(pending.full)
+	}
+	h.mu.Unlock()
+
+	if exists {
+		select {
+		case <-pending.done:
+		case <-ctx.Done():
+		}
+		return
+	}
+
+	h.collect(ctx, key, pending)
+	defer close(pending.done)
+	sort.SliceStable(pending.album.Updates, func(i, j int) bool {
+		return pending.album.Updates[i].EffectiveMessage().MessageID < pending.album.Updates[j].EffectiveMessage().MessageID
+	})
+	setHandlerName(ctx, funcName(h.album))
+	h.album(ctx, &pending.album)
+}
+
+// collect waits for the rest of the album and removes it from the pending ones.
+func (h *MediaGroupHandler) collect(ctx context.Context, key string, pending *pendingAlbum) {
+	defer func() {
+		h.mu.Lock()
+		delete(h.groups, key)
+		h.mu.Unlock()
+	}()
+
+	timer := time.NewTimer(h.window)
+	defer timer.Stop()
+	for {
+		select {
+		case <-pending.full:
+			return
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		h.mu.Lock()
+		wait := time.Until(pending.deadline)
+		h.mu.Unlock()
+		if wait <= 0 {
+			return
+		}
+		timer.Reset(wait)
+	}
+}
+
+// mediaGroupKey returns the key of the album of the new message or channel post.
+func mediaGroupKey(update *Update) (string, bool) {
+	var msg *Message
+	switch update.Kind() {
+	case UpdateKindMessage, UpdateKindChannelPost:
+		msg = update.EffectiveMessage()
+	default:
+		return "", false
+	}
+	if msg.MediaGroupID == nil {
+		return "", false
+	}
+	return strconv.FormatInt(msg.Chat.ID, 10) + ":" + *msg.MediaGroupID, true
+}
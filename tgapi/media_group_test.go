@@ -0,0 +1,98 @@
+package tgapi
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func albumUpdate(messageID int64, mediaGroupID string) *Update {
+	msg := &Message{MessageID: messageID, Chat: Chat{ID: 1}}
+	if mediaGroupID != "" {
+		msg.SetMediaGroupID(mediaGroupID)
+	}
+	return &Update{UpdateID: messageID, Message: msg}
+}
+
+func TestMediaGroupHandler(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		albums []*Album
+		others []int64
+	)
+	h := NewMediaGroupHandler(
+		NewCallTree(func(ctx context.Context, upd *Update) {
+			mu.Lock()
+			defer mu.Unlock()
+			others = append(others, upd.UpdateID)
+		}),
+		func(ctx context.Context, album *Album) {
+			mu.Lock()
+			defer mu.Unlock()
+			albums = append(albums, album)
+		},
+		MediaGroupWindow(50*time.Millisecond),
+	)
+
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	for _, upd := range []*Update{
+		albumUpdate(3, "a"),
+		albumUpdate(1, "a"),
+		albumUpdate(5, ""),
+		albumUpdate(2, "a"),
+		albumUpdate(4, "b"),
+	} {
+		upd := upd
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			h.HandleUpdate(ctx, upd)
+		}()
+		time.Sleep(10 * time.Millisecond)
+	}
+	wg.Wait()
+
+	// every HandleUpdate returns after the album is handled.
+	require.Len(t, albums, 2)
+	require.Equal(t, []int64{5}, others)
+	byID := make(map[string]*Album)
+	for _, album := range albums {
+		byID[album.MediaGroupID] = album
+	}
+	var ids []int64
+	for _, msg := range byID["a"].Messages() {
+		ids = append(ids, msg.MessageID)
+	}
+	require.Equal(t, []int64{1, 2, 3}, ids)
+	require.Len(t, byID["b"].Updates, 1)
+	require.Empty(t, h.groups)
+}
+
+func TestMediaGroupHandlerFull(t *testing.T) {
+	handled := make(chan *Album, 2)
+	h := NewMediaGroupHandler(nil, func(ctx context.Context, album *Album) {
+		handled <- album
+	}, MediaGroupWindow(time.Hour))
+
+	var wg sync.WaitGroup
+	for i := 1; i <= MaxMediaGroupSize; i++ {
+		upd := albumUpdate(int64(i), "full")
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			h.HandleUpdate(context.Background(), upd)
+		}()
+	}
+	wg.Wait()
+	require.Len(t, (<-handled).Updates, MaxMediaGroupSize)
+
+	// the canceled context flushes the album at once.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	h.HandleUpdate(ctx, albumUpdate(1, "canceled"))
+	require.Len(t, (<-handled).Updates, 1)
+}
@@ -27,6 +27,30 @@ type AddStickerToSetConfig struct {
 	UserID int64 `json:"user_id"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *AddStickerToSetConfig) Validate() error {
+	return nil
+}
+
+// Method returns the name of the API method.
+func (t *AddStickerToSetConfig) Method() string {
+	return "addStickerToSet"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *AddStickerToSetConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewAddStickerToSetConfig creates AddStickerToSetConfig with the required arguments.
+func NewAddStickerToSetConfig(name string, sticker InputSticker, userID int64) *AddStickerToSetConfig {
+	return &AddStickerToSetConfig{
+		Name:    name,
+		Sticker: sticker,
+		UserID:  userID,
+	}
+}
+
 // AddStickerToSet
 // Use this method to add a new sticker to a set created by the bot. The format of the added
 // sticker must match the format of the other stickers in the set. Emoji sticker sets can have up
@@ -36,8 +60,7 @@ func (api *API) AddStickerToSet(
 	ctx context.Context,
 	args *AddStickerToSetConfig,
 ) error {
-	_, err := api.MakeRequest(ctx, "addStickerToSet", args)
-	return err
+	return api.Do(ctx, args, nil)
 }
 
 // AnswerCallbackQuery
@@ -68,6 +91,50 @@ type AnswerCallbackQueryConfig struct {
 	URL string `json:"url,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *AnswerCallbackQueryConfig) Validate() error {
+	return firstError(
+		validateLength(!isZero(t.Text), "text", t.Text, 0, 200),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *AnswerCallbackQueryConfig) Method() string {
+	return "answerCallbackQuery"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *AnswerCallbackQueryConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewAnswerCallbackQueryConfig creates AnswerCallbackQueryConfig with the required arguments.
+func NewAnswerCallbackQueryConfig(callbackQueryID string) *AnswerCallbackQueryConfig {
+	return &AnswerCallbackQueryConfig{
+		CallbackQueryID: callbackQueryID,
+	}
+}
+
+func (t *AnswerCallbackQueryConfig) SetCacheTime(cacheTime int64) *AnswerCallbackQueryConfig {
+	t.CacheTime = cacheTime
+	return t
+}
+
+func (t *AnswerCallbackQueryConfig) SetShowAlert(showAlert bool) *AnswerCallbackQueryConfig {
+	t.ShowAlert = showAlert
+	return t
+}
+
+func (t *AnswerCallbackQueryConfig) SetText(text string) *AnswerCallbackQueryConfig {
+	t.Text = text
+	return t
+}
+
+func (t *AnswerCallbackQueryConfig) SetURL(url string) *AnswerCallbackQueryConfig {
+	t.URL = url
+	return t
+}
+
 // AnswerCallbackQuery
 // Use this method to send answers to callback queries sent from inline keyboards. The answer will
 // be displayed to the user as a notification at the top of the chat screen or as an alert. On
@@ -76,8 +143,7 @@ func (api *API) AnswerCallbackQuery(
 	ctx context.Context,
 	args *AnswerCallbackQueryConfig,
 ) error {
-	_, err := api.MakeRequest(ctx, "answerCallbackQuery", args)
-	return err
+	return api.Do(ctx, args, nil)
 }
 
 // AnswerInlineQuery
@@ -108,6 +174,51 @@ type AnswerInlineQueryConfig struct {
 	NextOffset string `json:"next_offset,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *AnswerInlineQueryConfig) Validate() error {
+	return firstError(
+		validateBytes(!isZero(t.NextOffset), "next_offset", t.NextOffset, 0, 64),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *AnswerInlineQueryConfig) Method() string {
+	return "answerInlineQuery"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *AnswerInlineQueryConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewAnswerInlineQueryConfig creates AnswerInlineQueryConfig with the required arguments.
+func NewAnswerInlineQueryConfig(inlineQueryID string, results []InlineQueryResult) *AnswerInlineQueryConfig {
+	return &AnswerInlineQueryConfig{
+		InlineQueryID: inlineQueryID,
+		Results:       results,
+	}
+}
+
+func (t *AnswerInlineQueryConfig) SetButton(button InlineQueryResultsButton) *AnswerInlineQueryConfig {
+	t.Button = &button
+	return t
+}
+
+func (t *AnswerInlineQueryConfig) SetCacheTime(cacheTime int64) *AnswerInlineQueryConfig {
+	t.CacheTime = cacheTime
+	return t
+}
+
+func (t *AnswerInlineQueryConfig) SetIsPersonal(isPersonal bool) *AnswerInlineQueryConfig {
+	t.IsPersonal = isPersonal
+	return t
+}
+
+func (t *AnswerInlineQueryConfig) SetNextOffset(nextOffset string) *AnswerInlineQueryConfig {
+	t.NextOffset = nextOffset
+	return t
+}
+
 // AnswerInlineQuery
 // Use this method to send answers to an inline query. On success, True is returned.No more than 50
 // results per query are allowed.
@@ -115,8 +226,7 @@ func (api *API) AnswerInlineQuery(
 	ctx context.Context,
 	args *AnswerInlineQueryConfig,
 ) error {
-	_, err := api.MakeRequest(ctx, "answerInlineQuery", args)
-	return err
+	return api.Do(ctx, args, nil)
 }
 
 // AnswerPreCheckoutQuery
@@ -140,6 +250,36 @@ type AnswerPreCheckoutQueryConfig struct {
 	ErrorMessage string `json:"error_message,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *AnswerPreCheckoutQueryConfig) Validate() error {
+	return firstError(
+		validateRequired(!t.Ok, "error_message", t.ErrorMessage, "required if ok is false"),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *AnswerPreCheckoutQueryConfig) Method() string {
+	return "answerPreCheckoutQuery"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *AnswerPreCheckoutQueryConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewAnswerPreCheckoutQueryConfig creates AnswerPreCheckoutQueryConfig with the required arguments.
+func NewAnswerPreCheckoutQueryConfig(ok bool, preCheckoutQueryID string) *AnswerPreCheckoutQueryConfig {
+	return &AnswerPreCheckoutQueryConfig{
+		Ok:                 ok,
+		PreCheckoutQueryID: preCheckoutQueryID,
+	}
+}
+
+func (t *AnswerPreCheckoutQueryConfig) SetErrorMessage(errorMessage string) *AnswerPreCheckoutQueryConfig {
+	t.ErrorMessage = errorMessage
+	return t
+}
+
 // AnswerPreCheckoutQuery
 // Once the user has confirmed their payment and shipping details, the Bot API sends the final
 // confirmation in the form of an Update with the field pre_checkout_query. Use this method to
@@ -149,8 +289,7 @@ func (api *API) AnswerPreCheckoutQuery(
 	ctx context.Context,
 	args *AnswerPreCheckoutQueryConfig,
 ) error {
-	_, err := api.MakeRequest(ctx, "answerPreCheckoutQuery", args)
-	return err
+	return api.Do(ctx, args, nil)
 }
 
 // AnswerShippingQuery
@@ -175,6 +314,42 @@ type AnswerShippingQueryConfig struct {
 	ShippingOptions []ShippingOption `json:"shipping_options,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *AnswerShippingQueryConfig) Validate() error {
+	return firstError(
+		validateRequired(!t.Ok, "error_message", t.ErrorMessage, "required if ok is false"),
+		validateRequired(t.Ok, "shipping_options", t.ShippingOptions, "required if ok is true"),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *AnswerShippingQueryConfig) Method() string {
+	return "answerShippingQuery"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *AnswerShippingQueryConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewAnswerShippingQueryConfig creates AnswerShippingQueryConfig with the required arguments.
+func NewAnswerShippingQueryConfig(ok bool, shippingQueryID string) *AnswerShippingQueryConfig {
+	return &AnswerShippingQueryConfig{
+		Ok:              ok,
+		ShippingQueryID: shippingQueryID,
+	}
+}
+
+func (t *AnswerShippingQueryConfig) SetErrorMessage(errorMessage string) *AnswerShippingQueryConfig {
+	t.ErrorMessage = errorMessage
+	return t
+}
+
+func (t *AnswerShippingQueryConfig) SetShippingOptions(shippingOptions []ShippingOption) *AnswerShippingQueryConfig {
+	t.ShippingOptions = shippingOptions
+	return t
+}
+
 // AnswerShippingQuery
 // If you sent an invoice requesting a shipping address and the parameter is_flexible was
 // specified, the Bot API will send an Update with a shipping_query field to the bot. Use this
@@ -183,8 +358,7 @@ func (api *API) AnswerShippingQuery(
 	ctx context.Context,
 	args *AnswerShippingQueryConfig,
 ) error {
-	_, err := api.MakeRequest(ctx, "answerShippingQuery", args)
-	return err
+	return api.Do(ctx, args, nil)
 }
 
 // AnswerWebAppQuery
@@ -259,6 +433,39 @@ type BanChatMemberConfig struct {
 	UntilDate int64 `json:"until_date,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *BanChatMemberConfig) Validate() error {
+	return nil
+}
+
+// Method returns the name of the API method.
+func (t *BanChatMemberConfig) Method() string {
+	return "banChatMember"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *BanChatMemberConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewBanChatMemberConfig creates BanChatMemberConfig with the required arguments.
+func NewBanChatMemberConfig(chatID IntStr, userID int64) *BanChatMemberConfig {
+	return &BanChatMemberConfig{
+		ChatID: chatID,
+		UserID: userID,
+	}
+}
+
+func (t *BanChatMemberConfig) SetRevokeMessages(revokeMessages bool) *BanChatMemberConfig {
+	t.RevokeMessages = revokeMessages
+	return t
+}
+
+func (t *BanChatMemberConfig) SetUntilDate(untilDate int64) *BanChatMemberConfig {
+	t.UntilDate = untilDate
+	return t
+}
+
 // BanChatMember
 // Use this method to ban a user in a group, a supergroup or a channel. In the case of supergroups
 // and channels, the user will not be able to return to the chat on their own using invite links,
@@ -268,8 +475,7 @@ func (api *API) BanChatMember(
 	ctx context.Context,
 	args *BanChatMemberConfig,
 ) error {
-	_, err := api.MakeRequest(ctx, "banChatMember", args)
-	return err
+	return api.Do(ctx, args, nil)
 }
 
 // BanChatSenderChat
@@ -385,7 +591,7 @@ type CopyMessageConfig struct {
 	MessageThreadID int64 `json:"message_thread_id,omitempty"`
 	// ParseMode
 	// Mode for parsing entities in the new caption. See formatting options for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 	// ProtectContent
 	// Protects the contents of the sent message from forwarding and saving
 	ProtectContent bool `json:"protect_content,omitempty"`
@@ -398,6 +604,77 @@ type CopyMessageConfig struct {
 	ReplyToMessageID int64 `json:"reply_to_message_id,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *CopyMessageConfig) Validate() error {
+	return firstError(
+		validateLength(!isZero(t.Caption) && isZero(t.ParseMode), "caption", t.Caption, 0, 1024),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *CopyMessageConfig) Method() string {
+	return "copyMessage"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *CopyMessageConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewCopyMessageConfig creates CopyMessageConfig with the required arguments.
+func NewCopyMessageConfig(chatID IntStr, fromChatID IntStr, messageID int64) *CopyMessageConfig {
+	return &CopyMessageConfig{
+		ChatID:     chatID,
+		FromChatID: fromChatID,
+		MessageID:  messageID,
+	}
+}
+
+func (t *CopyMessageConfig) SetAllowSendingWithoutReply(allowSendingWithoutReply bool) *CopyMessageConfig {
+	t.AllowSendingWithoutReply = allowSendingWithoutReply
+	return t
+}
+
+func (t *CopyMessageConfig) SetCaption(caption string) *CopyMessageConfig {
+	t.Caption = caption
+	return t
+}
+
+func (t *CopyMessageConfig) SetCaptionEntities(captionEntities []MessageEntity) *CopyMessageConfig {
+	t.CaptionEntities = captionEntities
+	return t
+}
+
+func (t *CopyMessageConfig) SetDisableNotification(disableNotification bool) *CopyMessageConfig {
+	t.DisableNotification = disableNotification
+	return t
+}
+
+func (t *CopyMessageConfig) SetMessageThreadID(messageThreadID int64) *CopyMessageConfig {
+	t.MessageThreadID = messageThreadID
+	return t
+}
+
+func (t *CopyMessageConfig) SetParseMode(parseMode ParseMode) *CopyMessageConfig {
+	t.ParseMode = parseMode
+	return t
+}
+
+func (t *CopyMessageConfig) SetProtectContent(protectContent bool) *CopyMessageConfig {
+	t.ProtectContent = protectContent
+	return t
+}
+
+func (t *CopyMessageConfig) SetReplyMarkup(replyMarkup ReplyMarkup) *CopyMessageConfig {
+	t.ReplyMarkup = replyMarkup
+	return t
+}
+
+func (t *CopyMessageConfig) SetReplyToMessageID(replyToMessageID int64) *CopyMessageConfig {
+	t.ReplyToMessageID = replyToMessageID
+	return t
+}
+
 // CopyMessage
 // Use this method to copy messages of any kind. Service messages and invoice messages can't be
 // copied. A quiz poll can be copied only if the value of the field correct_option_id is known to
@@ -407,13 +684,11 @@ func (api *API) CopyMessage(
 	ctx context.Context,
 	args *CopyMessageConfig,
 ) (*MessageID, error) {
-	resp, err := api.MakeRequest(ctx, "copyMessage", args)
-	if err != nil {
+	var res MessageID
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data MessageID
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
+	return &res, nil
 }
 
 // CreateChatInviteLink
@@ -442,6 +717,51 @@ type CreateChatInviteLinkConfig struct {
 	Name string `json:"name,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *CreateChatInviteLinkConfig) Validate() error {
+	return firstError(
+		validateRange(!isZero(t.MemberLimit), "member_limit", t.MemberLimit, 1, 99999),
+		validateLength(!isZero(t.Name), "name", t.Name, 0, 32),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *CreateChatInviteLinkConfig) Method() string {
+	return "createChatInviteLink"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *CreateChatInviteLinkConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewCreateChatInviteLinkConfig creates CreateChatInviteLinkConfig with the required arguments.
+func NewCreateChatInviteLinkConfig(chatID IntStr) *CreateChatInviteLinkConfig {
+	return &CreateChatInviteLinkConfig{
+		ChatID: chatID,
+	}
+}
+
+func (t *CreateChatInviteLinkConfig) SetCreatesJoinRequest(createsJoinRequest bool) *CreateChatInviteLinkConfig {
+	t.CreatesJoinRequest = createsJoinRequest
+	return t
+}
+
+func (t *CreateChatInviteLinkConfig) SetExpireDate(expireDate int64) *CreateChatInviteLinkConfig {
+	t.ExpireDate = expireDate
+	return t
+}
+
+func (t *CreateChatInviteLinkConfig) SetMemberLimit(memberLimit int64) *CreateChatInviteLinkConfig {
+	t.MemberLimit = memberLimit
+	return t
+}
+
+func (t *CreateChatInviteLinkConfig) SetName(name string) *CreateChatInviteLinkConfig {
+	t.Name = name
+	return t
+}
+
 // CreateChatInviteLink
 // Use this method to create an additional invite link for a chat. The bot must be an administrator
 // in the chat for this to work and must have the appropriate administrator rights. The link can be
@@ -451,13 +771,11 @@ func (api *API) CreateChatInviteLink(
 	ctx context.Context,
 	args *CreateChatInviteLinkConfig,
 ) (*ChatInviteLink, error) {
-	resp, err := api.MakeRequest(ctx, "createChatInviteLink", args)
-	if err != nil {
+	var res ChatInviteLink
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data ChatInviteLink
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
+	return &res, nil
 }
 
 // CreateForumTopic
@@ -483,6 +801,41 @@ type CreateForumTopicConfig struct {
 	IconCustomEmojiID string `json:"icon_custom_emoji_id,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *CreateForumTopicConfig) Validate() error {
+	return firstError(
+		validateLength(true, "name", t.Name, 1, 128),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *CreateForumTopicConfig) Method() string {
+	return "createForumTopic"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *CreateForumTopicConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewCreateForumTopicConfig creates CreateForumTopicConfig with the required arguments.
+func NewCreateForumTopicConfig(chatID IntStr, name string) *CreateForumTopicConfig {
+	return &CreateForumTopicConfig{
+		ChatID: chatID,
+		Name:   name,
+	}
+}
+
+func (t *CreateForumTopicConfig) SetIconColor(iconColor int64) *CreateForumTopicConfig {
+	t.IconColor = iconColor
+	return t
+}
+
+func (t *CreateForumTopicConfig) SetIconCustomEmojiID(iconCustomEmojiID string) *CreateForumTopicConfig {
+	t.IconCustomEmojiID = iconCustomEmojiID
+	return t
+}
+
 // CreateForumTopic
 // Use this method to create a topic in a forum supergroup chat. The bot must be an administrator
 // in the chat for this to work and must have the can_manage_topics administrator rights. Returns
@@ -491,13 +844,11 @@ func (api *API) CreateForumTopic(
 	ctx context.Context,
 	args *CreateForumTopicConfig,
 ) (*ForumTopic, error) {
-	resp, err := api.MakeRequest(ctx, "createForumTopic", args)
-	if err != nil {
+	var res ForumTopic
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data ForumTopic
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
+	return &res, nil
 }
 
 // CreateInvoiceLink
@@ -576,6 +927,108 @@ type CreateInvoiceLinkConfig struct {
 	SuggestedTipAmounts []int64 `json:"suggested_tip_amounts,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *CreateInvoiceLinkConfig) Validate() error {
+	return firstError(
+		validateLength(true, "description", t.Description, 1, 255),
+		validateBytes(true, "payload", t.Payload, 1, 128),
+		validateCount(!isZero(t.SuggestedTipAmounts), "suggested_tip_amounts", t.SuggestedTipAmounts, 0, 4),
+		validateLength(true, "title", t.Title, 1, 32),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *CreateInvoiceLinkConfig) Method() string {
+	return "createInvoiceLink"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *CreateInvoiceLinkConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewCreateInvoiceLinkConfig creates CreateInvoiceLinkConfig with the required arguments.
+func NewCreateInvoiceLinkConfig(currency string, description string, payload string, prices []LabeledPrice, providerToken string, title string) *CreateInvoiceLinkConfig {
+	return &CreateInvoiceLinkConfig{
+		Currency:      currency,
+		Description:   description,
+		Payload:       payload,
+		Prices:        prices,
+		ProviderToken: providerToken,
+		Title:         title,
+	}
+}
+
+func (t *CreateInvoiceLinkConfig) SetIsFlexible(isFlexible bool) *CreateInvoiceLinkConfig {
+	t.IsFlexible = isFlexible
+	return t
+}
+
+func (t *CreateInvoiceLinkConfig) SetMaxTipAmount(maxTipAmount int64) *CreateInvoiceLinkConfig {
+	t.MaxTipAmount = maxTipAmount
+	return t
+}
+
+func (t *CreateInvoiceLinkConfig) SetNeedEmail(needEmail bool) *CreateInvoiceLinkConfig {
+	t.NeedEmail = needEmail
+	return t
+}
+
+func (t *CreateInvoiceLinkConfig) SetNeedName(needName bool) *CreateInvoiceLinkConfig {
+	t.NeedName = needName
+	return t
+}
+
+func (t *CreateInvoiceLinkConfig) SetNeedPhoneNumber(needPhoneNumber bool) *CreateInvoiceLinkConfig {
+	t.NeedPhoneNumber = needPhoneNumber
+	return t
+}
+
+func (t *CreateInvoiceLinkConfig) SetNeedShippingAddress(needShippingAddress bool) *CreateInvoiceLinkConfig {
+	t.NeedShippingAddress = needShippingAddress
+	return t
+}
+
+func (t *CreateInvoiceLinkConfig) SetPhotoHeight(photoHeight int64) *CreateInvoiceLinkConfig {
+	t.PhotoHeight = photoHeight
+	return t
+}
+
+func (t *CreateInvoiceLinkConfig) SetPhotoSize(photoSize int64) *CreateInvoiceLinkConfig {
+	t.PhotoSize = photoSize
+	return t
+}
+
+func (t *CreateInvoiceLinkConfig) SetPhotoURL(photoURL string) *CreateInvoiceLinkConfig {
+	t.PhotoURL = photoURL
+	return t
+}
+
+func (t *CreateInvoiceLinkConfig) SetPhotoWidth(photoWidth int64) *CreateInvoiceLinkConfig {
+	t.PhotoWidth = photoWidth
+	return t
+}
+
+func (t *CreateInvoiceLinkConfig) SetProviderData(providerData string) *CreateInvoiceLinkConfig {
+	t.ProviderData = providerData
+	return t
+}
+
+func (t *CreateInvoiceLinkConfig) SetSendEmailToProvider(sendEmailToProvider bool) *CreateInvoiceLinkConfig {
+	t.SendEmailToProvider = sendEmailToProvider
+	return t
+}
+
+func (t *CreateInvoiceLinkConfig) SetSendPhoneNumberToProvider(sendPhoneNumberToProvider bool) *CreateInvoiceLinkConfig {
+	t.SendPhoneNumberToProvider = sendPhoneNumberToProvider
+	return t
+}
+
+func (t *CreateInvoiceLinkConfig) SetSuggestedTipAmounts(suggestedTipAmounts []int64) *CreateInvoiceLinkConfig {
+	t.SuggestedTipAmounts = suggestedTipAmounts
+	return t
+}
+
 // CreateInvoiceLink
 // Use this method to create a link for an invoice. Returns the created invoice link as String on
 // success.
@@ -583,13 +1036,11 @@ func (api *API) CreateInvoiceLink(
 	ctx context.Context,
 	args *CreateInvoiceLinkConfig,
 ) (string, error) {
-	resp, err := api.MakeRequest(ctx, "createInvoiceLink", args)
-	if err != nil {
+	var res string
+	if err := api.Do(ctx, args, &res); err != nil {
 		return "", err
 	}
-	var data string
-	err = json.Unmarshal(resp.Result, &data)
-	return data, err
+	return res, nil
 }
 
 // CreateNewStickerSet
@@ -604,7 +1055,7 @@ type CreateNewStickerSetConfig struct {
 	Name string `json:"name"`
 	// StickerFormat
 	// Format of stickers in the set, must be one of "static", "animated", "video"
-	StickerFormat string `json:"sticker_format"`
+	StickerFormat StickerFormat `json:"sticker_format"`
 	// Stickers
 	// A JSON-serialized list of 1-50 initial stickers to be added to the sticker set
 	Stickers []InputSticker `json:"stickers"`
@@ -622,7 +1073,47 @@ type CreateNewStickerSetConfig struct {
 	// StickerType
 	// Type of stickers in the set, pass "regular", "mask", or "custom_emoji". By default, a
 	// regular sticker set is created.
-	StickerType string `json:"sticker_type,omitempty"`
+	StickerType StickerType `json:"sticker_type,omitempty"`
+}
+
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *CreateNewStickerSetConfig) Validate() error {
+	return firstError(
+		validateLength(true, "name", t.Name, 1, 64),
+		validateCount(true, "stickers", t.Stickers, 1, 50),
+		validateLength(true, "title", t.Title, 1, 64),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *CreateNewStickerSetConfig) Method() string {
+	return "createNewStickerSet"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *CreateNewStickerSetConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewCreateNewStickerSetConfig creates CreateNewStickerSetConfig with the required arguments.
+func NewCreateNewStickerSetConfig(name string, stickerFormat StickerFormat, stickers []InputSticker, title string, userID int64) *CreateNewStickerSetConfig {
+	return &CreateNewStickerSetConfig{
+		Name:          name,
+		StickerFormat: stickerFormat,
+		Stickers:      stickers,
+		Title:         title,
+		UserID:        userID,
+	}
+}
+
+func (t *CreateNewStickerSetConfig) SetNeedsRepainting(needsRepainting bool) *CreateNewStickerSetConfig {
+	t.NeedsRepainting = needsRepainting
+	return t
+}
+
+func (t *CreateNewStickerSetConfig) SetStickerType(stickerType StickerType) *CreateNewStickerSetConfig {
+	t.StickerType = stickerType
+	return t
 }
 
 // CreateNewStickerSet
@@ -632,8 +1123,7 @@ func (api *API) CreateNewStickerSet(
 	ctx context.Context,
 	args *CreateNewStickerSetConfig,
 ) error {
-	_, err := api.MakeRequest(ctx, "createNewStickerSet", args)
-	return err
+	return api.Do(ctx, args, nil)
 }
 
 // DeclineChatJoinRequest
@@ -840,6 +1330,52 @@ type EditChatInviteLinkConfig struct {
 	Name string `json:"name,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *EditChatInviteLinkConfig) Validate() error {
+	return firstError(
+		validateRange(!isZero(t.MemberLimit), "member_limit", t.MemberLimit, 1, 99999),
+		validateLength(!isZero(t.Name), "name", t.Name, 0, 32),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *EditChatInviteLinkConfig) Method() string {
+	return "editChatInviteLink"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *EditChatInviteLinkConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewEditChatInviteLinkConfig creates EditChatInviteLinkConfig with the required arguments.
+func NewEditChatInviteLinkConfig(chatID IntStr, inviteLink string) *EditChatInviteLinkConfig {
+	return &EditChatInviteLinkConfig{
+		ChatID:     chatID,
+		InviteLink: inviteLink,
+	}
+}
+
+func (t *EditChatInviteLinkConfig) SetCreatesJoinRequest(createsJoinRequest bool) *EditChatInviteLinkConfig {
+	t.CreatesJoinRequest = createsJoinRequest
+	return t
+}
+
+func (t *EditChatInviteLinkConfig) SetExpireDate(expireDate int64) *EditChatInviteLinkConfig {
+	t.ExpireDate = expireDate
+	return t
+}
+
+func (t *EditChatInviteLinkConfig) SetMemberLimit(memberLimit int64) *EditChatInviteLinkConfig {
+	t.MemberLimit = memberLimit
+	return t
+}
+
+func (t *EditChatInviteLinkConfig) SetName(name string) *EditChatInviteLinkConfig {
+	t.Name = name
+	return t
+}
+
 // EditChatInviteLink
 // Use this method to edit a non-primary invite link created by the bot. The bot must be an
 // administrator in the chat for this to work and must have the appropriate administrator rights.
@@ -848,13 +1384,11 @@ func (api *API) EditChatInviteLink(
 	ctx context.Context,
 	args *EditChatInviteLinkConfig,
 ) (*ChatInviteLink, error) {
-	resp, err := api.MakeRequest(ctx, "editChatInviteLink", args)
-	if err != nil {
+	var res ChatInviteLink
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data ChatInviteLink
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
+	return &res, nil
 }
 
 // EditForumTopic
@@ -880,6 +1414,41 @@ type EditForumTopicConfig struct {
 	Name string `json:"name,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *EditForumTopicConfig) Validate() error {
+	return firstError(
+		validateLength(!isZero(t.Name), "name", t.Name, 0, 128),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *EditForumTopicConfig) Method() string {
+	return "editForumTopic"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *EditForumTopicConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewEditForumTopicConfig creates EditForumTopicConfig with the required arguments.
+func NewEditForumTopicConfig(chatID IntStr, messageThreadID int64) *EditForumTopicConfig {
+	return &EditForumTopicConfig{
+		ChatID:          chatID,
+		MessageThreadID: messageThreadID,
+	}
+}
+
+func (t *EditForumTopicConfig) SetIconCustomEmojiID(iconCustomEmojiID string) *EditForumTopicConfig {
+	t.IconCustomEmojiID = iconCustomEmojiID
+	return t
+}
+
+func (t *EditForumTopicConfig) SetName(name string) *EditForumTopicConfig {
+	t.Name = name
+	return t
+}
+
 // EditForumTopic
 // Use this method to edit name and icon of a topic in a forum supergroup chat. The bot must be an
 // administrator in the chat for this to work and must have can_manage_topics administrator rights,
@@ -888,8 +1457,7 @@ func (api *API) EditForumTopic(
 	ctx context.Context,
 	args *EditForumTopicConfig,
 ) error {
-	_, err := api.MakeRequest(ctx, "editForumTopic", args)
-	return err
+	return api.Do(ctx, args, nil)
 }
 
 // EditGeneralForumTopic
@@ -937,26 +1505,84 @@ type EditMessageCaptionConfig struct {
 	MessageID int64 `json:"message_id,omitempty"`
 	// ParseMode
 	// Mode for parsing entities in the message caption. See formatting options for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 	// ReplyMarkup
 	// A JSON-serialized object for an inline keyboard.
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
-// EditMessageCaption
-// Use this method to edit captions of messages. On success, if the edited message is not an inline
-// message, the edited Message is returned, otherwise True is returned.
-func (api *API) EditMessageCaption(
-	ctx context.Context,
-	args *EditMessageCaptionConfig,
-) (*Message, error) {
-	resp, err := api.MakeRequest(ctx, "editMessageCaption", args)
-	if err != nil {
-		return nil, err
-	}
-	var data Message
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *EditMessageCaptionConfig) Validate() error {
+	return firstError(
+		validateLength(!isZero(t.Caption) && isZero(t.ParseMode), "caption", t.Caption, 0, 1024),
+		validateRequired(isZero(t.InlineMessageID), "chat_id", t.ChatID, "required if inline_message_id is not specified"),
+		validateRequired(isZero(t.ChatID) && isZero(t.MessageID), "inline_message_id", t.InlineMessageID, "required if chat_id and message_id are not specified"),
+		validateRequired(isZero(t.InlineMessageID), "message_id", t.MessageID, "required if inline_message_id is not specified"),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *EditMessageCaptionConfig) Method() string {
+	return "editMessageCaption"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *EditMessageCaptionConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewEditMessageCaptionConfig creates EditMessageCaptionConfig with the required arguments.
+func NewEditMessageCaptionConfig() *EditMessageCaptionConfig {
+	return &EditMessageCaptionConfig{}
+}
+
+func (t *EditMessageCaptionConfig) SetCaption(caption string) *EditMessageCaptionConfig {
+	t.Caption = caption
+	return t
+}
+
+func (t *EditMessageCaptionConfig) SetCaptionEntities(captionEntities []MessageEntity) *EditMessageCaptionConfig {
+	t.CaptionEntities = captionEntities
+	return t
+}
+
+func (t *EditMessageCaptionConfig) SetChatID(chatID IntStr) *EditMessageCaptionConfig {
+	t.ChatID = chatID
+	return t
+}
+
+func (t *EditMessageCaptionConfig) SetInlineMessageID(inlineMessageID string) *EditMessageCaptionConfig {
+	t.InlineMessageID = inlineMessageID
+	return t
+}
+
+func (t *EditMessageCaptionConfig) SetMessageID(messageID int64) *EditMessageCaptionConfig {
+	t.MessageID = messageID
+	return t
+}
+
+func (t *EditMessageCaptionConfig) SetParseMode(parseMode ParseMode) *EditMessageCaptionConfig {
+	t.ParseMode = parseMode
+	return t
+}
+
+func (t *EditMessageCaptionConfig) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *EditMessageCaptionConfig {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
+// EditMessageCaption
+// Use this method to edit captions of messages. On success, if the edited message is not an inline
+// message, the edited Message is returned, otherwise True is returned.
+func (api *API) EditMessageCaption(
+	ctx context.Context,
+	args *EditMessageCaptionConfig,
+) (*Message, error) {
+	var res Message
+	if err := api.Do(ctx, args, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
 }
 
 // EditMessageLiveLocation
@@ -996,6 +1622,71 @@ type EditMessageLiveLocationConfig struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *EditMessageLiveLocationConfig) Validate() error {
+	return firstError(
+		validateRequired(isZero(t.InlineMessageID), "chat_id", t.ChatID, "required if inline_message_id is not specified"),
+		validateRange(!isZero(t.Heading), "heading", t.Heading, 1, 360),
+		validateRange(!isZero(t.HorizontalAccuracy), "horizontal_accuracy", t.HorizontalAccuracy, 0, 1500),
+		validateRequired(isZero(t.ChatID) && isZero(t.MessageID), "inline_message_id", t.InlineMessageID, "required if chat_id and message_id are not specified"),
+		validateRequired(isZero(t.InlineMessageID), "message_id", t.MessageID, "required if inline_message_id is not specified"),
+		validateRange(!isZero(t.ProximityAlertRadius), "proximity_alert_radius", t.ProximityAlertRadius, 1, 100000),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *EditMessageLiveLocationConfig) Method() string {
+	return "editMessageLiveLocation"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *EditMessageLiveLocationConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewEditMessageLiveLocationConfig creates EditMessageLiveLocationConfig with the required arguments.
+func NewEditMessageLiveLocationConfig(latitude float64, longitude float64) *EditMessageLiveLocationConfig {
+	return &EditMessageLiveLocationConfig{
+		Latitude:  latitude,
+		Longitude: longitude,
+	}
+}
+
+func (t *EditMessageLiveLocationConfig) SetChatID(chatID IntStr) *EditMessageLiveLocationConfig {
+	t.ChatID = chatID
+	return t
+}
+
+func (t *EditMessageLiveLocationConfig) SetHeading(heading int64) *EditMessageLiveLocationConfig {
+	t.Heading = heading
+	return t
+}
+
+func (t *EditMessageLiveLocationConfig) SetHorizontalAccuracy(horizontalAccuracy float64) *EditMessageLiveLocationConfig {
+	t.HorizontalAccuracy = &horizontalAccuracy
+	return t
+}
+
+func (t *EditMessageLiveLocationConfig) SetInlineMessageID(inlineMessageID string) *EditMessageLiveLocationConfig {
+	t.InlineMessageID = inlineMessageID
+	return t
+}
+
+func (t *EditMessageLiveLocationConfig) SetMessageID(messageID int64) *EditMessageLiveLocationConfig {
+	t.MessageID = messageID
+	return t
+}
+
+func (t *EditMessageLiveLocationConfig) SetProximityAlertRadius(proximityAlertRadius int64) *EditMessageLiveLocationConfig {
+	t.ProximityAlertRadius = proximityAlertRadius
+	return t
+}
+
+func (t *EditMessageLiveLocationConfig) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *EditMessageLiveLocationConfig {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
 // EditMessageLiveLocation
 // Use this method to edit live location messages. A location can be edited until its live_period
 // expires or editing is explicitly disabled by a call to stopMessageLiveLocation. On success, if
@@ -1005,13 +1696,11 @@ func (api *API) EditMessageLiveLocation(
 	ctx context.Context,
 	args *EditMessageLiveLocationConfig,
 ) (*Message, error) {
-	resp, err := api.MakeRequest(ctx, "editMessageLiveLocation", args)
-	if err != nil {
+	var res Message
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data Message
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
+	return &res, nil
 }
 
 // EditMessageMedia
@@ -1040,6 +1729,52 @@ type EditMessageMediaConfig struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *EditMessageMediaConfig) Validate() error {
+	return firstError(
+		validateRequired(isZero(t.InlineMessageID), "chat_id", t.ChatID, "required if inline_message_id is not specified"),
+		validateRequired(isZero(t.ChatID) && isZero(t.MessageID), "inline_message_id", t.InlineMessageID, "required if chat_id and message_id are not specified"),
+		validateRequired(isZero(t.InlineMessageID), "message_id", t.MessageID, "required if inline_message_id is not specified"),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *EditMessageMediaConfig) Method() string {
+	return "editMessageMedia"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *EditMessageMediaConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewEditMessageMediaConfig creates EditMessageMediaConfig with the required arguments.
+func NewEditMessageMediaConfig(media InputMedia) *EditMessageMediaConfig {
+	return &EditMessageMediaConfig{
+		Media: media,
+	}
+}
+
+func (t *EditMessageMediaConfig) SetChatID(chatID IntStr) *EditMessageMediaConfig {
+	t.ChatID = chatID
+	return t
+}
+
+func (t *EditMessageMediaConfig) SetInlineMessageID(inlineMessageID string) *EditMessageMediaConfig {
+	t.InlineMessageID = inlineMessageID
+	return t
+}
+
+func (t *EditMessageMediaConfig) SetMessageID(messageID int64) *EditMessageMediaConfig {
+	t.MessageID = messageID
+	return t
+}
+
+func (t *EditMessageMediaConfig) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *EditMessageMediaConfig {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
 // EditMessageMedia
 // Use this method to edit animation, audio, document, photo, or video messages. If a message is
 // part of a message album, then it can be edited only to an audio for audio albums, only to a
@@ -1051,13 +1786,11 @@ func (api *API) EditMessageMedia(
 	ctx context.Context,
 	args *EditMessageMediaConfig,
 ) (*Message, error) {
-	resp, err := api.MakeRequest(ctx, "editMessageMedia", args)
-	if err != nil {
+	var res Message
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data Message
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
+	return &res, nil
 }
 
 // EditMessageReplyMarkup
@@ -1079,6 +1812,50 @@ type EditMessageReplyMarkupConfig struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *EditMessageReplyMarkupConfig) Validate() error {
+	return firstError(
+		validateRequired(isZero(t.InlineMessageID), "chat_id", t.ChatID, "required if inline_message_id is not specified"),
+		validateRequired(isZero(t.ChatID) && isZero(t.MessageID), "inline_message_id", t.InlineMessageID, "required if chat_id and message_id are not specified"),
+		validateRequired(isZero(t.InlineMessageID), "message_id", t.MessageID, "required if inline_message_id is not specified"),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *EditMessageReplyMarkupConfig) Method() string {
+	return "editMessageReplyMarkup"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *EditMessageReplyMarkupConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewEditMessageReplyMarkupConfig creates EditMessageReplyMarkupConfig with the required arguments.
+func NewEditMessageReplyMarkupConfig() *EditMessageReplyMarkupConfig {
+	return &EditMessageReplyMarkupConfig{}
+}
+
+func (t *EditMessageReplyMarkupConfig) SetChatID(chatID IntStr) *EditMessageReplyMarkupConfig {
+	t.ChatID = chatID
+	return t
+}
+
+func (t *EditMessageReplyMarkupConfig) SetInlineMessageID(inlineMessageID string) *EditMessageReplyMarkupConfig {
+	t.InlineMessageID = inlineMessageID
+	return t
+}
+
+func (t *EditMessageReplyMarkupConfig) SetMessageID(messageID int64) *EditMessageReplyMarkupConfig {
+	t.MessageID = messageID
+	return t
+}
+
+func (t *EditMessageReplyMarkupConfig) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *EditMessageReplyMarkupConfig {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
 // EditMessageReplyMarkup
 // Use this method to edit only the reply markup of messages. On success, if the edited message is
 // not an inline message, the edited Message is returned, otherwise True is returned.
@@ -1086,13 +1863,11 @@ func (api *API) EditMessageReplyMarkup(
 	ctx context.Context,
 	args *EditMessageReplyMarkupConfig,
 ) (*Message, error) {
-	resp, err := api.MakeRequest(ctx, "editMessageReplyMarkup", args)
-	if err != nil {
+	var res Message
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data Message
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
+	return &res, nil
 }
 
 // EditMessageText
@@ -1121,12 +1896,74 @@ type EditMessageTextConfig struct {
 	MessageID int64 `json:"message_id,omitempty"`
 	// ParseMode
 	// Mode for parsing entities in the message text. See formatting options for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 	// ReplyMarkup
 	// A JSON-serialized object for an inline keyboard.
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *EditMessageTextConfig) Validate() error {
+	return firstError(
+		validateRequired(isZero(t.InlineMessageID), "chat_id", t.ChatID, "required if inline_message_id is not specified"),
+		validateRequired(isZero(t.ChatID) && isZero(t.MessageID), "inline_message_id", t.InlineMessageID, "required if chat_id and message_id are not specified"),
+		validateRequired(isZero(t.InlineMessageID), "message_id", t.MessageID, "required if inline_message_id is not specified"),
+		validateLength(isZero(t.ParseMode), "text", t.Text, 1, 4096),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *EditMessageTextConfig) Method() string {
+	return "editMessageText"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *EditMessageTextConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewEditMessageTextConfig creates EditMessageTextConfig with the required arguments.
+func NewEditMessageTextConfig(text string) *EditMessageTextConfig {
+	return &EditMessageTextConfig{
+		Text: text,
+	}
+}
+
+func (t *EditMessageTextConfig) SetChatID(chatID IntStr) *EditMessageTextConfig {
+	t.ChatID = chatID
+	return t
+}
+
+func (t *EditMessageTextConfig) SetDisableWebPagePreview(disableWebPagePreview bool) *EditMessageTextConfig {
+	t.DisableWebPagePreview = disableWebPagePreview
+	return t
+}
+
+func (t *EditMessageTextConfig) SetEntities(entities []MessageEntity) *EditMessageTextConfig {
+	t.Entities = entities
+	return t
+}
+
+func (t *EditMessageTextConfig) SetInlineMessageID(inlineMessageID string) *EditMessageTextConfig {
+	t.InlineMessageID = inlineMessageID
+	return t
+}
+
+func (t *EditMessageTextConfig) SetMessageID(messageID int64) *EditMessageTextConfig {
+	t.MessageID = messageID
+	return t
+}
+
+func (t *EditMessageTextConfig) SetParseMode(parseMode ParseMode) *EditMessageTextConfig {
+	t.ParseMode = parseMode
+	return t
+}
+
+func (t *EditMessageTextConfig) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *EditMessageTextConfig {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
 // EditMessageText
 // Use this method to edit text and game messages. On success, if the edited message is not an
 // inline message, the edited Message is returned, otherwise True is returned.
@@ -1134,13 +1971,11 @@ func (api *API) EditMessageText(
 	ctx context.Context,
 	args *EditMessageTextConfig,
 ) (*Message, error) {
-	resp, err := api.MakeRequest(ctx, "editMessageText", args)
-	if err != nil {
+	var res Message
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data Message
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
+	return &res, nil
 }
 
 // ExportChatInviteLink
@@ -1193,6 +2028,45 @@ type ForwardMessageConfig struct {
 	ProtectContent bool `json:"protect_content,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *ForwardMessageConfig) Validate() error {
+	return nil
+}
+
+// Method returns the name of the API method.
+func (t *ForwardMessageConfig) Method() string {
+	return "forwardMessage"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *ForwardMessageConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewForwardMessageConfig creates ForwardMessageConfig with the required arguments.
+func NewForwardMessageConfig(chatID IntStr, fromChatID IntStr, messageID int64) *ForwardMessageConfig {
+	return &ForwardMessageConfig{
+		ChatID:     chatID,
+		FromChatID: fromChatID,
+		MessageID:  messageID,
+	}
+}
+
+func (t *ForwardMessageConfig) SetDisableNotification(disableNotification bool) *ForwardMessageConfig {
+	t.DisableNotification = disableNotification
+	return t
+}
+
+func (t *ForwardMessageConfig) SetMessageThreadID(messageThreadID int64) *ForwardMessageConfig {
+	t.MessageThreadID = messageThreadID
+	return t
+}
+
+func (t *ForwardMessageConfig) SetProtectContent(protectContent bool) *ForwardMessageConfig {
+	t.ProtectContent = protectContent
+	return t
+}
+
 // ForwardMessage
 // Use this method to forward messages of any kind. Service messages can't be forwarded. On
 // success, the sent Message is returned.
@@ -1200,13 +2074,11 @@ func (api *API) ForwardMessage(
 	ctx context.Context,
 	args *ForwardMessageConfig,
 ) (*Message, error) {
-	resp, err := api.MakeRequest(ctx, "forwardMessage", args)
-	if err != nil {
+	var res Message
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data Message
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
+	return &res, nil
 }
 
 // GetChat
@@ -1403,6 +2275,47 @@ type GetGameHighScoresConfig struct {
 	MessageID int64 `json:"message_id,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *GetGameHighScoresConfig) Validate() error {
+	return firstError(
+		validateRequired(isZero(t.InlineMessageID), "chat_id", t.ChatID, "required if inline_message_id is not specified"),
+		validateRequired(isZero(t.ChatID) && isZero(t.MessageID), "inline_message_id", t.InlineMessageID, "required if chat_id and message_id are not specified"),
+		validateRequired(isZero(t.InlineMessageID), "message_id", t.MessageID, "required if inline_message_id is not specified"),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *GetGameHighScoresConfig) Method() string {
+	return "getGameHighScores"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *GetGameHighScoresConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewGetGameHighScoresConfig creates GetGameHighScoresConfig with the required arguments.
+func NewGetGameHighScoresConfig(userID int64) *GetGameHighScoresConfig {
+	return &GetGameHighScoresConfig{
+		UserID: userID,
+	}
+}
+
+func (t *GetGameHighScoresConfig) SetChatID(chatID int64) *GetGameHighScoresConfig {
+	t.ChatID = chatID
+	return t
+}
+
+func (t *GetGameHighScoresConfig) SetInlineMessageID(inlineMessageID string) *GetGameHighScoresConfig {
+	t.InlineMessageID = inlineMessageID
+	return t
+}
+
+func (t *GetGameHighScoresConfig) SetMessageID(messageID int64) *GetGameHighScoresConfig {
+	t.MessageID = messageID
+	return t
+}
+
 // GetGameHighScores
 // Use this method to get data for high score tables. Will return the score of the specified user
 // and several of their neighbors in a game. Returns an Array of GameHighScore objects.
@@ -1410,13 +2323,11 @@ func (api *API) GetGameHighScores(
 	ctx context.Context,
 	args *GetGameHighScoresConfig,
 ) ([]GameHighScore, error) {
-	resp, err := api.MakeRequest(ctx, "getGameHighScores", args)
-	if err != nil {
+	var res []GameHighScore
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data []GameHighScore
-	err = json.Unmarshal(resp.Result, &data)
-	return data, err
+	return res, nil
 }
 
 // GetMe
@@ -1566,7 +2477,7 @@ type GetUpdatesConfig struct {
 	// to receive all update types except chat_member (default). If not specified, the previous
 	// setting will be used.Please note that this parameter doesn't affect updates created before
 	// the call to the getUpdates, so unwanted updates may be received for a short period of time.
-	AllowedUpdates []string `json:"allowed_updates,omitempty"`
+	AllowedUpdates []UpdateKind `json:"allowed_updates,omitempty"`
 	// Limit
 	// Limits the number of updates to be retrieved. Values between 1-100 are accepted. Defaults to
 	// 100.
@@ -1585,6 +2496,48 @@ type GetUpdatesConfig struct {
 	Timeout int64 `json:"timeout,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *GetUpdatesConfig) Validate() error {
+	return firstError(
+		validateRange(!isZero(t.Limit), "limit", t.Limit, 1, 100),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *GetUpdatesConfig) Method() string {
+	return "getUpdates"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *GetUpdatesConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewGetUpdatesConfig creates GetUpdatesConfig with the required arguments.
+func NewGetUpdatesConfig() *GetUpdatesConfig {
+	return &GetUpdatesConfig{}
+}
+
+func (t *GetUpdatesConfig) SetAllowedUpdates(allowedUpdates []UpdateKind) *GetUpdatesConfig {
+	t.AllowedUpdates = allowedUpdates
+	return t
+}
+
+func (t *GetUpdatesConfig) SetLimit(limit int64) *GetUpdatesConfig {
+	t.Limit = limit
+	return t
+}
+
+func (t *GetUpdatesConfig) SetOffset(offset int64) *GetUpdatesConfig {
+	t.Offset = offset
+	return t
+}
+
+func (t *GetUpdatesConfig) SetTimeout(timeout int64) *GetUpdatesConfig {
+	t.Timeout = timeout
+	return t
+}
+
 // GetUpdates
 // Use this method to receive incoming updates using long polling (wiki). Returns an Array of
 // Update objects.
@@ -1592,13 +2545,11 @@ func (api *API) GetUpdates(
 	ctx context.Context,
 	args *GetUpdatesConfig,
 ) ([]Update, error) {
-	resp, err := api.MakeRequest(ctx, "getUpdates", args)
-	if err != nil {
+	var res []Update
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data []Update
-	err = json.Unmarshal(resp.Result, &data)
-	return data, err
+	return res, nil
 }
 
 // GetUserProfilePhotos
@@ -1617,6 +2568,40 @@ type GetUserProfilePhotosConfig struct {
 	Offset int64 `json:"offset,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *GetUserProfilePhotosConfig) Validate() error {
+	return firstError(
+		validateRange(!isZero(t.Limit), "limit", t.Limit, 1, 100),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *GetUserProfilePhotosConfig) Method() string {
+	return "getUserProfilePhotos"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *GetUserProfilePhotosConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewGetUserProfilePhotosConfig creates GetUserProfilePhotosConfig with the required arguments.
+func NewGetUserProfilePhotosConfig(userID int64) *GetUserProfilePhotosConfig {
+	return &GetUserProfilePhotosConfig{
+		UserID: userID,
+	}
+}
+
+func (t *GetUserProfilePhotosConfig) SetLimit(limit int64) *GetUserProfilePhotosConfig {
+	t.Limit = limit
+	return t
+}
+
+func (t *GetUserProfilePhotosConfig) SetOffset(offset int64) *GetUserProfilePhotosConfig {
+	t.Offset = offset
+	return t
+}
+
 // GetUserProfilePhotos
 // Use this method to get a list of profile pictures for a user. Returns a UserProfilePhotos
 // object.
@@ -1624,13 +2609,11 @@ func (api *API) GetUserProfilePhotos(
 	ctx context.Context,
 	args *GetUserProfilePhotosConfig,
 ) (*UserProfilePhotos, error) {
-	resp, err := api.MakeRequest(ctx, "getUserProfilePhotos", args)
-	if err != nil {
+	var res UserProfilePhotos
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data UserProfilePhotos
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
+	return &res, nil
 }
 
 // GetWebhookInfo
@@ -1715,6 +2698,34 @@ type PinChatMessageConfig struct {
 	DisableNotification bool `json:"disable_notification,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *PinChatMessageConfig) Validate() error {
+	return nil
+}
+
+// Method returns the name of the API method.
+func (t *PinChatMessageConfig) Method() string {
+	return "pinChatMessage"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *PinChatMessageConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewPinChatMessageConfig creates PinChatMessageConfig with the required arguments.
+func NewPinChatMessageConfig(chatID IntStr, messageID int64) *PinChatMessageConfig {
+	return &PinChatMessageConfig{
+		ChatID:    chatID,
+		MessageID: messageID,
+	}
+}
+
+func (t *PinChatMessageConfig) SetDisableNotification(disableNotification bool) *PinChatMessageConfig {
+	t.DisableNotification = disableNotification
+	return t
+}
+
 // PinChatMessage
 // Use this method to add a message to the list of pinned messages in a chat. If the chat is not a
 // private chat, the bot must be an administrator in the chat for this to work and must have the
@@ -1724,8 +2735,7 @@ func (api *API) PinChatMessage(
 	ctx context.Context,
 	args *PinChatMessageConfig,
 ) error {
-	_, err := api.MakeRequest(ctx, "pinChatMessage", args)
-	return err
+	return api.Do(ctx, args, nil)
 }
 
 // PromoteChatMember
@@ -1784,6 +2794,89 @@ type PromoteChatMemberConfig struct {
 	IsAnonymous bool `json:"is_anonymous,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *PromoteChatMemberConfig) Validate() error {
+	return nil
+}
+
+// Method returns the name of the API method.
+func (t *PromoteChatMemberConfig) Method() string {
+	return "promoteChatMember"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *PromoteChatMemberConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewPromoteChatMemberConfig creates PromoteChatMemberConfig with the required arguments.
+func NewPromoteChatMemberConfig(chatID IntStr, userID int64) *PromoteChatMemberConfig {
+	return &PromoteChatMemberConfig{
+		ChatID: chatID,
+		UserID: userID,
+	}
+}
+
+func (t *PromoteChatMemberConfig) SetCanChangeInfo(canChangeInfo bool) *PromoteChatMemberConfig {
+	t.CanChangeInfo = canChangeInfo
+	return t
+}
+
+func (t *PromoteChatMemberConfig) SetCanDeleteMessages(canDeleteMessages bool) *PromoteChatMemberConfig {
+	t.CanDeleteMessages = canDeleteMessages
+	return t
+}
+
+func (t *PromoteChatMemberConfig) SetCanEditMessages(canEditMessages bool) *PromoteChatMemberConfig {
+	t.CanEditMessages = canEditMessages
+	return t
+}
+
+func (t *PromoteChatMemberConfig) SetCanInviteUsers(canInviteUsers bool) *PromoteChatMemberConfig {
+	t.CanInviteUsers = canInviteUsers
+	return t
+}
+
+func (t *PromoteChatMemberConfig) SetCanManageChat(canManageChat bool) *PromoteChatMemberConfig {
+	t.CanManageChat = canManageChat
+	return t
+}
+
+func (t *PromoteChatMemberConfig) SetCanManageTopics(canManageTopics bool) *PromoteChatMemberConfig {
+	t.CanManageTopics = canManageTopics
+	return t
+}
+
+func (t *PromoteChatMemberConfig) SetCanManageVideoChats(canManageVideoChats bool) *PromoteChatMemberConfig {
+	t.CanManageVideoChats = canManageVideoChats
+	return t
+}
+
+func (t *PromoteChatMemberConfig) SetCanPinMessages(canPinMessages bool) *PromoteChatMemberConfig {
+	t.CanPinMessages = canPinMessages
+	return t
+}
+
+func (t *PromoteChatMemberConfig) SetCanPostMessages(canPostMessages bool) *PromoteChatMemberConfig {
+	t.CanPostMessages = canPostMessages
+	return t
+}
+
+func (t *PromoteChatMemberConfig) SetCanPromoteMembers(canPromoteMembers bool) *PromoteChatMemberConfig {
+	t.CanPromoteMembers = canPromoteMembers
+	return t
+}
+
+func (t *PromoteChatMemberConfig) SetCanRestrictMembers(canRestrictMembers bool) *PromoteChatMemberConfig {
+	t.CanRestrictMembers = canRestrictMembers
+	return t
+}
+
+func (t *PromoteChatMemberConfig) SetIsAnonymous(isAnonymous bool) *PromoteChatMemberConfig {
+	t.IsAnonymous = isAnonymous
+	return t
+}
+
 // PromoteChatMember
 // Use this method to promote or demote a user in a supergroup or a channel. The bot must be an
 // administrator in the chat for this to work and must have the appropriate administrator rights.
@@ -1792,8 +2885,7 @@ func (api *API) PromoteChatMember(
 	ctx context.Context,
 	args *PromoteChatMemberConfig,
 ) error {
-	_, err := api.MakeRequest(ctx, "promoteChatMember", args)
-	return err
+	return api.Do(ctx, args, nil)
 }
 
 // ReopenForumTopic
@@ -1865,6 +2957,40 @@ type RestrictChatMemberConfig struct {
 	UseIndependentChatPermissions bool `json:"use_independent_chat_permissions,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *RestrictChatMemberConfig) Validate() error {
+	return nil
+}
+
+// Method returns the name of the API method.
+func (t *RestrictChatMemberConfig) Method() string {
+	return "restrictChatMember"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *RestrictChatMemberConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewRestrictChatMemberConfig creates RestrictChatMemberConfig with the required arguments.
+func NewRestrictChatMemberConfig(chatID IntStr, permissions ChatPermissions, userID int64) *RestrictChatMemberConfig {
+	return &RestrictChatMemberConfig{
+		ChatID:      chatID,
+		Permissions: permissions,
+		UserID:      userID,
+	}
+}
+
+func (t *RestrictChatMemberConfig) SetUntilDate(untilDate int64) *RestrictChatMemberConfig {
+	t.UntilDate = untilDate
+	return t
+}
+
+func (t *RestrictChatMemberConfig) SetUseIndependentChatPermissions(useIndependentChatPermissions bool) *RestrictChatMemberConfig {
+	t.UseIndependentChatPermissions = useIndependentChatPermissions
+	return t
+}
+
 // RestrictChatMember
 // Use this method to restrict a user in a supergroup. The bot must be an administrator in the
 // supergroup for this to work and must have the appropriate administrator rights. Pass True for
@@ -1873,8 +2999,7 @@ func (api *API) RestrictChatMember(
 	ctx context.Context,
 	args *RestrictChatMemberConfig,
 ) error {
-	_, err := api.MakeRequest(ctx, "restrictChatMember", args)
-	return err
+	return api.Do(ctx, args, nil)
 }
 
 // RevokeChatInviteLink
@@ -1950,7 +3075,7 @@ type SendAnimationConfig struct {
 	MessageThreadID int64 `json:"message_thread_id,omitempty"`
 	// ParseMode
 	// Mode for parsing entities in the animation caption. See formatting options for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 	// ProtectContent
 	// Protects the contents of the sent message from forwarding and saving
 	ProtectContent bool `json:"protect_content,omitempty"`
@@ -1974,7 +3099,107 @@ type SendAnimationConfig struct {
 	Width int64 `json:"width,omitempty"`
 }
 
-func (t SendAnimationConfig) EncodeURL() (url.Values, error) {
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *SendAnimationConfig) Validate() error {
+	return firstError(
+		validateLength(!isZero(t.Caption) && isZero(t.ParseMode), "caption", t.Caption, 0, 1024),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *SendAnimationConfig) Method() string {
+	return "sendAnimation"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *SendAnimationConfig) Files() map[string]*InputFile {
+	files := make(map[string]*InputFile)
+	files["animation"] = &t.Animation
+	if t.Thumbnail != nil {
+		files["thumbnail"] = t.Thumbnail
+	}
+	return files
+}
+
+// NewSendAnimationConfig creates SendAnimationConfig with the required arguments.
+func NewSendAnimationConfig(animation InputFile, chatID IntStr) *SendAnimationConfig {
+	return &SendAnimationConfig{
+		Animation: animation,
+		ChatID:    chatID,
+	}
+}
+
+func (t *SendAnimationConfig) SetAllowSendingWithoutReply(allowSendingWithoutReply bool) *SendAnimationConfig {
+	t.AllowSendingWithoutReply = allowSendingWithoutReply
+	return t
+}
+
+func (t *SendAnimationConfig) SetCaption(caption string) *SendAnimationConfig {
+	t.Caption = caption
+	return t
+}
+
+func (t *SendAnimationConfig) SetCaptionEntities(captionEntities []MessageEntity) *SendAnimationConfig {
+	t.CaptionEntities = captionEntities
+	return t
+}
+
+func (t *SendAnimationConfig) SetDisableNotification(disableNotification bool) *SendAnimationConfig {
+	t.DisableNotification = disableNotification
+	return t
+}
+
+func (t *SendAnimationConfig) SetDuration(duration int64) *SendAnimationConfig {
+	t.Duration = duration
+	return t
+}
+
+func (t *SendAnimationConfig) SetHasSpoiler(hasSpoiler bool) *SendAnimationConfig {
+	t.HasSpoiler = hasSpoiler
+	return t
+}
+
+func (t *SendAnimationConfig) SetHeight(height int64) *SendAnimationConfig {
+	t.Height = height
+	return t
+}
+
+func (t *SendAnimationConfig) SetMessageThreadID(messageThreadID int64) *SendAnimationConfig {
+	t.MessageThreadID = messageThreadID
+	return t
+}
+
+func (t *SendAnimationConfig) SetParseMode(parseMode ParseMode) *SendAnimationConfig {
+	t.ParseMode = parseMode
+	return t
+}
+
+func (t *SendAnimationConfig) SetProtectContent(protectContent bool) *SendAnimationConfig {
+	t.ProtectContent = protectContent
+	return t
+}
+
+func (t *SendAnimationConfig) SetReplyMarkup(replyMarkup ReplyMarkup) *SendAnimationConfig {
+	t.ReplyMarkup = replyMarkup
+	return t
+}
+
+func (t *SendAnimationConfig) SetReplyToMessageID(replyToMessageID int64) *SendAnimationConfig {
+	t.ReplyToMessageID = replyToMessageID
+	return t
+}
+
+func (t *SendAnimationConfig) SetThumbnail(thumbnail InputFile) *SendAnimationConfig {
+	t.Thumbnail = &thumbnail
+	return t
+}
+
+func (t *SendAnimationConfig) SetWidth(width int64) *SendAnimationConfig {
+	t.Width = width
+	return t
+}
+
+func (t SendAnimationConfig) EncodeURL() (url.Values, error) {
 	res := make(url.Values)
 	res.Add("allow_sending_without_reply", strconv.FormatBool(t.AllowSendingWithoutReply))
 	res.Add("caption", t.Caption)
@@ -1991,7 +3216,9 @@ func (t SendAnimationConfig) EncodeURL() (url.Values, error) {
 	res.Add("has_spoiler", strconv.FormatBool(t.HasSpoiler))
 	res.Add("height", strconv.FormatInt(t.Height, 10))
 	res.Add("message_thread_id", strconv.FormatInt(t.MessageThreadID, 10))
-	res.Add("parse_mode", t.ParseMode)
+	if t.ParseMode != "" {
+		res.Add("parse_mode", t.ParseMode.String())
+	}
 	res.Add("protect_content", strconv.FormatBool(t.ProtectContent))
 	if t.ReplyMarkup != nil {
 		raw, err := json.Marshal(t.ReplyMarkup)
@@ -2013,27 +3240,11 @@ func (api *API) SendAnimation(
 	ctx context.Context,
 	args *SendAnimationConfig,
 ) (*Message, error) {
-	if args.Animation.Reader != nil {
-		values, err := args.EncodeURL()
-		if err != nil {
-			return nil, err
-		}
-		resp, err := api.UploadFile(ctx, values, "sendAnimation", "animation", &args.Animation)
-		if err != nil {
-			return nil, err
-		}
-
-		var res Message
-		err = json.Unmarshal(resp.Result, &res)
-		return &res, err
-	}
-	resp, err := api.MakeRequest(ctx, "sendAnimation", args)
-	if err != nil {
+	var res Message
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data Message
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
+	return &res, nil
 }
 
 // SendAudio
@@ -2075,7 +3286,7 @@ type SendAudioConfig struct {
 	MessageThreadID int64 `json:"message_thread_id,omitempty"`
 	// ParseMode
 	// Mode for parsing entities in the audio caption. See formatting options for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 	// Performer
 	// Performer
 	Performer string `json:"performer,omitempty"`
@@ -2102,6 +3313,101 @@ type SendAudioConfig struct {
 	Title string `json:"title,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *SendAudioConfig) Validate() error {
+	return firstError(
+		validateLength(!isZero(t.Caption) && isZero(t.ParseMode), "caption", t.Caption, 0, 1024),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *SendAudioConfig) Method() string {
+	return "sendAudio"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *SendAudioConfig) Files() map[string]*InputFile {
+	files := make(map[string]*InputFile)
+	files["audio"] = &t.Audio
+	if t.Thumbnail != nil {
+		files["thumbnail"] = t.Thumbnail
+	}
+	return files
+}
+
+// NewSendAudioConfig creates SendAudioConfig with the required arguments.
+func NewSendAudioConfig(audio InputFile, chatID IntStr) *SendAudioConfig {
+	return &SendAudioConfig{
+		Audio:  audio,
+		ChatID: chatID,
+	}
+}
+
+func (t *SendAudioConfig) SetAllowSendingWithoutReply(allowSendingWithoutReply bool) *SendAudioConfig {
+	t.AllowSendingWithoutReply = allowSendingWithoutReply
+	return t
+}
+
+func (t *SendAudioConfig) SetCaption(caption string) *SendAudioConfig {
+	t.Caption = caption
+	return t
+}
+
+func (t *SendAudioConfig) SetCaptionEntities(captionEntities []MessageEntity) *SendAudioConfig {
+	t.CaptionEntities = captionEntities
+	return t
+}
+
+func (t *SendAudioConfig) SetDisableNotification(disableNotification bool) *SendAudioConfig {
+	t.DisableNotification = disableNotification
+	return t
+}
+
+func (t *SendAudioConfig) SetDuration(duration int64) *SendAudioConfig {
+	t.Duration = duration
+	return t
+}
+
+func (t *SendAudioConfig) SetMessageThreadID(messageThreadID int64) *SendAudioConfig {
+	t.MessageThreadID = messageThreadID
+	return t
+}
+
+func (t *SendAudioConfig) SetParseMode(parseMode ParseMode) *SendAudioConfig {
+	t.ParseMode = parseMode
+	return t
+}
+
+func (t *SendAudioConfig) SetPerformer(performer string) *SendAudioConfig {
+	t.Performer = performer
+	return t
+}
+
+func (t *SendAudioConfig) SetProtectContent(protectContent bool) *SendAudioConfig {
+	t.ProtectContent = protectContent
+	return t
+}
+
+func (t *SendAudioConfig) SetReplyMarkup(replyMarkup ReplyMarkup) *SendAudioConfig {
+	t.ReplyMarkup = replyMarkup
+	return t
+}
+
+func (t *SendAudioConfig) SetReplyToMessageID(replyToMessageID int64) *SendAudioConfig {
+	t.ReplyToMessageID = replyToMessageID
+	return t
+}
+
+func (t *SendAudioConfig) SetThumbnail(thumbnail InputFile) *SendAudioConfig {
+	t.Thumbnail = &thumbnail
+	return t
+}
+
+func (t *SendAudioConfig) SetTitle(title string) *SendAudioConfig {
+	t.Title = title
+	return t
+}
+
 func (t SendAudioConfig) EncodeURL() (url.Values, error) {
 	res := make(url.Values)
 	res.Add("allow_sending_without_reply", strconv.FormatBool(t.AllowSendingWithoutReply))
@@ -2117,7 +3423,9 @@ func (t SendAudioConfig) EncodeURL() (url.Values, error) {
 	res.Add("disable_notification", strconv.FormatBool(t.DisableNotification))
 	res.Add("duration", strconv.FormatInt(t.Duration, 10))
 	res.Add("message_thread_id", strconv.FormatInt(t.MessageThreadID, 10))
-	res.Add("parse_mode", t.ParseMode)
+	if t.ParseMode != "" {
+		res.Add("parse_mode", t.ParseMode.String())
+	}
 	res.Add("performer", t.Performer)
 	res.Add("protect_content", strconv.FormatBool(t.ProtectContent))
 	if t.ReplyMarkup != nil {
@@ -2141,27 +3449,11 @@ func (api *API) SendAudio(
 	ctx context.Context,
 	args *SendAudioConfig,
 ) (*Message, error) {
-	if args.Audio.Reader != nil {
-		values, err := args.EncodeURL()
-		if err != nil {
-			return nil, err
-		}
-		resp, err := api.UploadFile(ctx, values, "sendAudio", "audio", &args.Audio)
-		if err != nil {
-			return nil, err
-		}
-
-		var res Message
-		err = json.Unmarshal(resp.Result, &res)
-		return &res, err
-	}
-	resp, err := api.MakeRequest(ctx, "sendAudio", args)
-	if err != nil {
+	var res Message
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data Message
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
+	return &res, nil
 }
 
 // SendChatAction
@@ -2175,7 +3467,7 @@ type SendChatActionConfig struct {
 	// record_voice or upload_voice for voice notes, upload_document for general files,
 	// choose_sticker for stickers, find_location for location data, record_video_note or
 	// upload_video_note for video notes.
-	Action string `json:"action"`
+	Action ChatAction `json:"action"`
 	// ChatID
 	// Unique identifier for the target chat or username of the target channel (in the format
 	// @channelusername)
@@ -2185,6 +3477,34 @@ type SendChatActionConfig struct {
 	MessageThreadID int64 `json:"message_thread_id,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *SendChatActionConfig) Validate() error {
+	return nil
+}
+
+// Method returns the name of the API method.
+func (t *SendChatActionConfig) Method() string {
+	return "sendChatAction"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *SendChatActionConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewSendChatActionConfig creates SendChatActionConfig with the required arguments.
+func NewSendChatActionConfig(action ChatAction, chatID IntStr) *SendChatActionConfig {
+	return &SendChatActionConfig{
+		Action: action,
+		ChatID: chatID,
+	}
+}
+
+func (t *SendChatActionConfig) SetMessageThreadID(messageThreadID int64) *SendChatActionConfig {
+	t.MessageThreadID = messageThreadID
+	return t
+}
+
 // SendChatAction
 // Use this method when you need to tell the user that something is happening on the bot's side.
 // The status is set for 5 seconds or less (when a message arrives from your bot, Telegram clients
@@ -2193,8 +3513,7 @@ func (api *API) SendChatAction(
 	ctx context.Context,
 	args *SendChatActionConfig,
 ) error {
-	_, err := api.MakeRequest(ctx, "sendChatAction", args)
-	return err
+	return api.Do(ctx, args, nil)
 }
 
 // SendContact
@@ -2239,19 +3558,83 @@ type SendContactConfig struct {
 	Vcard string `json:"vcard,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *SendContactConfig) Validate() error {
+	return firstError(
+		validateBytes(!isZero(t.Vcard), "vcard", t.Vcard, 0, 2048),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *SendContactConfig) Method() string {
+	return "sendContact"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *SendContactConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewSendContactConfig creates SendContactConfig with the required arguments.
+func NewSendContactConfig(chatID IntStr, firstName string, phoneNumber string) *SendContactConfig {
+	return &SendContactConfig{
+		ChatID:      chatID,
+		FirstName:   firstName,
+		PhoneNumber: phoneNumber,
+	}
+}
+
+func (t *SendContactConfig) SetAllowSendingWithoutReply(allowSendingWithoutReply bool) *SendContactConfig {
+	t.AllowSendingWithoutReply = allowSendingWithoutReply
+	return t
+}
+
+func (t *SendContactConfig) SetDisableNotification(disableNotification bool) *SendContactConfig {
+	t.DisableNotification = disableNotification
+	return t
+}
+
+func (t *SendContactConfig) SetLastName(lastName string) *SendContactConfig {
+	t.LastName = lastName
+	return t
+}
+
+func (t *SendContactConfig) SetMessageThreadID(messageThreadID int64) *SendContactConfig {
+	t.MessageThreadID = messageThreadID
+	return t
+}
+
+func (t *SendContactConfig) SetProtectContent(protectContent bool) *SendContactConfig {
+	t.ProtectContent = protectContent
+	return t
+}
+
+func (t *SendContactConfig) SetReplyMarkup(replyMarkup ReplyMarkup) *SendContactConfig {
+	t.ReplyMarkup = replyMarkup
+	return t
+}
+
+func (t *SendContactConfig) SetReplyToMessageID(replyToMessageID int64) *SendContactConfig {
+	t.ReplyToMessageID = replyToMessageID
+	return t
+}
+
+func (t *SendContactConfig) SetVcard(vcard string) *SendContactConfig {
+	t.Vcard = vcard
+	return t
+}
+
 // SendContact
 // Use this method to send phone contacts. On success, the sent Message is returned.
 func (api *API) SendContact(
 	ctx context.Context,
 	args *SendContactConfig,
 ) (*Message, error) {
-	resp, err := api.MakeRequest(ctx, "sendContact", args)
-	if err != nil {
+	var res Message
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data Message
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
+	return &res, nil
 }
 
 // SendDice
@@ -2273,7 +3656,7 @@ type SendDiceConfig struct {
 	// Emoji on which the dice throw animation is based. Currently, must be one of "", "", "", "",
 	// "", or "". Dice can have values 1-6 for "", "" and "", values 1-5 for "" and "", and values
 	// 1-64 for "". Defaults to ""
-	Emoji string `json:"emoji,omitempty"`
+	Emoji DiceEmoji `json:"emoji,omitempty"`
 	// MessageThreadID
 	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups
 	// only
@@ -2290,6 +3673,63 @@ type SendDiceConfig struct {
 	ReplyToMessageID int64 `json:"reply_to_message_id,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *SendDiceConfig) Validate() error {
+	return nil
+}
+
+// Method returns the name of the API method.
+func (t *SendDiceConfig) Method() string {
+	return "sendDice"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *SendDiceConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewSendDiceConfig creates SendDiceConfig with the required arguments.
+func NewSendDiceConfig(chatID IntStr) *SendDiceConfig {
+	return &SendDiceConfig{
+		ChatID: chatID,
+	}
+}
+
+func (t *SendDiceConfig) SetAllowSendingWithoutReply(allowSendingWithoutReply bool) *SendDiceConfig {
+	t.AllowSendingWithoutReply = allowSendingWithoutReply
+	return t
+}
+
+func (t *SendDiceConfig) SetDisableNotification(disableNotification bool) *SendDiceConfig {
+	t.DisableNotification = disableNotification
+	return t
+}
+
+func (t *SendDiceConfig) SetEmoji(emoji DiceEmoji) *SendDiceConfig {
+	t.Emoji = emoji
+	return t
+}
+
+func (t *SendDiceConfig) SetMessageThreadID(messageThreadID int64) *SendDiceConfig {
+	t.MessageThreadID = messageThreadID
+	return t
+}
+
+func (t *SendDiceConfig) SetProtectContent(protectContent bool) *SendDiceConfig {
+	t.ProtectContent = protectContent
+	return t
+}
+
+func (t *SendDiceConfig) SetReplyMarkup(replyMarkup ReplyMarkup) *SendDiceConfig {
+	t.ReplyMarkup = replyMarkup
+	return t
+}
+
+func (t *SendDiceConfig) SetReplyToMessageID(replyToMessageID int64) *SendDiceConfig {
+	t.ReplyToMessageID = replyToMessageID
+	return t
+}
+
 // SendDice
 // Use this method to send an animated emoji that will display a random value. On success, the sent
 // Message is returned.
@@ -2297,13 +3737,11 @@ func (api *API) SendDice(
 	ctx context.Context,
 	args *SendDiceConfig,
 ) (*Message, error) {
-	resp, err := api.MakeRequest(ctx, "sendDice", args)
-	if err != nil {
+	var res Message
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data Message
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
+	return &res, nil
 }
 
 // SendDocument
@@ -2345,7 +3783,7 @@ type SendDocumentConfig struct {
 	MessageThreadID int64 `json:"message_thread_id,omitempty"`
 	// ParseMode
 	// Mode for parsing entities in the document caption. See formatting options for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 	// ProtectContent
 	// Protects the contents of the sent message from forwarding and saving
 	ProtectContent bool `json:"protect_content,omitempty"`
@@ -2366,6 +3804,91 @@ type SendDocumentConfig struct {
 	Thumbnail *InputFile `json:"thumbnail,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *SendDocumentConfig) Validate() error {
+	return firstError(
+		validateLength(!isZero(t.Caption) && isZero(t.ParseMode), "caption", t.Caption, 0, 1024),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *SendDocumentConfig) Method() string {
+	return "sendDocument"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *SendDocumentConfig) Files() map[string]*InputFile {
+	files := make(map[string]*InputFile)
+	files["document"] = &t.Document
+	if t.Thumbnail != nil {
+		files["thumbnail"] = t.Thumbnail
+	}
+	return files
+}
+
+// NewSendDocumentConfig creates SendDocumentConfig with the required arguments.
+func NewSendDocumentConfig(chatID IntStr, document InputFile) *SendDocumentConfig {
+	return &SendDocumentConfig{
+		ChatID:   chatID,
+		Document: document,
+	}
+}
+
+func (t *SendDocumentConfig) SetAllowSendingWithoutReply(allowSendingWithoutReply bool) *SendDocumentConfig {
+	t.AllowSendingWithoutReply = allowSendingWithoutReply
+	return t
+}
+
+func (t *SendDocumentConfig) SetCaption(caption string) *SendDocumentConfig {
+	t.Caption = caption
+	return t
+}
+
+func (t *SendDocumentConfig) SetCaptionEntities(captionEntities []MessageEntity) *SendDocumentConfig {
+	t.CaptionEntities = captionEntities
+	return t
+}
+
+func (t *SendDocumentConfig) SetDisableContentTypeDetection(disableContentTypeDetection bool) *SendDocumentConfig {
+	t.DisableContentTypeDetection = disableContentTypeDetection
+	return t
+}
+
+func (t *SendDocumentConfig) SetDisableNotification(disableNotification bool) *SendDocumentConfig {
+	t.DisableNotification = disableNotification
+	return t
+}
+
+func (t *SendDocumentConfig) SetMessageThreadID(messageThreadID int64) *SendDocumentConfig {
+	t.MessageThreadID = messageThreadID
+	return t
+}
+
+func (t *SendDocumentConfig) SetParseMode(parseMode ParseMode) *SendDocumentConfig {
+	t.ParseMode = parseMode
+	return t
+}
+
+func (t *SendDocumentConfig) SetProtectContent(protectContent bool) *SendDocumentConfig {
+	t.ProtectContent = protectContent
+	return t
+}
+
+func (t *SendDocumentConfig) SetReplyMarkup(replyMarkup ReplyMarkup) *SendDocumentConfig {
+	t.ReplyMarkup = replyMarkup
+	return t
+}
+
+func (t *SendDocumentConfig) SetReplyToMessageID(replyToMessageID int64) *SendDocumentConfig {
+	t.ReplyToMessageID = replyToMessageID
+	return t
+}
+
+func (t *SendDocumentConfig) SetThumbnail(thumbnail InputFile) *SendDocumentConfig {
+	t.Thumbnail = &thumbnail
+	return t
+}
+
 func (t SendDocumentConfig) EncodeURL() (url.Values, error) {
 	res := make(url.Values)
 	res.Add("allow_sending_without_reply", strconv.FormatBool(t.AllowSendingWithoutReply))
@@ -2381,7 +3904,9 @@ func (t SendDocumentConfig) EncodeURL() (url.Values, error) {
 	res.Add("disable_content_type_detection", strconv.FormatBool(t.DisableContentTypeDetection))
 	res.Add("disable_notification", strconv.FormatBool(t.DisableNotification))
 	res.Add("message_thread_id", strconv.FormatInt(t.MessageThreadID, 10))
-	res.Add("parse_mode", t.ParseMode)
+	if t.ParseMode != "" {
+		res.Add("parse_mode", t.ParseMode.String())
+	}
 	res.Add("protect_content", strconv.FormatBool(t.ProtectContent))
 	if t.ReplyMarkup != nil {
 		raw, err := json.Marshal(t.ReplyMarkup)
@@ -2402,27 +3927,11 @@ func (api *API) SendDocument(
 	ctx context.Context,
 	args *SendDocumentConfig,
 ) (*Message, error) {
-	if args.Document.Reader != nil {
-		values, err := args.EncodeURL()
-		if err != nil {
-			return nil, err
-		}
-		resp, err := api.UploadFile(ctx, values, "sendDocument", "document", &args.Document)
-		if err != nil {
-			return nil, err
-		}
-
-		var res Message
-		err = json.Unmarshal(resp.Result, &res)
-		return &res, err
-	}
-	resp, err := api.MakeRequest(ctx, "sendDocument", args)
-	if err != nil {
+	var res Message
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data Message
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
+	return &res, nil
 }
 
 // SendGame
@@ -2458,19 +3967,70 @@ type SendGameConfig struct {
 	ReplyToMessageID int64 `json:"reply_to_message_id,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *SendGameConfig) Validate() error {
+	return nil
+}
+
+// Method returns the name of the API method.
+func (t *SendGameConfig) Method() string {
+	return "sendGame"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *SendGameConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewSendGameConfig creates SendGameConfig with the required arguments.
+func NewSendGameConfig(chatID int64, gameShortName string) *SendGameConfig {
+	return &SendGameConfig{
+		ChatID:        chatID,
+		GameShortName: gameShortName,
+	}
+}
+
+func (t *SendGameConfig) SetAllowSendingWithoutReply(allowSendingWithoutReply bool) *SendGameConfig {
+	t.AllowSendingWithoutReply = allowSendingWithoutReply
+	return t
+}
+
+func (t *SendGameConfig) SetDisableNotification(disableNotification bool) *SendGameConfig {
+	t.DisableNotification = disableNotification
+	return t
+}
+
+func (t *SendGameConfig) SetMessageThreadID(messageThreadID int64) *SendGameConfig {
+	t.MessageThreadID = messageThreadID
+	return t
+}
+
+func (t *SendGameConfig) SetProtectContent(protectContent bool) *SendGameConfig {
+	t.ProtectContent = protectContent
+	return t
+}
+
+func (t *SendGameConfig) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *SendGameConfig {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
+func (t *SendGameConfig) SetReplyToMessageID(replyToMessageID int64) *SendGameConfig {
+	t.ReplyToMessageID = replyToMessageID
+	return t
+}
+
 // SendGame
 // Use this method to send a game. On success, the sent Message is returned.
 func (api *API) SendGame(
 	ctx context.Context,
 	args *SendGameConfig,
 ) (*Message, error) {
-	resp, err := api.MakeRequest(ctx, "sendGame", args)
-	if err != nil {
+	var res Message
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data Message
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
+	return &res, nil
 }
 
 // SendInvoice
@@ -2579,27 +4139,163 @@ type SendInvoiceConfig struct {
 	SuggestedTipAmounts []int64 `json:"suggested_tip_amounts,omitempty"`
 }
 
-// SendInvoice
-// Use this method to send invoices. On success, the sent Message is returned.
-func (api *API) SendInvoice(
-	ctx context.Context,
-	args *SendInvoiceConfig,
-) (*Message, error) {
-	resp, err := api.MakeRequest(ctx, "sendInvoice", args)
-	if err != nil {
-		return nil, err
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *SendInvoiceConfig) Validate() error {
+	return firstError(
+		validateLength(true, "description", t.Description, 1, 255),
+		validateBytes(true, "payload", t.Payload, 1, 128),
+		validateCount(!isZero(t.SuggestedTipAmounts), "suggested_tip_amounts", t.SuggestedTipAmounts, 0, 4),
+		validateLength(true, "title", t.Title, 1, 32),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *SendInvoiceConfig) Method() string {
+	return "sendInvoice"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *SendInvoiceConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewSendInvoiceConfig creates SendInvoiceConfig with the required arguments.
+func NewSendInvoiceConfig(chatID IntStr, currency string, description string, payload string, prices []LabeledPrice, providerToken string, title string) *SendInvoiceConfig {
+	return &SendInvoiceConfig{
+		ChatID:        chatID,
+		Currency:      currency,
+		Description:   description,
+		Payload:       payload,
+		Prices:        prices,
+		ProviderToken: providerToken,
+		Title:         title,
 	}
-	var data Message
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
 }
 
-// SendLocation
-// Use this method to send point on the map. On success, the sent Message is returned.
-type SendLocationConfig struct {
-	// ChatID
-	// Unique identifier for the target chat or username of the target channel (in the format
-	// @channelusername)
+func (t *SendInvoiceConfig) SetAllowSendingWithoutReply(allowSendingWithoutReply bool) *SendInvoiceConfig {
+	t.AllowSendingWithoutReply = allowSendingWithoutReply
+	return t
+}
+
+func (t *SendInvoiceConfig) SetDisableNotification(disableNotification bool) *SendInvoiceConfig {
+	t.DisableNotification = disableNotification
+	return t
+}
+
+func (t *SendInvoiceConfig) SetIsFlexible(isFlexible bool) *SendInvoiceConfig {
+	t.IsFlexible = isFlexible
+	return t
+}
+
+func (t *SendInvoiceConfig) SetMaxTipAmount(maxTipAmount int64) *SendInvoiceConfig {
+	t.MaxTipAmount = maxTipAmount
+	return t
+}
+
+func (t *SendInvoiceConfig) SetMessageThreadID(messageThreadID int64) *SendInvoiceConfig {
+	t.MessageThreadID = messageThreadID
+	return t
+}
+
+func (t *SendInvoiceConfig) SetNeedEmail(needEmail bool) *SendInvoiceConfig {
+	t.NeedEmail = needEmail
+	return t
+}
+
+func (t *SendInvoiceConfig) SetNeedName(needName bool) *SendInvoiceConfig {
+	t.NeedName = needName
+	return t
+}
+
+func (t *SendInvoiceConfig) SetNeedPhoneNumber(needPhoneNumber bool) *SendInvoiceConfig {
+	t.NeedPhoneNumber = needPhoneNumber
+	return t
+}
+
+func (t *SendInvoiceConfig) SetNeedShippingAddress(needShippingAddress bool) *SendInvoiceConfig {
+	t.NeedShippingAddress = needShippingAddress
+	return t
+}
+
+func (t *SendInvoiceConfig) SetPhotoHeight(photoHeight int64) *SendInvoiceConfig {
+	t.PhotoHeight = photoHeight
+	return t
+}
+
+func (t *SendInvoiceConfig) SetPhotoSize(photoSize int64) *SendInvoiceConfig {
+	t.PhotoSize = photoSize
+	return t
+}
+
+func (t *SendInvoiceConfig) SetPhotoURL(photoURL string) *SendInvoiceConfig {
+	t.PhotoURL = photoURL
+	return t
+}
+
+func (t *SendInvoiceConfig) SetPhotoWidth(photoWidth int64) *SendInvoiceConfig {
+	t.PhotoWidth = photoWidth
+	return t
+}
+
+func (t *SendInvoiceConfig) SetProtectContent(protectContent bool) *SendInvoiceConfig {
+	t.ProtectContent = protectContent
+	return t
+}
+
+func (t *SendInvoiceConfig) SetProviderData(providerData string) *SendInvoiceConfig {
+	t.ProviderData = providerData
+	return t
+}
+
+func (t *SendInvoiceConfig) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *SendInvoiceConfig {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
+func (t *SendInvoiceConfig) SetReplyToMessageID(replyToMessageID int64) *SendInvoiceConfig {
+	t.ReplyToMessageID = replyToMessageID
+	return t
+}
+
+func (t *SendInvoiceConfig) SetSendEmailToProvider(sendEmailToProvider bool) *SendInvoiceConfig {
+	t.SendEmailToProvider = sendEmailToProvider
+	return t
+}
+
+func (t *SendInvoiceConfig) SetSendPhoneNumberToProvider(sendPhoneNumberToProvider bool) *SendInvoiceConfig {
+	t.SendPhoneNumberToProvider = sendPhoneNumberToProvider
+	return t
+}
+
+func (t *SendInvoiceConfig) SetStartParameter(startParameter string) *SendInvoiceConfig {
+	t.StartParameter = startParameter
+	return t
+}
+
+func (t *SendInvoiceConfig) SetSuggestedTipAmounts(suggestedTipAmounts []int64) *SendInvoiceConfig {
+	t.SuggestedTipAmounts = suggestedTipAmounts
+	return t
+}
+
+// SendInvoice
+// Use this method to send invoices. On success, the sent Message is returned.
+func (api *API) SendInvoice(
+	ctx context.Context,
+	args *SendInvoiceConfig,
+) (*Message, error) {
+	var res Message
+	if err := api.Do(ctx, args, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// SendLocation
+// Use this method to send point on the map. On success, the sent Message is returned.
+type SendLocationConfig struct {
+	// ChatID
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
 	ChatID IntStr `json:"chat_id"`
 	// Latitude
 	// Latitude of the location
@@ -2645,19 +4341,96 @@ type SendLocationConfig struct {
 	ReplyToMessageID int64 `json:"reply_to_message_id,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *SendLocationConfig) Validate() error {
+	return firstError(
+		validateRange(!isZero(t.Heading), "heading", t.Heading, 1, 360),
+		validateRange(!isZero(t.HorizontalAccuracy), "horizontal_accuracy", t.HorizontalAccuracy, 0, 1500),
+		validateRange(!isZero(t.LivePeriod), "live_period", t.LivePeriod, 60, 86400),
+		validateRange(!isZero(t.ProximityAlertRadius), "proximity_alert_radius", t.ProximityAlertRadius, 1, 100000),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *SendLocationConfig) Method() string {
+	return "sendLocation"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *SendLocationConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewSendLocationConfig creates SendLocationConfig with the required arguments.
+func NewSendLocationConfig(chatID IntStr, latitude float64, longitude float64) *SendLocationConfig {
+	return &SendLocationConfig{
+		ChatID:    chatID,
+		Latitude:  latitude,
+		Longitude: longitude,
+	}
+}
+
+func (t *SendLocationConfig) SetAllowSendingWithoutReply(allowSendingWithoutReply bool) *SendLocationConfig {
+	t.AllowSendingWithoutReply = allowSendingWithoutReply
+	return t
+}
+
+func (t *SendLocationConfig) SetDisableNotification(disableNotification bool) *SendLocationConfig {
+	t.DisableNotification = disableNotification
+	return t
+}
+
+func (t *SendLocationConfig) SetHeading(heading int64) *SendLocationConfig {
+	t.Heading = heading
+	return t
+}
+
+func (t *SendLocationConfig) SetHorizontalAccuracy(horizontalAccuracy float64) *SendLocationConfig {
+	t.HorizontalAccuracy = &horizontalAccuracy
+	return t
+}
+
+func (t *SendLocationConfig) SetLivePeriod(livePeriod int64) *SendLocationConfig {
+	t.LivePeriod = livePeriod
+	return t
+}
+
+func (t *SendLocationConfig) SetMessageThreadID(messageThreadID int64) *SendLocationConfig {
+	t.MessageThreadID = messageThreadID
+	return t
+}
+
+func (t *SendLocationConfig) SetProtectContent(protectContent bool) *SendLocationConfig {
+	t.ProtectContent = protectContent
+	return t
+}
+
+func (t *SendLocationConfig) SetProximityAlertRadius(proximityAlertRadius int64) *SendLocationConfig {
+	t.ProximityAlertRadius = proximityAlertRadius
+	return t
+}
+
+func (t *SendLocationConfig) SetReplyMarkup(replyMarkup ReplyMarkup) *SendLocationConfig {
+	t.ReplyMarkup = replyMarkup
+	return t
+}
+
+func (t *SendLocationConfig) SetReplyToMessageID(replyToMessageID int64) *SendLocationConfig {
+	t.ReplyToMessageID = replyToMessageID
+	return t
+}
+
 // SendLocation
 // Use this method to send point on the map. On success, the sent Message is returned.
 func (api *API) SendLocation(
 	ctx context.Context,
 	args *SendLocationConfig,
 ) (*Message, error) {
-	resp, err := api.MakeRequest(ctx, "sendLocation", args)
-	if err != nil {
+	var res Message
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data Message
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
+	return &res, nil
 }
 
 // SendMediaGroup
@@ -2691,6 +4464,56 @@ type SendMediaGroupConfig struct {
 	ReplyToMessageID int64 `json:"reply_to_message_id,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *SendMediaGroupConfig) Validate() error {
+	return firstError(
+		validateCount(true, "media", t.Media, 2, 10),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *SendMediaGroupConfig) Method() string {
+	return "sendMediaGroup"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *SendMediaGroupConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewSendMediaGroupConfig creates SendMediaGroupConfig with the required arguments.
+func NewSendMediaGroupConfig(chatID IntStr, media []InputMediaGraphics) *SendMediaGroupConfig {
+	return &SendMediaGroupConfig{
+		ChatID: chatID,
+		Media:  media,
+	}
+}
+
+func (t *SendMediaGroupConfig) SetAllowSendingWithoutReply(allowSendingWithoutReply bool) *SendMediaGroupConfig {
+	t.AllowSendingWithoutReply = allowSendingWithoutReply
+	return t
+}
+
+func (t *SendMediaGroupConfig) SetDisableNotification(disableNotification bool) *SendMediaGroupConfig {
+	t.DisableNotification = disableNotification
+	return t
+}
+
+func (t *SendMediaGroupConfig) SetMessageThreadID(messageThreadID int64) *SendMediaGroupConfig {
+	t.MessageThreadID = messageThreadID
+	return t
+}
+
+func (t *SendMediaGroupConfig) SetProtectContent(protectContent bool) *SendMediaGroupConfig {
+	t.ProtectContent = protectContent
+	return t
+}
+
+func (t *SendMediaGroupConfig) SetReplyToMessageID(replyToMessageID int64) *SendMediaGroupConfig {
+	t.ReplyToMessageID = replyToMessageID
+	return t
+}
+
 // SendMediaGroup
 // Use this method to send a group of photos, videos, documents or audios as an album. Documents
 // and audio files can be only grouped in an album with messages of the same type. On success, an
@@ -2699,13 +4522,11 @@ func (api *API) SendMediaGroup(
 	ctx context.Context,
 	args *SendMediaGroupConfig,
 ) ([]Message, error) {
-	resp, err := api.MakeRequest(ctx, "sendMediaGroup", args)
-	if err != nil {
+	var res []Message
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data []Message
-	err = json.Unmarshal(resp.Result, &data)
-	return data, err
+	return res, nil
 }
 
 // SendMessage
@@ -2738,7 +4559,7 @@ type SendMessageConfig struct {
 	MessageThreadID int64 `json:"message_thread_id,omitempty"`
 	// ParseMode
 	// Mode for parsing entities in the message text. See formatting options for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 	// ProtectContent
 	// Protects the contents of the sent message from forwarding and saving
 	ProtectContent bool `json:"protect_content,omitempty"`
@@ -2751,19 +4572,87 @@ type SendMessageConfig struct {
 	ReplyToMessageID int64 `json:"reply_to_message_id,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *SendMessageConfig) Validate() error {
+	return firstError(
+		validateLength(isZero(t.ParseMode), "text", t.Text, 1, 4096),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *SendMessageConfig) Method() string {
+	return "sendMessage"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *SendMessageConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewSendMessageConfig creates SendMessageConfig with the required arguments.
+func NewSendMessageConfig(chatID IntStr, text string) *SendMessageConfig {
+	return &SendMessageConfig{
+		ChatID: chatID,
+		Text:   text,
+	}
+}
+
+func (t *SendMessageConfig) SetAllowSendingWithoutReply(allowSendingWithoutReply bool) *SendMessageConfig {
+	t.AllowSendingWithoutReply = allowSendingWithoutReply
+	return t
+}
+
+func (t *SendMessageConfig) SetDisableNotification(disableNotification bool) *SendMessageConfig {
+	t.DisableNotification = disableNotification
+	return t
+}
+
+func (t *SendMessageConfig) SetDisableWebPagePreview(disableWebPagePreview bool) *SendMessageConfig {
+	t.DisableWebPagePreview = disableWebPagePreview
+	return t
+}
+
+func (t *SendMessageConfig) SetEntities(entities []MessageEntity) *SendMessageConfig {
+	t.Entities = entities
+	return t
+}
+
+func (t *SendMessageConfig) SetMessageThreadID(messageThreadID int64) *SendMessageConfig {
+	t.MessageThreadID = messageThreadID
+	return t
+}
+
+func (t *SendMessageConfig) SetParseMode(parseMode ParseMode) *SendMessageConfig {
+	t.ParseMode = parseMode
+	return t
+}
+
+func (t *SendMessageConfig) SetProtectContent(protectContent bool) *SendMessageConfig {
+	t.ProtectContent = protectContent
+	return t
+}
+
+func (t *SendMessageConfig) SetReplyMarkup(replyMarkup ReplyMarkup) *SendMessageConfig {
+	t.ReplyMarkup = replyMarkup
+	return t
+}
+
+func (t *SendMessageConfig) SetReplyToMessageID(replyToMessageID int64) *SendMessageConfig {
+	t.ReplyToMessageID = replyToMessageID
+	return t
+}
+
 // SendMessage
 // Use this method to send text messages. On success, the sent Message is returned.
 func (api *API) SendMessage(
 	ctx context.Context,
 	args *SendMessageConfig,
 ) (*Message, error) {
-	resp, err := api.MakeRequest(ctx, "sendMessage", args)
-	if err != nil {
+	var res Message
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data Message
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
+	return &res, nil
 }
 
 // SendPhoto
@@ -2804,7 +4693,7 @@ type SendPhotoConfig struct {
 	MessageThreadID int64 `json:"message_thread_id,omitempty"`
 	// ParseMode
 	// Mode for parsing entities in the photo caption. See formatting options for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 	// ProtectContent
 	// Protects the contents of the sent message from forwarding and saving
 	ProtectContent bool `json:"protect_content,omitempty"`
@@ -2817,6 +4706,83 @@ type SendPhotoConfig struct {
 	ReplyToMessageID int64 `json:"reply_to_message_id,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *SendPhotoConfig) Validate() error {
+	return firstError(
+		validateLength(!isZero(t.Caption) && isZero(t.ParseMode), "caption", t.Caption, 0, 1024),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *SendPhotoConfig) Method() string {
+	return "sendPhoto"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *SendPhotoConfig) Files() map[string]*InputFile {
+	files := make(map[string]*InputFile)
+	files["photo"] = &t.Photo
+	return files
+}
+
+// NewSendPhotoConfig creates SendPhotoConfig with the required arguments.
+func NewSendPhotoConfig(chatID IntStr, photo InputFile) *SendPhotoConfig {
+	return &SendPhotoConfig{
+		ChatID: chatID,
+		Photo:  photo,
+	}
+}
+
+func (t *SendPhotoConfig) SetAllowSendingWithoutReply(allowSendingWithoutReply bool) *SendPhotoConfig {
+	t.AllowSendingWithoutReply = allowSendingWithoutReply
+	return t
+}
+
+func (t *SendPhotoConfig) SetCaption(caption string) *SendPhotoConfig {
+	t.Caption = caption
+	return t
+}
+
+func (t *SendPhotoConfig) SetCaptionEntities(captionEntities []MessageEntity) *SendPhotoConfig {
+	t.CaptionEntities = captionEntities
+	return t
+}
+
+func (t *SendPhotoConfig) SetDisableNotification(disableNotification bool) *SendPhotoConfig {
+	t.DisableNotification = disableNotification
+	return t
+}
+
+func (t *SendPhotoConfig) SetHasSpoiler(hasSpoiler bool) *SendPhotoConfig {
+	t.HasSpoiler = hasSpoiler
+	return t
+}
+
+func (t *SendPhotoConfig) SetMessageThreadID(messageThreadID int64) *SendPhotoConfig {
+	t.MessageThreadID = messageThreadID
+	return t
+}
+
+func (t *SendPhotoConfig) SetParseMode(parseMode ParseMode) *SendPhotoConfig {
+	t.ParseMode = parseMode
+	return t
+}
+
+func (t *SendPhotoConfig) SetProtectContent(protectContent bool) *SendPhotoConfig {
+	t.ProtectContent = protectContent
+	return t
+}
+
+func (t *SendPhotoConfig) SetReplyMarkup(replyMarkup ReplyMarkup) *SendPhotoConfig {
+	t.ReplyMarkup = replyMarkup
+	return t
+}
+
+func (t *SendPhotoConfig) SetReplyToMessageID(replyToMessageID int64) *SendPhotoConfig {
+	t.ReplyToMessageID = replyToMessageID
+	return t
+}
+
 func (t SendPhotoConfig) EncodeURL() (url.Values, error) {
 	res := make(url.Values)
 	res.Add("allow_sending_without_reply", strconv.FormatBool(t.AllowSendingWithoutReply))
@@ -2832,7 +4798,9 @@ func (t SendPhotoConfig) EncodeURL() (url.Values, error) {
 	res.Add("disable_notification", strconv.FormatBool(t.DisableNotification))
 	res.Add("has_spoiler", strconv.FormatBool(t.HasSpoiler))
 	res.Add("message_thread_id", strconv.FormatInt(t.MessageThreadID, 10))
-	res.Add("parse_mode", t.ParseMode)
+	if t.ParseMode != "" {
+		res.Add("parse_mode", t.ParseMode.String())
+	}
 	res.Add("protect_content", strconv.FormatBool(t.ProtectContent))
 	if t.ReplyMarkup != nil {
 		raw, err := json.Marshal(t.ReplyMarkup)
@@ -2851,27 +4819,11 @@ func (api *API) SendPhoto(
 	ctx context.Context,
 	args *SendPhotoConfig,
 ) (*Message, error) {
-	if args.Photo.Reader != nil {
-		values, err := args.EncodeURL()
-		if err != nil {
-			return nil, err
-		}
-		resp, err := api.UploadFile(ctx, values, "sendPhoto", "photo", &args.Photo)
-		if err != nil {
-			return nil, err
-		}
-
-		var res Message
-		err = json.Unmarshal(resp.Result, &res)
-		return &res, err
-	}
-	resp, err := api.MakeRequest(ctx, "sendPhoto", args)
-	if err != nil {
+	var res Message
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data Message
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
+	return &res, nil
 }
 
 // SendPoll
@@ -2914,7 +4866,7 @@ type SendPollConfig struct {
 	ExplanationEntities []MessageEntity `json:"explanation_entities,omitempty"`
 	// ExplanationParseMode
 	// Mode for parsing entities in the explanation. See formatting options for more details.
-	ExplanationParseMode string `json:"explanation_parse_mode,omitempty"`
+	ExplanationParseMode ParseMode `json:"explanation_parse_mode,omitempty"`
 	// IsAnonymous
 	// True, if the poll needs to be anonymous, defaults to True
 	IsAnonymous bool `json:"is_anonymous,omitempty"`
@@ -2941,7 +4893,117 @@ type SendPollConfig struct {
 	ReplyToMessageID int64 `json:"reply_to_message_id,omitempty"`
 	// Type
 	// Poll type, "quiz" or "regular", defaults to "regular"
-	Type *SendType `json:"type,omitempty"`
+	Type SendType `json:"type,omitempty"`
+}
+
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *SendPollConfig) Validate() error {
+	return firstError(
+		validateLength(!isZero(t.Explanation) && isZero(t.ExplanationParseMode), "explanation", t.Explanation, 0, 200),
+		validateRange(!isZero(t.OpenPeriod), "open_period", t.OpenPeriod, 5, 600),
+		validateCount(true, "options", t.Options, 2, 10),
+		validateEachLength(true, "options", t.Options, 1, 100),
+		validateLength(true, "question", t.Question, 1, 300),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *SendPollConfig) Method() string {
+	return "sendPoll"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *SendPollConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewSendPollConfig creates SendPollConfig with the required arguments.
+func NewSendPollConfig(chatID IntStr, options []string, question string) *SendPollConfig {
+	return &SendPollConfig{
+		ChatID:   chatID,
+		Options:  options,
+		Question: question,
+	}
+}
+
+func (t *SendPollConfig) SetAllowSendingWithoutReply(allowSendingWithoutReply bool) *SendPollConfig {
+	t.AllowSendingWithoutReply = allowSendingWithoutReply
+	return t
+}
+
+func (t *SendPollConfig) SetAllowsMultipleAnswers(allowsMultipleAnswers bool) *SendPollConfig {
+	t.AllowsMultipleAnswers = allowsMultipleAnswers
+	return t
+}
+
+func (t *SendPollConfig) SetCloseDate(closeDate int64) *SendPollConfig {
+	t.CloseDate = closeDate
+	return t
+}
+
+func (t *SendPollConfig) SetCorrectOptionID(correctOptionID int64) *SendPollConfig {
+	t.CorrectOptionID = correctOptionID
+	return t
+}
+
+func (t *SendPollConfig) SetDisableNotification(disableNotification bool) *SendPollConfig {
+	t.DisableNotification = disableNotification
+	return t
+}
+
+func (t *SendPollConfig) SetExplanation(explanation string) *SendPollConfig {
+	t.Explanation = explanation
+	return t
+}
+
+func (t *SendPollConfig) SetExplanationEntities(explanationEntities []MessageEntity) *SendPollConfig {
+	t.ExplanationEntities = explanationEntities
+	return t
+}
+
+func (t *SendPollConfig) SetExplanationParseMode(explanationParseMode ParseMode) *SendPollConfig {
+	t.ExplanationParseMode = explanationParseMode
+	return t
+}
+
+func (t *SendPollConfig) SetIsAnonymous(isAnonymous bool) *SendPollConfig {
+	t.IsAnonymous = isAnonymous
+	return t
+}
+
+func (t *SendPollConfig) SetIsClosed(isClosed bool) *SendPollConfig {
+	t.IsClosed = isClosed
+	return t
+}
+
+func (t *SendPollConfig) SetMessageThreadID(messageThreadID int64) *SendPollConfig {
+	t.MessageThreadID = messageThreadID
+	return t
+}
+
+func (t *SendPollConfig) SetOpenPeriod(openPeriod int64) *SendPollConfig {
+	t.OpenPeriod = openPeriod
+	return t
+}
+
+func (t *SendPollConfig) SetProtectContent(protectContent bool) *SendPollConfig {
+	t.ProtectContent = protectContent
+	return t
+}
+
+func (t *SendPollConfig) SetReplyMarkup(replyMarkup ReplyMarkup) *SendPollConfig {
+	t.ReplyMarkup = replyMarkup
+	return t
+}
+
+func (t *SendPollConfig) SetReplyToMessageID(replyToMessageID int64) *SendPollConfig {
+	t.ReplyToMessageID = replyToMessageID
+	return t
+}
+
+func (t *SendPollConfig) SetType(typ SendType) *SendPollConfig {
+	t.Type = typ
+	return t
 }
 
 // SendPoll
@@ -2950,13 +5012,11 @@ func (api *API) SendPoll(
 	ctx context.Context,
 	args *SendPollConfig,
 ) (*Message, error) {
-	resp, err := api.MakeRequest(ctx, "sendPoll", args)
-	if err != nil {
+	var res Message
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data Message
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
+	return &res, nil
 }
 
 // SendSticker
@@ -3000,6 +5060,66 @@ type SendStickerConfig struct {
 	ReplyToMessageID int64 `json:"reply_to_message_id,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *SendStickerConfig) Validate() error {
+	return nil
+}
+
+// Method returns the name of the API method.
+func (t *SendStickerConfig) Method() string {
+	return "sendSticker"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *SendStickerConfig) Files() map[string]*InputFile {
+	files := make(map[string]*InputFile)
+	files["sticker"] = &t.Sticker
+	return files
+}
+
+// NewSendStickerConfig creates SendStickerConfig with the required arguments.
+func NewSendStickerConfig(chatID IntStr, sticker InputFile) *SendStickerConfig {
+	return &SendStickerConfig{
+		ChatID:  chatID,
+		Sticker: sticker,
+	}
+}
+
+func (t *SendStickerConfig) SetAllowSendingWithoutReply(allowSendingWithoutReply bool) *SendStickerConfig {
+	t.AllowSendingWithoutReply = allowSendingWithoutReply
+	return t
+}
+
+func (t *SendStickerConfig) SetDisableNotification(disableNotification bool) *SendStickerConfig {
+	t.DisableNotification = disableNotification
+	return t
+}
+
+func (t *SendStickerConfig) SetEmoji(emoji string) *SendStickerConfig {
+	t.Emoji = emoji
+	return t
+}
+
+func (t *SendStickerConfig) SetMessageThreadID(messageThreadID int64) *SendStickerConfig {
+	t.MessageThreadID = messageThreadID
+	return t
+}
+
+func (t *SendStickerConfig) SetProtectContent(protectContent bool) *SendStickerConfig {
+	t.ProtectContent = protectContent
+	return t
+}
+
+func (t *SendStickerConfig) SetReplyMarkup(replyMarkup ReplyMarkup) *SendStickerConfig {
+	t.ReplyMarkup = replyMarkup
+	return t
+}
+
+func (t *SendStickerConfig) SetReplyToMessageID(replyToMessageID int64) *SendStickerConfig {
+	t.ReplyToMessageID = replyToMessageID
+	return t
+}
+
 func (t SendStickerConfig) EncodeURL() (url.Values, error) {
 	res := make(url.Values)
 	res.Add("allow_sending_without_reply", strconv.FormatBool(t.AllowSendingWithoutReply))
@@ -3026,27 +5146,11 @@ func (api *API) SendSticker(
 	ctx context.Context,
 	args *SendStickerConfig,
 ) (*Message, error) {
-	if args.Sticker.Reader != nil {
-		values, err := args.EncodeURL()
-		if err != nil {
-			return nil, err
-		}
-		resp, err := api.UploadFile(ctx, values, "sendSticker", "sticker", &args.Sticker)
-		if err != nil {
-			return nil, err
-		}
-
-		var res Message
-		err = json.Unmarshal(resp.Result, &res)
-		return &res, err
-	}
-	resp, err := api.MakeRequest(ctx, "sendSticker", args)
-	if err != nil {
+	var res Message
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data Message
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
+	return &res, nil
 }
 
 // SendVenue
@@ -3104,19 +5208,93 @@ type SendVenueConfig struct {
 	ReplyToMessageID int64 `json:"reply_to_message_id,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *SendVenueConfig) Validate() error {
+	return nil
+}
+
+// Method returns the name of the API method.
+func (t *SendVenueConfig) Method() string {
+	return "sendVenue"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *SendVenueConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewSendVenueConfig creates SendVenueConfig with the required arguments.
+func NewSendVenueConfig(address string, chatID IntStr, latitude float64, longitude float64, title string) *SendVenueConfig {
+	return &SendVenueConfig{
+		Address:   address,
+		ChatID:    chatID,
+		Latitude:  latitude,
+		Longitude: longitude,
+		Title:     title,
+	}
+}
+
+func (t *SendVenueConfig) SetAllowSendingWithoutReply(allowSendingWithoutReply bool) *SendVenueConfig {
+	t.AllowSendingWithoutReply = allowSendingWithoutReply
+	return t
+}
+
+func (t *SendVenueConfig) SetDisableNotification(disableNotification bool) *SendVenueConfig {
+	t.DisableNotification = disableNotification
+	return t
+}
+
+func (t *SendVenueConfig) SetFoursquareID(foursquareID string) *SendVenueConfig {
+	t.FoursquareID = foursquareID
+	return t
+}
+
+func (t *SendVenueConfig) SetFoursquareType(foursquareType string) *SendVenueConfig {
+	t.FoursquareType = foursquareType
+	return t
+}
+
+func (t *SendVenueConfig) SetGooglePlaceID(googlePlaceID string) *SendVenueConfig {
+	t.GooglePlaceID = googlePlaceID
+	return t
+}
+
+func (t *SendVenueConfig) SetGooglePlaceType(googlePlaceType string) *SendVenueConfig {
+	t.GooglePlaceType = googlePlaceType
+	return t
+}
+
+func (t *SendVenueConfig) SetMessageThreadID(messageThreadID int64) *SendVenueConfig {
+	t.MessageThreadID = messageThreadID
+	return t
+}
+
+func (t *SendVenueConfig) SetProtectContent(protectContent bool) *SendVenueConfig {
+	t.ProtectContent = protectContent
+	return t
+}
+
+func (t *SendVenueConfig) SetReplyMarkup(replyMarkup ReplyMarkup) *SendVenueConfig {
+	t.ReplyMarkup = replyMarkup
+	return t
+}
+
+func (t *SendVenueConfig) SetReplyToMessageID(replyToMessageID int64) *SendVenueConfig {
+	t.ReplyToMessageID = replyToMessageID
+	return t
+}
+
 // SendVenue
 // Use this method to send information about a venue. On success, the sent Message is returned.
 func (api *API) SendVenue(
 	ctx context.Context,
 	args *SendVenueConfig,
 ) (*Message, error) {
-	resp, err := api.MakeRequest(ctx, "sendVenue", args)
-	if err != nil {
+	var res Message
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data Message
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
+	return &res, nil
 }
 
 // SendVideo
@@ -3163,7 +5341,7 @@ type SendVideoConfig struct {
 	MessageThreadID int64 `json:"message_thread_id,omitempty"`
 	// ParseMode
 	// Mode for parsing entities in the video caption. See formatting options for more details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 	// ProtectContent
 	// Protects the contents of the sent message from forwarding and saving
 	ProtectContent bool `json:"protect_content,omitempty"`
@@ -3190,6 +5368,111 @@ type SendVideoConfig struct {
 	Width int64 `json:"width,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *SendVideoConfig) Validate() error {
+	return firstError(
+		validateLength(!isZero(t.Caption) && isZero(t.ParseMode), "caption", t.Caption, 0, 1024),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *SendVideoConfig) Method() string {
+	return "sendVideo"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *SendVideoConfig) Files() map[string]*InputFile {
+	files := make(map[string]*InputFile)
+	if t.Thumbnail != nil {
+		files["thumbnail"] = t.Thumbnail
+	}
+	files["video"] = &t.Video
+	return files
+}
+
+// NewSendVideoConfig creates SendVideoConfig with the required arguments.
+func NewSendVideoConfig(chatID IntStr, video InputFile) *SendVideoConfig {
+	return &SendVideoConfig{
+		ChatID: chatID,
+		Video:  video,
+	}
+}
+
+func (t *SendVideoConfig) SetAllowSendingWithoutReply(allowSendingWithoutReply bool) *SendVideoConfig {
+	t.AllowSendingWithoutReply = allowSendingWithoutReply
+	return t
+}
+
+func (t *SendVideoConfig) SetCaption(caption string) *SendVideoConfig {
+	t.Caption = caption
+	return t
+}
+
+func (t *SendVideoConfig) SetCaptionEntities(captionEntities []MessageEntity) *SendVideoConfig {
+	t.CaptionEntities = captionEntities
+	return t
+}
+
+func (t *SendVideoConfig) SetDisableNotification(disableNotification bool) *SendVideoConfig {
+	t.DisableNotification = disableNotification
+	return t
+}
+
+func (t *SendVideoConfig) SetDuration(duration int64) *SendVideoConfig {
+	t.Duration = duration
+	return t
+}
+
+func (t *SendVideoConfig) SetHasSpoiler(hasSpoiler bool) *SendVideoConfig {
+	t.HasSpoiler = hasSpoiler
+	return t
+}
+
+func (t *SendVideoConfig) SetHeight(height int64) *SendVideoConfig {
+	t.Height = height
+	return t
+}
+
+func (t *SendVideoConfig) SetMessageThreadID(messageThreadID int64) *SendVideoConfig {
+	t.MessageThreadID = messageThreadID
+	return t
+}
+
+func (t *SendVideoConfig) SetParseMode(parseMode ParseMode) *SendVideoConfig {
+	t.ParseMode = parseMode
+	return t
+}
+
+func (t *SendVideoConfig) SetProtectContent(protectContent bool) *SendVideoConfig {
+	t.ProtectContent = protectContent
+	return t
+}
+
+func (t *SendVideoConfig) SetReplyMarkup(replyMarkup ReplyMarkup) *SendVideoConfig {
+	t.ReplyMarkup = replyMarkup
+	return t
+}
+
+func (t *SendVideoConfig) SetReplyToMessageID(replyToMessageID int64) *SendVideoConfig {
+	t.ReplyToMessageID = replyToMessageID
+	return t
+}
+
+func (t *SendVideoConfig) SetSupportsStreaming(supportsStreaming bool) *SendVideoConfig {
+	t.SupportsStreaming = supportsStreaming
+	return t
+}
+
+func (t *SendVideoConfig) SetThumbnail(thumbnail InputFile) *SendVideoConfig {
+	t.Thumbnail = &thumbnail
+	return t
+}
+
+func (t *SendVideoConfig) SetWidth(width int64) *SendVideoConfig {
+	t.Width = width
+	return t
+}
+
 func (t SendVideoConfig) EncodeURL() (url.Values, error) {
 	res := make(url.Values)
 	res.Add("allow_sending_without_reply", strconv.FormatBool(t.AllowSendingWithoutReply))
@@ -3207,7 +5490,9 @@ func (t SendVideoConfig) EncodeURL() (url.Values, error) {
 	res.Add("has_spoiler", strconv.FormatBool(t.HasSpoiler))
 	res.Add("height", strconv.FormatInt(t.Height, 10))
 	res.Add("message_thread_id", strconv.FormatInt(t.MessageThreadID, 10))
-	res.Add("parse_mode", t.ParseMode)
+	if t.ParseMode != "" {
+		res.Add("parse_mode", t.ParseMode.String())
+	}
 	res.Add("protect_content", strconv.FormatBool(t.ProtectContent))
 	if t.ReplyMarkup != nil {
 		raw, err := json.Marshal(t.ReplyMarkup)
@@ -3230,27 +5515,11 @@ func (api *API) SendVideo(
 	ctx context.Context,
 	args *SendVideoConfig,
 ) (*Message, error) {
-	if args.Video.Reader != nil {
-		values, err := args.EncodeURL()
-		if err != nil {
-			return nil, err
-		}
-		resp, err := api.UploadFile(ctx, values, "sendVideo", "video", &args.Video)
-		if err != nil {
-			return nil, err
-		}
-
-		var res Message
-		err = json.Unmarshal(resp.Result, &res)
-		return &res, err
-	}
-	resp, err := api.MakeRequest(ctx, "sendVideo", args)
-	if err != nil {
+	var res Message
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data Message
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
+	return &res, nil
 }
 
 // SendVideoNote
@@ -3303,6 +5572,79 @@ type SendVideoNoteConfig struct {
 	Thumbnail *InputFile `json:"thumbnail,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *SendVideoNoteConfig) Validate() error {
+	return nil
+}
+
+// Method returns the name of the API method.
+func (t *SendVideoNoteConfig) Method() string {
+	return "sendVideoNote"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *SendVideoNoteConfig) Files() map[string]*InputFile {
+	files := make(map[string]*InputFile)
+	if t.Thumbnail != nil {
+		files["thumbnail"] = t.Thumbnail
+	}
+	files["video_note"] = &t.VideoNote
+	return files
+}
+
+// NewSendVideoNoteConfig creates SendVideoNoteConfig with the required arguments.
+func NewSendVideoNoteConfig(chatID IntStr, videoNote InputFile) *SendVideoNoteConfig {
+	return &SendVideoNoteConfig{
+		ChatID:    chatID,
+		VideoNote: videoNote,
+	}
+}
+
+func (t *SendVideoNoteConfig) SetAllowSendingWithoutReply(allowSendingWithoutReply bool) *SendVideoNoteConfig {
+	t.AllowSendingWithoutReply = allowSendingWithoutReply
+	return t
+}
+
+func (t *SendVideoNoteConfig) SetDisableNotification(disableNotification bool) *SendVideoNoteConfig {
+	t.DisableNotification = disableNotification
+	return t
+}
+
+func (t *SendVideoNoteConfig) SetDuration(duration int64) *SendVideoNoteConfig {
+	t.Duration = duration
+	return t
+}
+
+func (t *SendVideoNoteConfig) SetLength(length int64) *SendVideoNoteConfig {
+	t.Length = length
+	return t
+}
+
+func (t *SendVideoNoteConfig) SetMessageThreadID(messageThreadID int64) *SendVideoNoteConfig {
+	t.MessageThreadID = messageThreadID
+	return t
+}
+
+func (t *SendVideoNoteConfig) SetProtectContent(protectContent bool) *SendVideoNoteConfig {
+	t.ProtectContent = protectContent
+	return t
+}
+
+func (t *SendVideoNoteConfig) SetReplyMarkup(replyMarkup ReplyMarkup) *SendVideoNoteConfig {
+	t.ReplyMarkup = replyMarkup
+	return t
+}
+
+func (t *SendVideoNoteConfig) SetReplyToMessageID(replyToMessageID int64) *SendVideoNoteConfig {
+	t.ReplyToMessageID = replyToMessageID
+	return t
+}
+
+func (t *SendVideoNoteConfig) SetThumbnail(thumbnail InputFile) *SendVideoNoteConfig {
+	t.Thumbnail = &thumbnail
+	return t
+}
+
 func (t SendVideoNoteConfig) EncodeURL() (url.Values, error) {
 	res := make(url.Values)
 	res.Add("allow_sending_without_reply", strconv.FormatBool(t.AllowSendingWithoutReply))
@@ -3330,27 +5672,11 @@ func (api *API) SendVideoNote(
 	ctx context.Context,
 	args *SendVideoNoteConfig,
 ) (*Message, error) {
-	if args.VideoNote.Reader != nil {
-		values, err := args.EncodeURL()
-		if err != nil {
-			return nil, err
-		}
-		resp, err := api.UploadFile(ctx, values, "sendVideoNote", "videonote", &args.VideoNote)
-		if err != nil {
-			return nil, err
-		}
-
-		var res Message
-		err = json.Unmarshal(resp.Result, &res)
-		return &res, err
-	}
-	resp, err := api.MakeRequest(ctx, "sendVideoNote", args)
-	if err != nil {
+	var res Message
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data Message
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
+	return &res, nil
 }
 
 // SendVoice
@@ -3394,7 +5720,7 @@ type SendVoiceConfig struct {
 	// ParseMode
 	// Mode for parsing entities in the voice message caption. See formatting options for more
 	// details.
-	ParseMode string `json:"parse_mode,omitempty"`
+	ParseMode ParseMode `json:"parse_mode,omitempty"`
 	// ProtectContent
 	// Protects the contents of the sent message from forwarding and saving
 	ProtectContent bool `json:"protect_content,omitempty"`
@@ -3407,6 +5733,83 @@ type SendVoiceConfig struct {
 	ReplyToMessageID int64 `json:"reply_to_message_id,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *SendVoiceConfig) Validate() error {
+	return firstError(
+		validateLength(!isZero(t.Caption) && isZero(t.ParseMode), "caption", t.Caption, 0, 1024),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *SendVoiceConfig) Method() string {
+	return "sendVoice"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *SendVoiceConfig) Files() map[string]*InputFile {
+	files := make(map[string]*InputFile)
+	files["voice"] = &t.Voice
+	return files
+}
+
+// NewSendVoiceConfig creates SendVoiceConfig with the required arguments.
+func NewSendVoiceConfig(chatID IntStr, voice InputFile) *SendVoiceConfig {
+	return &SendVoiceConfig{
+		ChatID: chatID,
+		Voice:  voice,
+	}
+}
+
+func (t *SendVoiceConfig) SetAllowSendingWithoutReply(allowSendingWithoutReply bool) *SendVoiceConfig {
+	t.AllowSendingWithoutReply = allowSendingWithoutReply
+	return t
+}
+
+func (t *SendVoiceConfig) SetCaption(caption string) *SendVoiceConfig {
+	t.Caption = caption
+	return t
+}
+
+func (t *SendVoiceConfig) SetCaptionEntities(captionEntities []MessageEntity) *SendVoiceConfig {
+	t.CaptionEntities = captionEntities
+	return t
+}
+
+func (t *SendVoiceConfig) SetDisableNotification(disableNotification bool) *SendVoiceConfig {
+	t.DisableNotification = disableNotification
+	return t
+}
+
+func (t *SendVoiceConfig) SetDuration(duration int64) *SendVoiceConfig {
+	t.Duration = duration
+	return t
+}
+
+func (t *SendVoiceConfig) SetMessageThreadID(messageThreadID int64) *SendVoiceConfig {
+	t.MessageThreadID = messageThreadID
+	return t
+}
+
+func (t *SendVoiceConfig) SetParseMode(parseMode ParseMode) *SendVoiceConfig {
+	t.ParseMode = parseMode
+	return t
+}
+
+func (t *SendVoiceConfig) SetProtectContent(protectContent bool) *SendVoiceConfig {
+	t.ProtectContent = protectContent
+	return t
+}
+
+func (t *SendVoiceConfig) SetReplyMarkup(replyMarkup ReplyMarkup) *SendVoiceConfig {
+	t.ReplyMarkup = replyMarkup
+	return t
+}
+
+func (t *SendVoiceConfig) SetReplyToMessageID(replyToMessageID int64) *SendVoiceConfig {
+	t.ReplyToMessageID = replyToMessageID
+	return t
+}
+
 func (t SendVoiceConfig) EncodeURL() (url.Values, error) {
 	res := make(url.Values)
 	res.Add("allow_sending_without_reply", strconv.FormatBool(t.AllowSendingWithoutReply))
@@ -3422,7 +5825,9 @@ func (t SendVoiceConfig) EncodeURL() (url.Values, error) {
 	res.Add("disable_notification", strconv.FormatBool(t.DisableNotification))
 	res.Add("duration", strconv.FormatInt(t.Duration, 10))
 	res.Add("message_thread_id", strconv.FormatInt(t.MessageThreadID, 10))
-	res.Add("parse_mode", t.ParseMode)
+	if t.ParseMode != "" {
+		res.Add("parse_mode", t.ParseMode.String())
+	}
 	res.Add("protect_content", strconv.FormatBool(t.ProtectContent))
 	if t.ReplyMarkup != nil {
 		raw, err := json.Marshal(t.ReplyMarkup)
@@ -3445,27 +5850,11 @@ func (api *API) SendVoice(
 	ctx context.Context,
 	args *SendVoiceConfig,
 ) (*Message, error) {
-	if args.Voice.Reader != nil {
-		values, err := args.EncodeURL()
-		if err != nil {
-			return nil, err
-		}
-		resp, err := api.UploadFile(ctx, values, "sendVoice", "voice", &args.Voice)
-		if err != nil {
-			return nil, err
-		}
-
-		var res Message
-		err = json.Unmarshal(resp.Result, &res)
-		return &res, err
-	}
-	resp, err := api.MakeRequest(ctx, "sendVoice", args)
-	if err != nil {
+	var res Message
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data Message
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
+	return &res, nil
 }
 
 // SetChatAdministratorCustomTitle
@@ -3484,6 +5873,32 @@ type SetChatAdministratorCustomTitleConfig struct {
 	UserID int64 `json:"user_id"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *SetChatAdministratorCustomTitleConfig) Validate() error {
+	return firstError(
+		validateLength(true, "custom_title", t.CustomTitle, 0, 16),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *SetChatAdministratorCustomTitleConfig) Method() string {
+	return "setChatAdministratorCustomTitle"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *SetChatAdministratorCustomTitleConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewSetChatAdministratorCustomTitleConfig creates SetChatAdministratorCustomTitleConfig with the required arguments.
+func NewSetChatAdministratorCustomTitleConfig(chatID IntStr, customTitle string, userID int64) *SetChatAdministratorCustomTitleConfig {
+	return &SetChatAdministratorCustomTitleConfig{
+		ChatID:      chatID,
+		CustomTitle: customTitle,
+		UserID:      userID,
+	}
+}
+
 // SetChatAdministratorCustomTitle
 // Use this method to set a custom title for an administrator in a supergroup promoted by the bot.
 // Returns True on success.
@@ -3491,8 +5906,7 @@ func (api *API) SetChatAdministratorCustomTitle(
 	ctx context.Context,
 	args *SetChatAdministratorCustomTitleConfig,
 ) error {
-	_, err := api.MakeRequest(ctx, "setChatAdministratorCustomTitle", args)
-	return err
+	return api.Do(ctx, args, nil)
 }
 
 // SetChatDescription
@@ -3559,6 +5973,34 @@ type SetChatPermissionsConfig struct {
 	UseIndependentChatPermissions bool `json:"use_independent_chat_permissions,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *SetChatPermissionsConfig) Validate() error {
+	return nil
+}
+
+// Method returns the name of the API method.
+func (t *SetChatPermissionsConfig) Method() string {
+	return "setChatPermissions"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *SetChatPermissionsConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewSetChatPermissionsConfig creates SetChatPermissionsConfig with the required arguments.
+func NewSetChatPermissionsConfig(chatID IntStr, permissions ChatPermissions) *SetChatPermissionsConfig {
+	return &SetChatPermissionsConfig{
+		ChatID:      chatID,
+		Permissions: permissions,
+	}
+}
+
+func (t *SetChatPermissionsConfig) SetUseIndependentChatPermissions(useIndependentChatPermissions bool) *SetChatPermissionsConfig {
+	t.UseIndependentChatPermissions = useIndependentChatPermissions
+	return t
+}
+
 // SetChatPermissions
 // Use this method to set default chat permissions for all members. The bot must be an
 // administrator in the group or a supergroup for this to work and must have the
@@ -3567,8 +6009,7 @@ func (api *API) SetChatPermissions(
 	ctx context.Context,
 	args *SetChatPermissionsConfig,
 ) error {
-	_, err := api.MakeRequest(ctx, "setChatPermissions", args)
-	return err
+	return api.Do(ctx, args, nil)
 }
 
 // SetChatPhoto
@@ -3589,7 +6030,7 @@ func (api *API) SetChatPhoto(
 		values := url.Values{
 			"chat_id": []string{chatID.String()},
 		}
-		_, err := api.UploadFile(ctx, values, "setChatPhoto", "setchatphoto", &photo)
+		_, err := api.UploadFile(ctx, values, "setChatPhoto", "photo", &photo)
 		return err
 	}
 	args := map[string]interface{}{
@@ -3696,6 +6137,58 @@ type SetGameScoreConfig struct {
 	MessageID int64 `json:"message_id,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *SetGameScoreConfig) Validate() error {
+	return firstError(
+		validateRequired(isZero(t.InlineMessageID), "chat_id", t.ChatID, "required if inline_message_id is not specified"),
+		validateRequired(isZero(t.ChatID) && isZero(t.MessageID), "inline_message_id", t.InlineMessageID, "required if chat_id and message_id are not specified"),
+		validateRequired(isZero(t.InlineMessageID), "message_id", t.MessageID, "required if inline_message_id is not specified"),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *SetGameScoreConfig) Method() string {
+	return "setGameScore"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *SetGameScoreConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewSetGameScoreConfig creates SetGameScoreConfig with the required arguments.
+func NewSetGameScoreConfig(score int64, userID int64) *SetGameScoreConfig {
+	return &SetGameScoreConfig{
+		Score:  score,
+		UserID: userID,
+	}
+}
+
+func (t *SetGameScoreConfig) SetChatID(chatID int64) *SetGameScoreConfig {
+	t.ChatID = chatID
+	return t
+}
+
+func (t *SetGameScoreConfig) SetDisableEditMessage(disableEditMessage bool) *SetGameScoreConfig {
+	t.DisableEditMessage = disableEditMessage
+	return t
+}
+
+func (t *SetGameScoreConfig) SetForce(force bool) *SetGameScoreConfig {
+	t.Force = force
+	return t
+}
+
+func (t *SetGameScoreConfig) SetInlineMessageID(inlineMessageID string) *SetGameScoreConfig {
+	t.InlineMessageID = inlineMessageID
+	return t
+}
+
+func (t *SetGameScoreConfig) SetMessageID(messageID int64) *SetGameScoreConfig {
+	t.MessageID = messageID
+	return t
+}
+
 // SetGameScore
 // Use this method to set the score of the specified user in a game message. On success, if the
 // message is not an inline message, the Message is returned, otherwise True is returned. Returns
@@ -3705,13 +6198,11 @@ func (api *API) SetGameScore(
 	ctx context.Context,
 	args *SetGameScoreConfig,
 ) (*Message, error) {
-	resp, err := api.MakeRequest(ctx, "setGameScore", args)
-	if err != nil {
+	var res Message
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data Message
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
+	return &res, nil
 }
 
 // SetMyCommands
@@ -3732,6 +6223,40 @@ type SetMyCommandsConfig struct {
 	Scope *BotCommandScope `json:"scope,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *SetMyCommandsConfig) Validate() error {
+	return firstError(
+		validateCount(true, "commands", t.Commands, 0, 100),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *SetMyCommandsConfig) Method() string {
+	return "setMyCommands"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *SetMyCommandsConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewSetMyCommandsConfig creates SetMyCommandsConfig with the required arguments.
+func NewSetMyCommandsConfig(commands []BotCommand) *SetMyCommandsConfig {
+	return &SetMyCommandsConfig{
+		Commands: commands,
+	}
+}
+
+func (t *SetMyCommandsConfig) SetLanguageCode(languageCode string) *SetMyCommandsConfig {
+	t.LanguageCode = languageCode
+	return t
+}
+
+func (t *SetMyCommandsConfig) SetScope(scope BotCommandScope) *SetMyCommandsConfig {
+	t.Scope = &scope
+	return t
+}
+
 // SetMyCommands
 // Use this method to change the list of the bot's commands. See this manual for more details about
 // bot commands. Returns True on success.
@@ -3739,8 +6264,7 @@ func (api *API) SetMyCommands(
 	ctx context.Context,
 	args *SetMyCommandsConfig,
 ) error {
-	_, err := api.MakeRequest(ctx, "setMyCommands", args)
-	return err
+	return api.Do(ctx, args, nil)
 }
 
 // SetMyDefaultAdministratorRights
@@ -3958,6 +6482,38 @@ type SetStickerSetThumbnailConfig struct {
 	Thumbnail *InputFile `json:"thumbnail,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *SetStickerSetThumbnailConfig) Validate() error {
+	return nil
+}
+
+// Method returns the name of the API method.
+func (t *SetStickerSetThumbnailConfig) Method() string {
+	return "setStickerSetThumbnail"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *SetStickerSetThumbnailConfig) Files() map[string]*InputFile {
+	files := make(map[string]*InputFile)
+	if t.Thumbnail != nil {
+		files["thumbnail"] = t.Thumbnail
+	}
+	return files
+}
+
+// NewSetStickerSetThumbnailConfig creates SetStickerSetThumbnailConfig with the required arguments.
+func NewSetStickerSetThumbnailConfig(name string, userID int64) *SetStickerSetThumbnailConfig {
+	return &SetStickerSetThumbnailConfig{
+		Name:   name,
+		UserID: userID,
+	}
+}
+
+func (t *SetStickerSetThumbnailConfig) SetThumbnail(thumbnail InputFile) *SetStickerSetThumbnailConfig {
+	t.Thumbnail = &thumbnail
+	return t
+}
+
 // SetStickerSetThumbnail
 // Use this method to set the thumbnail of a regular or mask sticker set. The format of the
 // thumbnail file must match the format of the stickers in the set. Returns True on success.
@@ -3965,8 +6521,7 @@ func (api *API) SetStickerSetThumbnail(
 	ctx context.Context,
 	args *SetStickerSetThumbnailConfig,
 ) error {
-	_, err := api.MakeRequest(ctx, "setStickerSetThumbnail", args)
-	return err
+	return api.Do(ctx, args, nil)
 }
 
 // SetStickerSetTitle
@@ -4004,7 +6559,7 @@ type SetWebhookConfig struct {
 	// to receive all update types except chat_member (default). If not specified, the previous
 	// setting will be used.Please note that this parameter doesn't affect updates created before
 	// the call to the setWebhook, so unwanted updates may be received for a short period of time.
-	AllowedUpdates []string `json:"allowed_updates,omitempty"`
+	AllowedUpdates []UpdateKind `json:"allowed_updates,omitempty"`
 	// Certificate
 	// Upload your public key certificate so that the root certificate in use can be checked. See
 	// our self-signed guide for details.
@@ -4028,6 +6583,65 @@ type SetWebhookConfig struct {
 	SecretToken string `json:"secret_token,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *SetWebhookConfig) Validate() error {
+	return firstError(
+		validateRange(!isZero(t.MaxConnections), "max_connections", t.MaxConnections, 1, 100),
+		validateLength(!isZero(t.SecretToken), "secret_token", t.SecretToken, 1, 256),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *SetWebhookConfig) Method() string {
+	return "setWebhook"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *SetWebhookConfig) Files() map[string]*InputFile {
+	files := make(map[string]*InputFile)
+	if t.Certificate != nil {
+		files["certificate"] = t.Certificate
+	}
+	return files
+}
+
+// NewSetWebhookConfig creates SetWebhookConfig with the required arguments.
+func NewSetWebhookConfig(url string) *SetWebhookConfig {
+	return &SetWebhookConfig{
+		URL: url,
+	}
+}
+
+func (t *SetWebhookConfig) SetAllowedUpdates(allowedUpdates []UpdateKind) *SetWebhookConfig {
+	t.AllowedUpdates = allowedUpdates
+	return t
+}
+
+func (t *SetWebhookConfig) SetCertificate(certificate InputFile) *SetWebhookConfig {
+	t.Certificate = &certificate
+	return t
+}
+
+func (t *SetWebhookConfig) SetDropPendingUpdates(dropPendingUpdates bool) *SetWebhookConfig {
+	t.DropPendingUpdates = dropPendingUpdates
+	return t
+}
+
+func (t *SetWebhookConfig) SetIPAddress(ipAddress string) *SetWebhookConfig {
+	t.IPAddress = ipAddress
+	return t
+}
+
+func (t *SetWebhookConfig) SetMaxConnections(maxConnections int64) *SetWebhookConfig {
+	t.MaxConnections = maxConnections
+	return t
+}
+
+func (t *SetWebhookConfig) SetSecretToken(secretToken string) *SetWebhookConfig {
+	t.SecretToken = secretToken
+	return t
+}
+
 // SetWebhook
 // Use this method to specify a URL and receive incoming updates via an outgoing webhook. Whenever
 // there is an update for the bot, we will send an HTTPS POST request to the specified URL,
@@ -4037,8 +6651,7 @@ func (api *API) SetWebhook(
 	ctx context.Context,
 	args *SetWebhookConfig,
 ) error {
-	_, err := api.MakeRequest(ctx, "setWebhook", args)
-	return err
+	return api.Do(ctx, args, nil)
 }
 
 // StopMessageLiveLocation
@@ -4062,6 +6675,50 @@ type StopMessageLiveLocationConfig struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *StopMessageLiveLocationConfig) Validate() error {
+	return firstError(
+		validateRequired(isZero(t.InlineMessageID), "chat_id", t.ChatID, "required if inline_message_id is not specified"),
+		validateRequired(isZero(t.ChatID) && isZero(t.MessageID), "inline_message_id", t.InlineMessageID, "required if chat_id and message_id are not specified"),
+		validateRequired(isZero(t.InlineMessageID), "message_id", t.MessageID, "required if inline_message_id is not specified"),
+	)
+}
+
+// Method returns the name of the API method.
+func (t *StopMessageLiveLocationConfig) Method() string {
+	return "stopMessageLiveLocation"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *StopMessageLiveLocationConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewStopMessageLiveLocationConfig creates StopMessageLiveLocationConfig with the required arguments.
+func NewStopMessageLiveLocationConfig() *StopMessageLiveLocationConfig {
+	return &StopMessageLiveLocationConfig{}
+}
+
+func (t *StopMessageLiveLocationConfig) SetChatID(chatID IntStr) *StopMessageLiveLocationConfig {
+	t.ChatID = chatID
+	return t
+}
+
+func (t *StopMessageLiveLocationConfig) SetInlineMessageID(inlineMessageID string) *StopMessageLiveLocationConfig {
+	t.InlineMessageID = inlineMessageID
+	return t
+}
+
+func (t *StopMessageLiveLocationConfig) SetMessageID(messageID int64) *StopMessageLiveLocationConfig {
+	t.MessageID = messageID
+	return t
+}
+
+func (t *StopMessageLiveLocationConfig) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *StopMessageLiveLocationConfig {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
 // StopMessageLiveLocation
 // Use this method to stop updating a live location message before live_period expires. On success,
 // if the message is not an inline message, the edited Message is returned, otherwise True is
@@ -4070,13 +6727,11 @@ func (api *API) StopMessageLiveLocation(
 	ctx context.Context,
 	args *StopMessageLiveLocationConfig,
 ) (*Message, error) {
-	resp, err := api.MakeRequest(ctx, "stopMessageLiveLocation", args)
-	if err != nil {
+	var res Message
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data Message
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
+	return &res, nil
 }
 
 // StopPoll
@@ -4095,6 +6750,34 @@ type StopPollConfig struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *StopPollConfig) Validate() error {
+	return nil
+}
+
+// Method returns the name of the API method.
+func (t *StopPollConfig) Method() string {
+	return "stopPoll"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *StopPollConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewStopPollConfig creates StopPollConfig with the required arguments.
+func NewStopPollConfig(chatID IntStr, messageID int64) *StopPollConfig {
+	return &StopPollConfig{
+		ChatID:    chatID,
+		MessageID: messageID,
+	}
+}
+
+func (t *StopPollConfig) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *StopPollConfig {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
 // StopPoll
 // Use this method to stop a poll which was sent by the bot. On success, the stopped Poll is
 // returned.
@@ -4102,13 +6785,11 @@ func (api *API) StopPoll(
 	ctx context.Context,
 	args *StopPollConfig,
 ) (*Poll, error) {
-	resp, err := api.MakeRequest(ctx, "stopPoll", args)
-	if err != nil {
+	var res Poll
+	if err := api.Do(ctx, args, &res); err != nil {
 		return nil, err
 	}
-	var data Poll
-	err = json.Unmarshal(resp.Result, &data)
-	return &data, err
+	return &res, nil
 }
 
 // UnbanChatMember
@@ -4131,6 +6812,34 @@ type UnbanChatMemberConfig struct {
 	OnlyIfBanned bool `json:"only_if_banned,omitempty"`
 }
 
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *UnbanChatMemberConfig) Validate() error {
+	return nil
+}
+
+// Method returns the name of the API method.
+func (t *UnbanChatMemberConfig) Method() string {
+	return "unbanChatMember"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *UnbanChatMemberConfig) Files() map[string]*InputFile {
+	return nil
+}
+
+// NewUnbanChatMemberConfig creates UnbanChatMemberConfig with the required arguments.
+func NewUnbanChatMemberConfig(chatID IntStr, userID int64) *UnbanChatMemberConfig {
+	return &UnbanChatMemberConfig{
+		ChatID: chatID,
+		UserID: userID,
+	}
+}
+
+func (t *UnbanChatMemberConfig) SetOnlyIfBanned(onlyIfBanned bool) *UnbanChatMemberConfig {
+	t.OnlyIfBanned = onlyIfBanned
+	return t
+}
+
 // UnbanChatMember
 // Use this method to unban a previously banned user in a supergroup or channel. The user will not
 // return to the group or channel automatically, but will be able to join via link, etc. The bot
@@ -4142,8 +6851,7 @@ func (api *API) UnbanChatMember(
 	ctx context.Context,
 	args *UnbanChatMemberConfig,
 ) error {
-	_, err := api.MakeRequest(ctx, "unbanChatMember", args)
-	return err
+	return api.Do(ctx, args, nil)
 }
 
 // UnbanChatSenderChat
@@ -4263,8 +6971,56 @@ type UploadStickerFileConfig struct {
 	Sticker InputFile `json:"sticker"`
 	// StickerFormat
 	// Format of the sticker, must be one of "static", "animated", "video"
-	StickerFormat string `json:"sticker_format"`
+	StickerFormat StickerFormat `json:"sticker_format"`
 	// UserID
 	// User identifier of sticker file owner
 	UserID int64 `json:"user_id"`
 }
+
+// Validate checks the arguments against the constraints from the API documentation.
+func (t *UploadStickerFileConfig) Validate() error {
+	return nil
+}
+
+// Method returns the name of the API method.
+func (t *UploadStickerFileConfig) Method() string {
+	return "uploadStickerFile"
+}
+
+// Files returns the files of the request by the argument names.
+func (t *UploadStickerFileConfig) Files() map[string]*InputFile {
+	files := make(map[string]*InputFile)
+	files["sticker"] = &t.Sticker
+	return files
+}
+
+// NewUploadStickerFileConfig creates UploadStickerFileConfig with the required arguments.
+func NewUploadStickerFileConfig(sticker InputFile, stickerFormat StickerFormat, userID int64) *UploadStickerFileConfig {
+	return &UploadStickerFileConfig{
+		Sticker:       sticker,
+		StickerFormat: stickerFormat,
+		UserID:        userID,
+	}
+}
+
+func (t UploadStickerFileConfig) EncodeURL() (url.Values, error) {
+	res := make(url.Values)
+	res.Add("sticker_format", t.StickerFormat.String())
+	res.Add("user_id", strconv.FormatInt(t.UserID, 10))
+	return res, nil
+}
+
+// UploadStickerFile
+// Use this method to upload a file with a sticker for later use in the createNewStickerSet and
+// addStickerToSet methods (the file can be used multiple times). Returns the uploaded File on
+// success.}}
+func (api *API) UploadStickerFile(
+	ctx context.Context,
+	args *UploadStickerFileConfig,
+) (*File, error) {
+	var res File
+	if err := api.Do(ctx, args, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
@@ -0,0 +1,124 @@
+package tgapi
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"runtime"
+	"runtime/debug"
+	"time"
+)
+
+// RequestStats describes a finished API method call.
+type RequestStats struct {
+	Method   string
+	Duration time.Duration
+	// ErrorCode is the code of the API Error. It is 0 on success and on transport errors.
+	ErrorCode int
+	Err       error
+	// Retries is the number of the previous attempts of the request, 0 for a single call.
+	// The callers retrying the requests set it with WithRetries, e.g. the broadcaster.
+	Retries int
+}
+
+// UpdateStats describes a handled update.
+type UpdateStats struct {
+	Kind UpdateKind
+	// Handler is the name of the CallTree node that handled the update,
+	// or the type of the Handler.
+	Handler  string
+	Duration time.Duration
+	// Panic is the value recovered from the handler.
+	Panic interface{}
+}
+
+// Observer is notified about every API method call and every update handled by LongPoller.
+// Both methods return a context, so the observer can propagate its data (e.g. tracing spans)
+// to the request or to the handler, and a callback, which is called when the work is done.
+type Observer interface {
+	ObserveRequest(ctx context.Context, method string) (context.Context, func(RequestStats))
+	ObserveUpdate(ctx context.Context, update *Update) (context.Context, func(UpdateStats))
+}
+
+// NopObserver does nothing.
+type NopObserver struct{}
+
+var _ Observer = NopObserver{}
+
+func (NopObserver) ObserveRequest(ctx context.Context, _ string) (context.Context, func(RequestStats)) {
+	return ctx, func(RequestStats) {}
+}
+
+func (NopObserver) ObserveUpdate(ctx context.Context, _ *Update) (context.Context, func(UpdateStats)) {
+	return ctx, func(UpdateStats) {}
+}
+
+type retriesKey struct{}
+
+// WithRetries marks the requests made with the context as the retries of a failed request,
+// retries is the number of the previous attempts. The number is reported in RequestStats.Retries.
+func WithRetries(ctx context.Context, retries int) context.Context {
+	return context.WithValue(ctx, retriesKey{}, retries)
+}
+
+// RequestRetries returns the number of the previous attempts set with WithRetries.
+func RequestRetries(ctx context.Context) int {
+	retries, _ := ctx.Value(retriesKey{}).(int)
+	return retries
+}
+
+type handlerNameKey struct{}
+
+// setHandlerName reports the name of the handler to the observer, if any.
+func setHandlerName(ctx context.Context, name string) {
+	if ptr, ok := ctx.Value(handlerNameKey{}).(*string); ok {
+		*ptr = name
+	}
+}
+
+func funcName(fn interface{}) string {
+	value := reflect.ValueOf(fn)
+	if value.Kind() != reflect.Func || value.IsNil() {
+		return ""
+	}
+	if f := runtime.FuncForPC(value.Pointer()); f != nil {
+		return f.Name()
+	}
+	return fmt.Sprintf("%T", fn)
+}
+
+// observeUpdate calls the handler and notifies the observer.
+// The panic of the handler is recovered and returned as *PanicError.
+func observeUpdate(ctx context.Context, observer Observer, handler Handler, update *Update) (err error) {
+	ctx, done := observer.ObserveUpdate(ctx, update)
+	stats := UpdateStats{
+		Kind:    update.Kind(),
+		Handler: fmt.Sprintf("%T", handler),
+	}
+	ctx = context.WithValue(ctx, handlerNameKey{}, &stats.Handler)
+	start := time.Now()
+	defer func() {
+		stats.Duration = time.Since(start)
+		if r := recover(); r != nil {
+			stats.Panic = r
+			err = &PanicError{UpdateID: update.UpdateID, Value: r, Stack: debug.Stack()}
+		}
+		done(stats)
+	}()
+
+	handler.HandleUpdate(ctx, update)
+	return nil
+}
+
+// PanicError is reported to the error listener of LongPoller when a handler panics.
+type PanicError struct {
+	UpdateID int64
+	// Value is the value passed to panic.
+	Value interface{}
+	// Stack is the stack trace of the handler goroutine.
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("handler panic on update %d: %v", e.UpdateID, e.Value)
+}
@@ -0,0 +1,147 @@
+package tgapi
+
+import (
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/Feresey/tgbotapi/internal/atomicfile"
+)
+
+// OffsetStore keeps the offset of the next update between the restarts of the bot.
+type OffsetStore interface {
+	// Load returns the saved offset or 0 if there is none.
+	Load() (int64, error)
+	Save(offset int64) error
+}
+
+// FileOffsetStore stores the offset in the text file with the path.
+type FileOffsetStore string
+
+var _ OffsetStore = FileOffsetStore("")
+
+// Load returns 0 if the file does not exist.
+func (f FileOffsetStore) Load() (int64, error) {
+	data, err := ioutil.ReadFile(string(f))
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+}
+
+// Save replaces the file atomically.
+func (f FileOffsetStore) Save(offset int64) error {
+	return atomicfile.WriteFile(string(f), []byte(strconv.FormatInt(offset, 10)+"\n"))
+}
+
+// offsetTracker tracks the dispatched updates and commits the offset to the store.
+type offsetTracker struct {
+	store       OffsetStore
+	atLeastOnce bool
+	onError     ErrorCallback
+
+	// saveMu serializes the commits, so the offsets are saved in order without holding mu.
+	saveMu sync.Mutex
+	saved  int64
+
+	mu sync.Mutex
+	// next is the offset after the last dispatched update.
+	next int64
+	// inFlight are the IDs of the updates whose handlers are running.
+	inFlight map[int64]struct{}
+	// finished is signaled when a handler is done.
+	finished chan struct{}
+}
+
+func newOffsetTracker(offset int64, opts pollerOptions) *offsetTracker {
+	return &offsetTracker{
+		store:       opts.offsetStore,
+		atLeastOnce: opts.atLeastOnce,
+		onError:     opts.listenErrorCallback,
+		next:        offset,
+		saved:       offset,
+		inFlight:    make(map[int64]struct{}),
+		finished:    make(chan struct{}, 1),
+	}
+}
+
+// dispatch reports whether the update is new and marks it as in flight.
+func (t *offsetTracker) dispatch(updateID int64) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if updateID < t.next {
+		return false
+	}
+	t.next = updateID + 1
+	if t.atLeastOnce {
+		t.inFlight[updateID] = struct{}{}
+	}
+	return true
+}
+
+// finish marks the update as handled and commits the offset in the at-least-once mode.
+func (t *offsetTracker) finish(updateID int64) {
+	if t.atLeastOnce {
+		t.mu.Lock()
+		delete(t.inFlight, updateID)
+		t.mu.Unlock()
+		t.commit()
+	}
+
+	select {
+	case t.finished <- struct{}{}:
+	default:
+	}
+}
+
+// committed returns the offset which is safe to confirm:
+// the lowest in-flight update or the next one if all of them are handled.
+func (t *offsetTracker) committed() int64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.committedLocked()
+}
+
+func (t *offsetTracker) committedLocked() int64 {
+	offset := t.next
+	for id := range t.inFlight {
+		if id < offset {
+			offset = id
+		}
+	}
+	return offset
+}
+
+// save commits the offset after the dispatched updates.
+func (t *offsetTracker) save() {
+	t.commit()
+}
+
+// commit saves the committed offset to the store. The store is called without holding mu,
+// so the slow store does not block the dispatching of the updates.
+func (t *offsetTracker) commit() {
+	if t.store == nil {
+		return
+	}
+
+	t.saveMu.Lock()
+	offset := t.committed()
+	if offset <= t.saved {
+		t.saveMu.Unlock()
+		return
+	}
+	err := t.store.Save(offset)
+	if err == nil {
+		t.saved = offset
+	}
+	t.saveMu.Unlock()
+
+	if err != nil && t.onError != nil {
+		t.onError(err)
+	}
+}
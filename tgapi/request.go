@@ -0,0 +1,145 @@
+package tgapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/url"
+	"sort"
+)
+
+// Request is the call of an API method with its arguments.
+// The generated *Config types implement it, the methods missing in the generated code
+// can be called with API.Do and a custom implementation.
+type Request interface {
+	// Method returns the name of the API method, e.g. "sendMessage".
+	Method() string
+	// Files returns the files of the request by the argument names.
+	// The request is sent as multipart/form-data if any of the files has a Reader.
+	Files() map[string]*InputFile
+}
+
+// urlEncoder is implemented by the generated configs of the methods uploading files.
+type urlEncoder interface {
+	EncodeURL() (url.Values, error)
+}
+
+// Do calls the API method of the request and decodes the result into result, if it is not nil.
+// The request is encoded to JSON, or to multipart/form-data if it uploads files.
+func (api *API) Do(ctx context.Context, req Request, result interface{}) error {
+	method := req.Method()
+
+	var (
+		resp *Response
+		err  error
+	)
+	if files := req.Files(); hasUploads(files) {
+		if err := api.validateRequest(method, req); err != nil {
+			return err
+		}
+		var values url.Values
+		if values, err = encodeValues(req, files); err != nil {
+			return err
+		}
+		resp, err = api.uploadFiles(ctx, method, values, files)
+	} else {
+		resp, err = api.MakeRequest(ctx, method, req)
+	}
+	if err != nil {
+		return err
+	}
+
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(resp.Result, result)
+}
+
+func hasUploads(files map[string]*InputFile) bool {
+	for _, file := range files {
+		if file.Reader != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// encodeValues encodes the arguments of the request except the uploaded files.
+// The files sent by FileID or URL are added as strings.
+func encodeValues(req Request, files map[string]*InputFile) (url.Values, error) {
+	var values url.Values
+	if encoder, ok := req.(urlEncoder); ok {
+		var err error
+		if values, err = encoder.EncodeURL(); err != nil {
+			return nil, err
+		}
+	} else {
+		raw, err := json.Marshal(req)
+		if err != nil {
+			return nil, err
+		}
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			return nil, err
+		}
+		values = make(url.Values, len(fields))
+		for name, field := range fields {
+			if _, ok := files[name]; ok {
+				continue
+			}
+			// strings are sent as is, other values are sent as JSON.
+			var s string
+			if err := json.Unmarshal(field, &s); err == nil {
+				values.Set(name, s)
+			} else {
+				values.Set(name, string(field))
+			}
+		}
+	}
+
+	for name, file := range files {
+		if file.Reader == nil && file.String() != "" {
+			values.Set(name, file.String())
+		}
+	}
+	return values, nil
+}
+
+// uploadFiles sends the files with Reader in the multipart body, the values are sent in the query.
+func (api *API) uploadFiles(
+	ctx context.Context,
+	method string,
+	values url.Values,
+	files map[string]*InputFile,
+) (*Response, error) {
+	names := make([]string, 0, len(files))
+	for name, file := range files {
+		if file.Reader != nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	b := new(bytes.Buffer)
+	w := multipart.NewWriter(b)
+	defer w.Close()
+
+	for _, name := range names {
+		file := files[name]
+		wr, err := w.CreateFormFile(name, file.Name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.Copy(wr, file.Reader); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return api.do(ctx, method, w.FormDataContentType(), values, b.Bytes())
+}
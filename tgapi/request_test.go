@@ -0,0 +1,148 @@
+package tgapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// setMyNameRequest is a method which is not in the generated code.
+type setMyNameRequest struct {
+	Name         string     `json:"name,omitempty"`
+	LanguageCode string     `json:"language_code,omitempty"`
+	Sticker      *InputFile `json:"sticker,omitempty"`
+	Options      []int64    `json:"options,omitempty"`
+}
+
+func (r *setMyNameRequest) Method() string { return "setMyName" }
+
+func (r *setMyNameRequest) Files() map[string]*InputFile {
+	if r.Sticker == nil {
+		return nil
+	}
+	return map[string]*InputFile{"sticker": r.Sticker}
+}
+
+type recordedRequest struct {
+	path        string
+	query       string
+	contentType string
+	body        string
+}
+
+func newRequestServer(t *testing.T, result string) (*API, *recordedRequest, func()) {
+	var got recordedRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		got = recordedRequest{
+			path:        r.URL.Path,
+			query:       r.URL.RawQuery,
+			contentType: r.Header.Get("Content-Type"),
+			body:        string(body),
+		}
+		_, _ = w.Write([]byte(`{"ok":true,"result":` + result + `}`))
+	}))
+	api := NewWithEndpointAndClient("TOKEN", server.URL, server.URL, server.Client())
+	return api, &got, server.Close
+}
+
+func TestDoJSON(t *testing.T) {
+	api, got, stop := newRequestServer(t, "true")
+	defer stop()
+
+	var res bool
+	require.NoError(t, api.Do(context.Background(), &setMyNameRequest{Name: "bot"}, &res))
+	require.True(t, res)
+	require.Equal(t, "/botTOKEN/setMyName", got.path)
+	require.Equal(t, "application/json", got.contentType)
+	require.JSONEq(t, `{"name":"bot"}`, got.body)
+}
+
+func TestDoUpload(t *testing.T) {
+	api, got, stop := newRequestServer(t, "true")
+	defer stop()
+
+	req := &setMyNameRequest{
+		Name:    "bot",
+		Options: []int64{1, 2},
+		Sticker: &InputFile{Name: "sticker.webp", Reader: strings.NewReader("image")},
+	}
+	require.NoError(t, api.Do(context.Background(), req, nil))
+	require.Equal(t, "name=bot&options=%5B1%2C2%5D", got.query)
+	require.True(t, strings.HasPrefix(got.contentType, "multipart/form-data"))
+	require.Contains(t, got.body, `name="sticker"; filename="sticker.webp"`)
+	require.Contains(t, got.body, "image")
+}
+
+func TestDoUploadError(t *testing.T) {
+	api := NewWithEndpointAndClient("TOKEN", "http://api.invalid", "", &http.Client{
+		Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+			return nil, errors.New("connection reset")
+		}),
+	})
+
+	var msg Message
+	req := &SendDocumentConfig{
+		ChatID:   IntStr{Int: 2},
+		Document: InputFile{Name: "doc.txt", Reader: strings.NewReader("text")},
+	}
+	err := api.Do(context.Background(), req, &msg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "connection reset")
+	require.Error(t, api.Do(context.Background(), req, nil))
+}
+
+func TestDoGeneratedConfig(t *testing.T) {
+	api, got, stop := newRequestServer(t, `{"message_id":1,"date":0,"chat":{"id":2,"type":"private"}}`)
+	defer stop()
+
+	var msg Message
+	req := &SendDocumentConfig{
+		ChatID:    IntStr{Int: 2},
+		Document:  InputFile{Name: "doc.txt", Reader: strings.NewReader("text")},
+		Thumbnail: &InputFile{FileID: "thumb"},
+	}
+	require.Equal(t, "sendDocument", req.Method())
+	require.NoError(t, api.Do(context.Background(), req, &msg))
+	require.Equal(t, int64(1), msg.MessageID)
+	require.Equal(t, "/botTOKEN/sendDocument", got.path)
+	require.Contains(t, got.query, "thumbnail=thumb")
+	require.Contains(t, got.body, `name="document"; filename="doc.txt"`)
+	require.NotContains(t, got.body, `name="thumbnail"`)
+
+	raw, err := json.Marshal(req)
+	require.NoError(t, err)
+	require.Contains(t, string(raw), `"thumbnail":"thumb"`)
+}
+
+func TestUploadFileField(t *testing.T) {
+	api, got, stop := newRequestServer(t, `{"file_id":"id","file_unique_id":"uid"}`)
+	defer stop()
+
+	sticker := NewUploadStickerFileConfig(
+		InputFile{Name: "sticker.webp", Reader: strings.NewReader("image")}, StickerFormatStatic, 1)
+	_, err := api.UploadStickerFile(context.Background(), sticker)
+	require.NoError(t, err)
+	require.Equal(t, "/botTOKEN/uploadStickerFile", got.path)
+	require.Contains(t, got.body, `name="sticker"; filename="sticker.webp"`)
+
+	photo := InputFile{Name: "photo.jpg", Reader: strings.NewReader("image")}
+	require.NoError(t, api.SetChatPhoto(context.Background(), IntStr{Int: 2}, photo))
+	require.Equal(t, "/botTOKEN/setChatPhoto", got.path)
+	require.Contains(t, got.body, `name="photo"; filename="photo.jpg"`)
+}
+
+func TestFakeAPIDo(t *testing.T) {
+	fake := &FakeAPI{}
+	req := &setMyNameRequest{Name: "bot"}
+	require.NoError(t, fake.Do(context.Background(), req, nil))
+	require.Equal(t, []FakeCall{{Method: "setMyName", Args: []interface{}{req}}}, fake.Calls())
+}
@@ -41,6 +41,37 @@ type Animation struct {
 	Thumbnail *PhotoSize `json:"thumbnail,omitempty"`
 }
 
+// NewAnimation creates Animation with the required fields.
+func NewAnimation(duration int64, fileID string, fileUniqueID string, height int64, width int64) *Animation {
+	return &Animation{
+		Duration:     duration,
+		FileID:       fileID,
+		FileUniqueID: fileUniqueID,
+		Height:       height,
+		Width:        width,
+	}
+}
+
+func (t *Animation) SetFileName(fileName string) *Animation {
+	t.FileName = &fileName
+	return t
+}
+
+func (t *Animation) SetFileSize(fileSize int64) *Animation {
+	t.FileSize = &fileSize
+	return t
+}
+
+func (t *Animation) SetMimeType(mimeType string) *Animation {
+	t.MimeType = &mimeType
+	return t
+}
+
+func (t *Animation) SetThumbnail(thumbnail PhotoSize) *Animation {
+	t.Thumbnail = &thumbnail
+	return t
+}
+
 func (t *Animation) GetDuration() int64 {
 	var res int64
 	if t == nil {
@@ -121,6 +152,35 @@ func (t *Animation) GetWidth() int64 {
 	return t.Width
 }
 
+// Clone returns a deep copy of t.
+func (t *Animation) Clone() *Animation {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.FileName = cloneStringPtr(t.FileName)
+	res.FileSize = cloneInt64Ptr(t.FileSize)
+	res.MimeType = cloneStringPtr(t.MimeType)
+	res.Thumbnail = t.Thumbnail.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *Animation) Equal(other *Animation) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Duration == other.Duration &&
+		t.FileID == other.FileID &&
+		equalStringPtr(t.FileName, other.FileName) &&
+		equalInt64Ptr(t.FileSize, other.FileSize) &&
+		t.FileUniqueID == other.FileUniqueID &&
+		t.Height == other.Height &&
+		equalStringPtr(t.MimeType, other.MimeType) &&
+		t.Thumbnail.Equal(other.Thumbnail) &&
+		t.Width == other.Width
+}
+
 // Audio
 // This object represents an audio file to be treated as music by the Telegram clients.
 type Audio struct {
@@ -156,6 +216,45 @@ type Audio struct {
 	Title *string `json:"title,omitempty"`
 }
 
+// NewAudio creates Audio with the required fields.
+func NewAudio(duration int64, fileID string, fileUniqueID string) *Audio {
+	return &Audio{
+		Duration:     duration,
+		FileID:       fileID,
+		FileUniqueID: fileUniqueID,
+	}
+}
+
+func (t *Audio) SetFileName(fileName string) *Audio {
+	t.FileName = &fileName
+	return t
+}
+
+func (t *Audio) SetFileSize(fileSize int64) *Audio {
+	t.FileSize = &fileSize
+	return t
+}
+
+func (t *Audio) SetMimeType(mimeType string) *Audio {
+	t.MimeType = &mimeType
+	return t
+}
+
+func (t *Audio) SetPerformer(performer string) *Audio {
+	t.Performer = &performer
+	return t
+}
+
+func (t *Audio) SetThumbnail(thumbnail PhotoSize) *Audio {
+	t.Thumbnail = &thumbnail
+	return t
+}
+
+func (t *Audio) SetTitle(title string) *Audio {
+	t.Title = &title
+	return t
+}
+
 func (t *Audio) GetDuration() int64 {
 	var res int64
 	if t == nil {
@@ -242,6 +341,37 @@ func (t *Audio) GetTitle() string {
 	return res
 }
 
+// Clone returns a deep copy of t.
+func (t *Audio) Clone() *Audio {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.FileName = cloneStringPtr(t.FileName)
+	res.FileSize = cloneInt64Ptr(t.FileSize)
+	res.MimeType = cloneStringPtr(t.MimeType)
+	res.Performer = cloneStringPtr(t.Performer)
+	res.Thumbnail = t.Thumbnail.Clone()
+	res.Title = cloneStringPtr(t.Title)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *Audio) Equal(other *Audio) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Duration == other.Duration &&
+		t.FileID == other.FileID &&
+		equalStringPtr(t.FileName, other.FileName) &&
+		equalInt64Ptr(t.FileSize, other.FileSize) &&
+		t.FileUniqueID == other.FileUniqueID &&
+		equalStringPtr(t.MimeType, other.MimeType) &&
+		equalStringPtr(t.Performer, other.Performer) &&
+		t.Thumbnail.Equal(other.Thumbnail) &&
+		equalStringPtr(t.Title, other.Title)
+}
+
 // BotCommand
 // This object represents a bot command.
 type BotCommand struct {
@@ -254,6 +384,14 @@ type BotCommand struct {
 	Description string `json:"description"`
 }
 
+// NewBotCommand creates BotCommand with the required fields.
+func NewBotCommand(command string, description string) *BotCommand {
+	return &BotCommand{
+		Command:     command,
+		Description: description,
+	}
+}
+
 func (t *BotCommand) GetCommand() string {
 	var res string
 	if t == nil {
@@ -270,6 +408,24 @@ func (t *BotCommand) GetDescription() string {
 	return t.Description
 }
 
+// Clone returns a deep copy of t.
+func (t *BotCommand) Clone() *BotCommand {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *BotCommand) Equal(other *BotCommand) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Command == other.Command &&
+		t.Description == other.Description
+}
+
 // BotCommandScope
 // This object represents the scope to which bot commands are applied. Currently, the following 7
 // scopes are supported:
@@ -279,6 +435,13 @@ type BotCommandScope struct {
 	Type BotType `json:"type"`
 }
 
+// NewBotCommandScope creates BotCommandScope with the required fields.
+func NewBotCommandScope() *BotCommandScope {
+	return &BotCommandScope{
+		Type: BotTypeDefault,
+	}
+}
+
 func (t *BotCommandScope) GetType() *BotType {
 	if t == nil {
 		return nil
@@ -286,6 +449,23 @@ func (t *BotCommandScope) GetType() *BotType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *BotCommandScope) Clone() *BotCommandScope {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *BotCommandScope) Equal(other *BotCommandScope) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Type == other.Type
+}
+
 // BotCommandScopeAllChatAdministrators
 // Represents the scope of bot commands, covering all group and supergroup chat administrators.
 type BotCommandScopeAllChatAdministrators struct {
@@ -294,6 +474,13 @@ type BotCommandScopeAllChatAdministrators struct {
 	Type BotType `json:"type"`
 }
 
+// NewBotCommandScopeAllChatAdministrators creates BotCommandScopeAllChatAdministrators with the required fields.
+func NewBotCommandScopeAllChatAdministrators() *BotCommandScopeAllChatAdministrators {
+	return &BotCommandScopeAllChatAdministrators{
+		Type: BotTypeAllChatAdministrators,
+	}
+}
+
 func (t *BotCommandScopeAllChatAdministrators) GetType() *BotType {
 	if t == nil {
 		return nil
@@ -301,6 +488,23 @@ func (t *BotCommandScopeAllChatAdministrators) GetType() *BotType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *BotCommandScopeAllChatAdministrators) Clone() *BotCommandScopeAllChatAdministrators {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *BotCommandScopeAllChatAdministrators) Equal(other *BotCommandScopeAllChatAdministrators) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Type == other.Type
+}
+
 // BotCommandScopeAllGroupChats
 // Represents the scope of bot commands, covering all group and supergroup chats.
 type BotCommandScopeAllGroupChats struct {
@@ -309,6 +513,13 @@ type BotCommandScopeAllGroupChats struct {
 	Type BotType `json:"type"`
 }
 
+// NewBotCommandScopeAllGroupChats creates BotCommandScopeAllGroupChats with the required fields.
+func NewBotCommandScopeAllGroupChats() *BotCommandScopeAllGroupChats {
+	return &BotCommandScopeAllGroupChats{
+		Type: BotTypeAllGroupChats,
+	}
+}
+
 func (t *BotCommandScopeAllGroupChats) GetType() *BotType {
 	if t == nil {
 		return nil
@@ -316,6 +527,23 @@ func (t *BotCommandScopeAllGroupChats) GetType() *BotType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *BotCommandScopeAllGroupChats) Clone() *BotCommandScopeAllGroupChats {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *BotCommandScopeAllGroupChats) Equal(other *BotCommandScopeAllGroupChats) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Type == other.Type
+}
+
 // BotCommandScopeAllPrivateChats
 // Represents the scope of bot commands, covering all private chats.
 type BotCommandScopeAllPrivateChats struct {
@@ -324,6 +552,13 @@ type BotCommandScopeAllPrivateChats struct {
 	Type BotType `json:"type"`
 }
 
+// NewBotCommandScopeAllPrivateChats creates BotCommandScopeAllPrivateChats with the required fields.
+func NewBotCommandScopeAllPrivateChats() *BotCommandScopeAllPrivateChats {
+	return &BotCommandScopeAllPrivateChats{
+		Type: BotTypeAllPrivateChats,
+	}
+}
+
 func (t *BotCommandScopeAllPrivateChats) GetType() *BotType {
 	if t == nil {
 		return nil
@@ -331,6 +566,23 @@ func (t *BotCommandScopeAllPrivateChats) GetType() *BotType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *BotCommandScopeAllPrivateChats) Clone() *BotCommandScopeAllPrivateChats {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *BotCommandScopeAllPrivateChats) Equal(other *BotCommandScopeAllPrivateChats) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Type == other.Type
+}
+
 // BotCommandScopeChat
 // Represents the scope of bot commands, covering a specific chat.
 type BotCommandScopeChat struct {
@@ -343,6 +595,14 @@ type BotCommandScopeChat struct {
 	Type BotType `json:"type"`
 }
 
+// NewBotCommandScopeChat creates BotCommandScopeChat with the required fields.
+func NewBotCommandScopeChat(chatID IntStr) *BotCommandScopeChat {
+	return &BotCommandScopeChat{
+		ChatID: chatID,
+		Type:   BotTypeChat,
+	}
+}
+
 func (t *BotCommandScopeChat) GetChatID() IntStr {
 	var res IntStr
 	if t == nil {
@@ -358,6 +618,24 @@ func (t *BotCommandScopeChat) GetType() *BotType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *BotCommandScopeChat) Clone() *BotCommandScopeChat {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *BotCommandScopeChat) Equal(other *BotCommandScopeChat) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.ChatID == other.ChatID &&
+		t.Type == other.Type
+}
+
 // BotCommandScopeChatAdministrators
 // Represents the scope of bot commands, covering all administrators of a specific group or
 // supergroup chat.
@@ -371,6 +649,14 @@ type BotCommandScopeChatAdministrators struct {
 	Type BotType `json:"type"`
 }
 
+// NewBotCommandScopeChatAdministrators creates BotCommandScopeChatAdministrators with the required fields.
+func NewBotCommandScopeChatAdministrators(chatID IntStr) *BotCommandScopeChatAdministrators {
+	return &BotCommandScopeChatAdministrators{
+		ChatID: chatID,
+		Type:   BotTypeChatAdministrators,
+	}
+}
+
 func (t *BotCommandScopeChatAdministrators) GetChatID() IntStr {
 	var res IntStr
 	if t == nil {
@@ -386,6 +672,24 @@ func (t *BotCommandScopeChatAdministrators) GetType() *BotType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *BotCommandScopeChatAdministrators) Clone() *BotCommandScopeChatAdministrators {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *BotCommandScopeChatAdministrators) Equal(other *BotCommandScopeChatAdministrators) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.ChatID == other.ChatID &&
+		t.Type == other.Type
+}
+
 // BotCommandScopeChatMember
 // Represents the scope of bot commands, covering a specific member of a group or supergroup chat.
 type BotCommandScopeChatMember struct {
@@ -401,6 +705,15 @@ type BotCommandScopeChatMember struct {
 	UserID int64 `json:"user_id"`
 }
 
+// NewBotCommandScopeChatMember creates BotCommandScopeChatMember with the required fields.
+func NewBotCommandScopeChatMember(chatID IntStr, userID int64) *BotCommandScopeChatMember {
+	return &BotCommandScopeChatMember{
+		ChatID: chatID,
+		Type:   BotTypeChatMember,
+		UserID: userID,
+	}
+}
+
 func (t *BotCommandScopeChatMember) GetChatID() IntStr {
 	var res IntStr
 	if t == nil {
@@ -424,6 +737,25 @@ func (t *BotCommandScopeChatMember) GetUserID() int64 {
 	return t.UserID
 }
 
+// Clone returns a deep copy of t.
+func (t *BotCommandScopeChatMember) Clone() *BotCommandScopeChatMember {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *BotCommandScopeChatMember) Equal(other *BotCommandScopeChatMember) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.ChatID == other.ChatID &&
+		t.Type == other.Type &&
+		t.UserID == other.UserID
+}
+
 // BotCommandScopeDefault
 // Represents the default scope of bot commands. Default commands are used if no commands with a
 // narrower scope are specified for the user.
@@ -433,6 +765,13 @@ type BotCommandScopeDefault struct {
 	Type BotType `json:"type"`
 }
 
+// NewBotCommandScopeDefault creates BotCommandScopeDefault with the required fields.
+func NewBotCommandScopeDefault() *BotCommandScopeDefault {
+	return &BotCommandScopeDefault{
+		Type: BotTypeDefault,
+	}
+}
+
 func (t *BotCommandScopeDefault) GetType() *BotType {
 	if t == nil {
 		return nil
@@ -440,6 +779,23 @@ func (t *BotCommandScopeDefault) GetType() *BotType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *BotCommandScopeDefault) Clone() *BotCommandScopeDefault {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *BotCommandScopeDefault) Equal(other *BotCommandScopeDefault) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Type == other.Type
+}
+
 // BotDescription
 // This object represents the bot's description.
 type BotDescription struct {
@@ -448,6 +804,13 @@ type BotDescription struct {
 	Description string `json:"description"`
 }
 
+// NewBotDescription creates BotDescription with the required fields.
+func NewBotDescription(description string) *BotDescription {
+	return &BotDescription{
+		Description: description,
+	}
+}
+
 func (t *BotDescription) GetDescription() string {
 	var res string
 	if t == nil {
@@ -456,6 +819,23 @@ func (t *BotDescription) GetDescription() string {
 	return t.Description
 }
 
+// Clone returns a deep copy of t.
+func (t *BotDescription) Clone() *BotDescription {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *BotDescription) Equal(other *BotDescription) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Description == other.Description
+}
+
 // BotName
 // This object represents the bot's name.
 type BotName struct {
@@ -464,6 +844,13 @@ type BotName struct {
 	Name string `json:"name"`
 }
 
+// NewBotName creates BotName with the required fields.
+func NewBotName(name string) *BotName {
+	return &BotName{
+		Name: name,
+	}
+}
+
 func (t *BotName) GetName() string {
 	var res string
 	if t == nil {
@@ -472,6 +859,23 @@ func (t *BotName) GetName() string {
 	return t.Name
 }
 
+// Clone returns a deep copy of t.
+func (t *BotName) Clone() *BotName {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *BotName) Equal(other *BotName) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Name == other.Name
+}
+
 // BotShortDescription
 // This object represents the bot's short description.
 type BotShortDescription struct {
@@ -480,6 +884,13 @@ type BotShortDescription struct {
 	ShortDescription string `json:"short_description"`
 }
 
+// NewBotShortDescription creates BotShortDescription with the required fields.
+func NewBotShortDescription(shortDescription string) *BotShortDescription {
+	return &BotShortDescription{
+		ShortDescription: shortDescription,
+	}
+}
+
 func (t *BotShortDescription) GetShortDescription() string {
 	var res string
 	if t == nil {
@@ -488,6 +899,23 @@ func (t *BotShortDescription) GetShortDescription() string {
 	return t.ShortDescription
 }
 
+// Clone returns a deep copy of t.
+func (t *BotShortDescription) Clone() *BotShortDescription {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *BotShortDescription) Equal(other *BotShortDescription) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.ShortDescription == other.ShortDescription
+}
+
 // CallbackGame
 // A placeholder, currently holds no information. Use BotFather to set up your game.
 type CallbackGame struct {
@@ -516,6 +944,39 @@ type CallbackGame struct {
 	MessageID *int64 `json:"message_id,omitempty"`
 }
 
+// NewCallbackGame creates CallbackGame with the required fields.
+func NewCallbackGame(score int64, userID int64) *CallbackGame {
+	return &CallbackGame{
+		Score:  score,
+		UserID: userID,
+	}
+}
+
+func (t *CallbackGame) SetChatID(chatID int64) *CallbackGame {
+	t.ChatID = &chatID
+	return t
+}
+
+func (t *CallbackGame) SetDisableEditMessage(disableEditMessage bool) *CallbackGame {
+	t.DisableEditMessage = &disableEditMessage
+	return t
+}
+
+func (t *CallbackGame) SetForce(force bool) *CallbackGame {
+	t.Force = &force
+	return t
+}
+
+func (t *CallbackGame) SetInlineMessageID(inlineMessageID string) *CallbackGame {
+	t.InlineMessageID = &inlineMessageID
+	return t
+}
+
+func (t *CallbackGame) SetMessageID(messageID int64) *CallbackGame {
+	t.MessageID = &messageID
+	return t
+}
+
 func (t *CallbackGame) GetChatID() int64 {
 	var res int64
 	if t == nil {
@@ -587,6 +1048,34 @@ func (t *CallbackGame) GetUserID() int64 {
 	return t.UserID
 }
 
+// Clone returns a deep copy of t.
+func (t *CallbackGame) Clone() *CallbackGame {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.ChatID = cloneInt64Ptr(t.ChatID)
+	res.DisableEditMessage = cloneBoolPtr(t.DisableEditMessage)
+	res.Force = cloneBoolPtr(t.Force)
+	res.InlineMessageID = cloneStringPtr(t.InlineMessageID)
+	res.MessageID = cloneInt64Ptr(t.MessageID)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *CallbackGame) Equal(other *CallbackGame) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalInt64Ptr(t.ChatID, other.ChatID) &&
+		equalBoolPtr(t.DisableEditMessage, other.DisableEditMessage) &&
+		equalBoolPtr(t.Force, other.Force) &&
+		equalStringPtr(t.InlineMessageID, other.InlineMessageID) &&
+		equalInt64Ptr(t.MessageID, other.MessageID) &&
+		t.Score == other.Score &&
+		t.UserID == other.UserID
+}
+
 // CallbackQuery
 // This object represents an incoming callback query from a callback button in an inline keyboard.
 // If the button that originated the query was attached to a message sent by the bot, the field
@@ -620,6 +1109,35 @@ type CallbackQuery struct {
 	Message *Message `json:"message,omitempty"`
 }
 
+// NewCallbackQuery creates CallbackQuery with the required fields.
+func NewCallbackQuery(chatInstance string, from User, id string) *CallbackQuery {
+	return &CallbackQuery{
+		ChatInstance: chatInstance,
+		From:         from,
+		ID:           id,
+	}
+}
+
+func (t *CallbackQuery) SetData(data string) *CallbackQuery {
+	t.Data = &data
+	return t
+}
+
+func (t *CallbackQuery) SetGameShortName(gameShortName string) *CallbackQuery {
+	t.GameShortName = &gameShortName
+	return t
+}
+
+func (t *CallbackQuery) SetInlineMessageID(inlineMessageID string) *CallbackQuery {
+	t.InlineMessageID = &inlineMessageID
+	return t
+}
+
+func (t *CallbackQuery) SetMessage(message Message) *CallbackQuery {
+	t.Message = &message
+	return t
+}
+
 func (t *CallbackQuery) GetChatInstance() string {
 	var res string
 	if t == nil {
@@ -683,6 +1201,34 @@ func (t *CallbackQuery) GetMessage() *Message {
 	return t.Message
 }
 
+// Clone returns a deep copy of t.
+func (t *CallbackQuery) Clone() *CallbackQuery {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Data = cloneStringPtr(t.Data)
+	res.From = *t.From.Clone()
+	res.GameShortName = cloneStringPtr(t.GameShortName)
+	res.InlineMessageID = cloneStringPtr(t.InlineMessageID)
+	res.Message = t.Message.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *CallbackQuery) Equal(other *CallbackQuery) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.ChatInstance == other.ChatInstance &&
+		equalStringPtr(t.Data, other.Data) &&
+		t.From.Equal(&other.From) &&
+		equalStringPtr(t.GameShortName, other.GameShortName) &&
+		t.ID == other.ID &&
+		equalStringPtr(t.InlineMessageID, other.InlineMessageID) &&
+		t.Message.Equal(other.Message)
+}
+
 // Chat
 // This object represents a chat.
 type Chat struct {
@@ -790,59 +1336,197 @@ type Chat struct {
 	Username *string `json:"username,omitempty"`
 }
 
-func (t *Chat) GetBio() string {
-	var res string
-	if t == nil {
-		return res
-	}
-	if field := t.Bio; field != nil {
-		return *field
+// NewChat creates Chat with the required fields.
+func NewChat(id int64, typ ChatType) *Chat {
+	return &Chat{
+		ID:   id,
+		Type: typ,
 	}
-	return res
 }
 
-func (t *Chat) GetCanSetStickerSet() *True {
-	if t == nil {
-		return nil
-	}
-	return t.CanSetStickerSet
+func (t *Chat) SetActiveUsernames(activeUsernames []string) *Chat {
+	t.ActiveUsernames = activeUsernames
+	return t
 }
 
-func (t *Chat) GetDescription() string {
-	var res string
-	if t == nil {
-		return res
-	}
-	if field := t.Description; field != nil {
-		return *field
-	}
-	return res
+func (t *Chat) SetBio(bio string) *Chat {
+	t.Bio = &bio
+	return t
 }
 
-func (t *Chat) GetEmojiStatusCustomEmojiID() string {
-	var res string
-	if t == nil {
-		return res
-	}
-	if field := t.EmojiStatusCustomEmojiID; field != nil {
-		return *field
-	}
-	return res
+func (t *Chat) SetCanSetStickerSet(canSetStickerSet True) *Chat {
+	t.CanSetStickerSet = &canSetStickerSet
+	return t
 }
 
-func (t *Chat) GetFirstName() string {
-	var res string
-	if t == nil {
-		return res
-	}
-	if field := t.FirstName; field != nil {
-		return *field
-	}
-	return res
+func (t *Chat) SetDescription(description string) *Chat {
+	t.Description = &description
+	return t
 }
 
-func (t *Chat) GetHasAggressiveAntiSpamEnabled() *True {
-	if t == nil {
+func (t *Chat) SetEmojiStatusCustomEmojiID(emojiStatusCustomEmojiID string) *Chat {
+	t.EmojiStatusCustomEmojiID = &emojiStatusCustomEmojiID
+	return t
+}
+
+func (t *Chat) SetFirstName(firstName string) *Chat {
+	t.FirstName = &firstName
+	return t
+}
+
+func (t *Chat) SetHasAggressiveAntiSpamEnabled(hasAggressiveAntiSpamEnabled True) *Chat {
+	t.HasAggressiveAntiSpamEnabled = &hasAggressiveAntiSpamEnabled
+	return t
+}
+
+func (t *Chat) SetHasHiddenMembers(hasHiddenMembers True) *Chat {
+	t.HasHiddenMembers = &hasHiddenMembers
+	return t
+}
+
+func (t *Chat) SetHasPrivateForwards(hasPrivateForwards True) *Chat {
+	t.HasPrivateForwards = &hasPrivateForwards
+	return t
+}
+
+func (t *Chat) SetHasProtectedContent(hasProtectedContent True) *Chat {
+	t.HasProtectedContent = &hasProtectedContent
+	return t
+}
+
+func (t *Chat) SetHasRestrictedVoiceAndVideoMessages(hasRestrictedVoiceAndVideoMessages True) *Chat {
+	t.HasRestrictedVoiceAndVideoMessages = &hasRestrictedVoiceAndVideoMessages
+	return t
+}
+
+func (t *Chat) SetInviteLink(inviteLink string) *Chat {
+	t.InviteLink = &inviteLink
+	return t
+}
+
+func (t *Chat) SetIsForum(isForum True) *Chat {
+	t.IsForum = &isForum
+	return t
+}
+
+func (t *Chat) SetJoinByRequest(joinByRequest True) *Chat {
+	t.JoinByRequest = &joinByRequest
+	return t
+}
+
+func (t *Chat) SetJoinToSendMessages(joinToSendMessages True) *Chat {
+	t.JoinToSendMessages = &joinToSendMessages
+	return t
+}
+
+func (t *Chat) SetLastName(lastName string) *Chat {
+	t.LastName = &lastName
+	return t
+}
+
+func (t *Chat) SetLinkedChatID(linkedChatID int64) *Chat {
+	t.LinkedChatID = &linkedChatID
+	return t
+}
+
+func (t *Chat) SetLocation(location ChatLocation) *Chat {
+	t.Location = &location
+	return t
+}
+
+func (t *Chat) SetMessageAutoDeleteTime(messageAutoDeleteTime int64) *Chat {
+	t.MessageAutoDeleteTime = &messageAutoDeleteTime
+	return t
+}
+
+func (t *Chat) SetPermissions(permissions ChatPermissions) *Chat {
+	t.Permissions = &permissions
+	return t
+}
+
+func (t *Chat) SetPhoto(photo ChatPhoto) *Chat {
+	t.Photo = &photo
+	return t
+}
+
+func (t *Chat) SetPinnedMessage(pinnedMessage Message) *Chat {
+	t.PinnedMessage = &pinnedMessage
+	return t
+}
+
+func (t *Chat) SetSlowModeDelay(slowModeDelay int64) *Chat {
+	t.SlowModeDelay = &slowModeDelay
+	return t
+}
+
+func (t *Chat) SetStickerSetName(stickerSetName string) *Chat {
+	t.StickerSetName = &stickerSetName
+	return t
+}
+
+func (t *Chat) SetTitle(title string) *Chat {
+	t.Title = &title
+	return t
+}
+
+func (t *Chat) SetUsername(username string) *Chat {
+	t.Username = &username
+	return t
+}
+
+func (t *Chat) GetBio() string {
+	var res string
+	if t == nil {
+		return res
+	}
+	if field := t.Bio; field != nil {
+		return *field
+	}
+	return res
+}
+
+func (t *Chat) GetCanSetStickerSet() *True {
+	if t == nil {
+		return nil
+	}
+	return t.CanSetStickerSet
+}
+
+func (t *Chat) GetDescription() string {
+	var res string
+	if t == nil {
+		return res
+	}
+	if field := t.Description; field != nil {
+		return *field
+	}
+	return res
+}
+
+func (t *Chat) GetEmojiStatusCustomEmojiID() string {
+	var res string
+	if t == nil {
+		return res
+	}
+	if field := t.EmojiStatusCustomEmojiID; field != nil {
+		return *field
+	}
+	return res
+}
+
+func (t *Chat) GetFirstName() string {
+	var res string
+	if t == nil {
+		return res
+	}
+	if field := t.FirstName; field != nil {
+		return *field
+	}
+	return res
+}
+
+func (t *Chat) GetHasAggressiveAntiSpamEnabled() *True {
+	if t == nil {
 		return nil
 	}
 	return t.HasAggressiveAntiSpamEnabled
@@ -1028,6 +1712,76 @@ func (t *Chat) GetUsername() string {
 	return res
 }
 
+// Clone returns a deep copy of t.
+func (t *Chat) Clone() *Chat {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.ActiveUsernames = cloneStringSlice(t.ActiveUsernames)
+	res.Bio = cloneStringPtr(t.Bio)
+	res.CanSetStickerSet = cloneTruePtr(t.CanSetStickerSet)
+	res.Description = cloneStringPtr(t.Description)
+	res.EmojiStatusCustomEmojiID = cloneStringPtr(t.EmojiStatusCustomEmojiID)
+	res.FirstName = cloneStringPtr(t.FirstName)
+	res.HasAggressiveAntiSpamEnabled = cloneTruePtr(t.HasAggressiveAntiSpamEnabled)
+	res.HasHiddenMembers = cloneTruePtr(t.HasHiddenMembers)
+	res.HasPrivateForwards = cloneTruePtr(t.HasPrivateForwards)
+	res.HasProtectedContent = cloneTruePtr(t.HasProtectedContent)
+	res.HasRestrictedVoiceAndVideoMessages = cloneTruePtr(t.HasRestrictedVoiceAndVideoMessages)
+	res.InviteLink = cloneStringPtr(t.InviteLink)
+	res.IsForum = cloneTruePtr(t.IsForum)
+	res.JoinByRequest = cloneTruePtr(t.JoinByRequest)
+	res.JoinToSendMessages = cloneTruePtr(t.JoinToSendMessages)
+	res.LastName = cloneStringPtr(t.LastName)
+	res.LinkedChatID = cloneInt64Ptr(t.LinkedChatID)
+	res.Location = t.Location.Clone()
+	res.MessageAutoDeleteTime = cloneInt64Ptr(t.MessageAutoDeleteTime)
+	res.Permissions = t.Permissions.Clone()
+	res.Photo = t.Photo.Clone()
+	res.PinnedMessage = t.PinnedMessage.Clone()
+	res.SlowModeDelay = cloneInt64Ptr(t.SlowModeDelay)
+	res.StickerSetName = cloneStringPtr(t.StickerSetName)
+	res.Title = cloneStringPtr(t.Title)
+	res.Username = cloneStringPtr(t.Username)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *Chat) Equal(other *Chat) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringSlice(t.ActiveUsernames, other.ActiveUsernames) &&
+		equalStringPtr(t.Bio, other.Bio) &&
+		equalTruePtr(t.CanSetStickerSet, other.CanSetStickerSet) &&
+		equalStringPtr(t.Description, other.Description) &&
+		equalStringPtr(t.EmojiStatusCustomEmojiID, other.EmojiStatusCustomEmojiID) &&
+		equalStringPtr(t.FirstName, other.FirstName) &&
+		equalTruePtr(t.HasAggressiveAntiSpamEnabled, other.HasAggressiveAntiSpamEnabled) &&
+		equalTruePtr(t.HasHiddenMembers, other.HasHiddenMembers) &&
+		equalTruePtr(t.HasPrivateForwards, other.HasPrivateForwards) &&
+		equalTruePtr(t.HasProtectedContent, other.HasProtectedContent) &&
+		equalTruePtr(t.HasRestrictedVoiceAndVideoMessages, other.HasRestrictedVoiceAndVideoMessages) &&
+		t.ID == other.ID &&
+		equalStringPtr(t.InviteLink, other.InviteLink) &&
+		equalTruePtr(t.IsForum, other.IsForum) &&
+		equalTruePtr(t.JoinByRequest, other.JoinByRequest) &&
+		equalTruePtr(t.JoinToSendMessages, other.JoinToSendMessages) &&
+		equalStringPtr(t.LastName, other.LastName) &&
+		equalInt64Ptr(t.LinkedChatID, other.LinkedChatID) &&
+		t.Location.Equal(other.Location) &&
+		equalInt64Ptr(t.MessageAutoDeleteTime, other.MessageAutoDeleteTime) &&
+		t.Permissions.Equal(other.Permissions) &&
+		t.Photo.Equal(other.Photo) &&
+		t.PinnedMessage.Equal(other.PinnedMessage) &&
+		equalInt64Ptr(t.SlowModeDelay, other.SlowModeDelay) &&
+		equalStringPtr(t.StickerSetName, other.StickerSetName) &&
+		equalStringPtr(t.Title, other.Title) &&
+		t.Type == other.Type &&
+		equalStringPtr(t.Username, other.Username)
+}
+
 // ChatAdministratorRights
 // Represents the rights of an administrator in a chat.
 type ChatAdministratorRights struct {
@@ -1075,6 +1829,40 @@ type ChatAdministratorRights struct {
 	CanPostMessages *bool `json:"can_post_messages,omitempty"`
 }
 
+// NewChatAdministratorRights creates ChatAdministratorRights with the required fields.
+func NewChatAdministratorRights(canChangeInfo bool, canDeleteMessages bool, canInviteUsers bool, canManageChat bool, canManageVideoChats bool, canPromoteMembers bool, canRestrictMembers bool, isAnonymous bool) *ChatAdministratorRights {
+	return &ChatAdministratorRights{
+		CanChangeInfo:       canChangeInfo,
+		CanDeleteMessages:   canDeleteMessages,
+		CanInviteUsers:      canInviteUsers,
+		CanManageChat:       canManageChat,
+		CanManageVideoChats: canManageVideoChats,
+		CanPromoteMembers:   canPromoteMembers,
+		CanRestrictMembers:  canRestrictMembers,
+		IsAnonymous:         isAnonymous,
+	}
+}
+
+func (t *ChatAdministratorRights) SetCanEditMessages(canEditMessages bool) *ChatAdministratorRights {
+	t.CanEditMessages = &canEditMessages
+	return t
+}
+
+func (t *ChatAdministratorRights) SetCanManageTopics(canManageTopics bool) *ChatAdministratorRights {
+	t.CanManageTopics = &canManageTopics
+	return t
+}
+
+func (t *ChatAdministratorRights) SetCanPinMessages(canPinMessages bool) *ChatAdministratorRights {
+	t.CanPinMessages = &canPinMessages
+	return t
+}
+
+func (t *ChatAdministratorRights) SetCanPostMessages(canPostMessages bool) *ChatAdministratorRights {
+	t.CanPostMessages = &canPostMessages
+	return t
+}
+
 func (t *ChatAdministratorRights) GetCanChangeInfo() bool {
 	var res bool
 	if t == nil {
@@ -1183,6 +1971,38 @@ func (t *ChatAdministratorRights) GetIsAnonymous() bool {
 	return t.IsAnonymous
 }
 
+// Clone returns a deep copy of t.
+func (t *ChatAdministratorRights) Clone() *ChatAdministratorRights {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.CanEditMessages = cloneBoolPtr(t.CanEditMessages)
+	res.CanManageTopics = cloneBoolPtr(t.CanManageTopics)
+	res.CanPinMessages = cloneBoolPtr(t.CanPinMessages)
+	res.CanPostMessages = cloneBoolPtr(t.CanPostMessages)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *ChatAdministratorRights) Equal(other *ChatAdministratorRights) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.CanChangeInfo == other.CanChangeInfo &&
+		t.CanDeleteMessages == other.CanDeleteMessages &&
+		equalBoolPtr(t.CanEditMessages, other.CanEditMessages) &&
+		t.CanInviteUsers == other.CanInviteUsers &&
+		t.CanManageChat == other.CanManageChat &&
+		equalBoolPtr(t.CanManageTopics, other.CanManageTopics) &&
+		t.CanManageVideoChats == other.CanManageVideoChats &&
+		equalBoolPtr(t.CanPinMessages, other.CanPinMessages) &&
+		equalBoolPtr(t.CanPostMessages, other.CanPostMessages) &&
+		t.CanPromoteMembers == other.CanPromoteMembers &&
+		t.CanRestrictMembers == other.CanRestrictMembers &&
+		t.IsAnonymous == other.IsAnonymous
+}
+
 // ChatInviteLink
 // Represents an invite link for a chat.
 type ChatInviteLink struct {
@@ -1217,6 +2037,37 @@ type ChatInviteLink struct {
 	PendingJoinRequestCount *int64 `json:"pending_join_request_count,omitempty"`
 }
 
+// NewChatInviteLink creates ChatInviteLink with the required fields.
+func NewChatInviteLink(createsJoinRequest bool, creator User, inviteLink string, isPrimary bool, isRevoked bool) *ChatInviteLink {
+	return &ChatInviteLink{
+		CreatesJoinRequest: createsJoinRequest,
+		Creator:            creator,
+		InviteLink:         inviteLink,
+		IsPrimary:          isPrimary,
+		IsRevoked:          isRevoked,
+	}
+}
+
+func (t *ChatInviteLink) SetExpireDate(expireDate int64) *ChatInviteLink {
+	t.ExpireDate = &expireDate
+	return t
+}
+
+func (t *ChatInviteLink) SetMemberLimit(memberLimit int64) *ChatInviteLink {
+	t.MemberLimit = &memberLimit
+	return t
+}
+
+func (t *ChatInviteLink) SetName(name string) *ChatInviteLink {
+	t.Name = &name
+	return t
+}
+
+func (t *ChatInviteLink) SetPendingJoinRequestCount(pendingJoinRequestCount int64) *ChatInviteLink {
+	t.PendingJoinRequestCount = &pendingJoinRequestCount
+	return t
+}
+
 func (t *ChatInviteLink) GetCreatesJoinRequest() bool {
 	var res bool
 	if t == nil {
@@ -1300,6 +2151,36 @@ func (t *ChatInviteLink) GetPendingJoinRequestCount() int64 {
 	return res
 }
 
+// Clone returns a deep copy of t.
+func (t *ChatInviteLink) Clone() *ChatInviteLink {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Creator = *t.Creator.Clone()
+	res.ExpireDate = cloneInt64Ptr(t.ExpireDate)
+	res.MemberLimit = cloneInt64Ptr(t.MemberLimit)
+	res.Name = cloneStringPtr(t.Name)
+	res.PendingJoinRequestCount = cloneInt64Ptr(t.PendingJoinRequestCount)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *ChatInviteLink) Equal(other *ChatInviteLink) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.CreatesJoinRequest == other.CreatesJoinRequest &&
+		t.Creator.Equal(&other.Creator) &&
+		equalInt64Ptr(t.ExpireDate, other.ExpireDate) &&
+		t.InviteLink == other.InviteLink &&
+		t.IsPrimary == other.IsPrimary &&
+		t.IsRevoked == other.IsRevoked &&
+		equalInt64Ptr(t.MemberLimit, other.MemberLimit) &&
+		equalStringPtr(t.Name, other.Name) &&
+		equalInt64Ptr(t.PendingJoinRequestCount, other.PendingJoinRequestCount)
+}
+
 // ChatJoinRequest
 // Represents a join request sent to a chat.
 type ChatJoinRequest struct {
@@ -1328,6 +2209,26 @@ type ChatJoinRequest struct {
 	InviteLink *ChatInviteLink `json:"invite_link,omitempty"`
 }
 
+// NewChatJoinRequest creates ChatJoinRequest with the required fields.
+func NewChatJoinRequest(chat Chat, date int64, from User, userChatID int64) *ChatJoinRequest {
+	return &ChatJoinRequest{
+		Chat:       chat,
+		Date:       date,
+		From:       from,
+		UserChatID: userChatID,
+	}
+}
+
+func (t *ChatJoinRequest) SetBio(bio string) *ChatJoinRequest {
+	t.Bio = &bio
+	return t
+}
+
+func (t *ChatJoinRequest) SetInviteLink(inviteLink ChatInviteLink) *ChatJoinRequest {
+	t.InviteLink = &inviteLink
+	return t
+}
+
 func (t *ChatJoinRequest) GetBio() string {
 	var res string
 	if t == nil {
@@ -1376,6 +2277,32 @@ func (t *ChatJoinRequest) GetUserChatID() int64 {
 	return t.UserChatID
 }
 
+// Clone returns a deep copy of t.
+func (t *ChatJoinRequest) Clone() *ChatJoinRequest {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Bio = cloneStringPtr(t.Bio)
+	res.Chat = *t.Chat.Clone()
+	res.From = *t.From.Clone()
+	res.InviteLink = t.InviteLink.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *ChatJoinRequest) Equal(other *ChatJoinRequest) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.Bio, other.Bio) &&
+		t.Chat.Equal(&other.Chat) &&
+		t.Date == other.Date &&
+		t.From.Equal(&other.From) &&
+		t.InviteLink.Equal(other.InviteLink) &&
+		t.UserChatID == other.UserChatID
+}
+
 // ChatLocation
 // Represents a location to which a chat is connected.
 type ChatLocation struct {
@@ -1387,6 +2314,14 @@ type ChatLocation struct {
 	Location Location `json:"location"`
 }
 
+// NewChatLocation creates ChatLocation with the required fields.
+func NewChatLocation(address string, location Location) *ChatLocation {
+	return &ChatLocation{
+		Address:  address,
+		Location: location,
+	}
+}
+
 func (t *ChatLocation) GetAddress() string {
 	var res string
 	if t == nil {
@@ -1402,6 +2337,25 @@ func (t *ChatLocation) GetLocation() *Location {
 	return &t.Location
 }
 
+// Clone returns a deep copy of t.
+func (t *ChatLocation) Clone() *ChatLocation {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Location = *t.Location.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *ChatLocation) Equal(other *ChatLocation) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Address == other.Address &&
+		t.Location.Equal(&other.Location)
+}
+
 // ChatMember
 // This object contains information about one member of a chat. Currently, the following 6 types of
 // chat members are supported:
@@ -1411,7 +2365,7 @@ type ChatMember struct {
 	IsAnonymous bool `json:"is_anonymous"`
 	// Status
 	// The member's status in the chat, always "creator"
-	Status string `json:"status"`
+	Status ChatMemberStatus `json:"status"`
 	// User
 	// Information about the user
 	User User `json:"user"`
@@ -1420,6 +2374,20 @@ type ChatMember struct {
 	CustomTitle *string `json:"custom_title,omitempty"`
 }
 
+// NewChatMember creates ChatMember with the required fields.
+func NewChatMember(isAnonymous bool, user User) *ChatMember {
+	return &ChatMember{
+		IsAnonymous: isAnonymous,
+		Status:      ChatMemberStatusCreator,
+		User:        user,
+	}
+}
+
+func (t *ChatMember) SetCustomTitle(customTitle string) *ChatMember {
+	t.CustomTitle = &customTitle
+	return t
+}
+
 func (t *ChatMember) GetCustomTitle() string {
 	var res string
 	if t == nil {
@@ -1439,12 +2407,11 @@ func (t *ChatMember) GetIsAnonymous() bool {
 	return t.IsAnonymous
 }
 
-func (t *ChatMember) GetStatus() string {
-	var res string
+func (t *ChatMember) GetStatus() *ChatMemberStatus {
 	if t == nil {
-		return res
+		return nil
 	}
-	return t.Status
+	return &t.Status
 }
 
 func (t *ChatMember) GetUser() *User {
@@ -1454,6 +2421,28 @@ func (t *ChatMember) GetUser() *User {
 	return &t.User
 }
 
+// Clone returns a deep copy of t.
+func (t *ChatMember) Clone() *ChatMember {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.CustomTitle = cloneStringPtr(t.CustomTitle)
+	res.User = *t.User.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *ChatMember) Equal(other *ChatMember) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.CustomTitle, other.CustomTitle) &&
+		t.IsAnonymous == other.IsAnonymous &&
+		t.Status == other.Status &&
+		t.User.Equal(&other.User)
+}
+
 // ChatMemberAdministrator
 // Represents a chat member that has some additional privileges.
 type ChatMemberAdministrator struct {
@@ -1490,7 +2479,7 @@ type ChatMemberAdministrator struct {
 	IsAnonymous bool `json:"is_anonymous"`
 	// Status
 	// The member's status in the chat, always "administrator"
-	Status string `json:"status"`
+	Status ChatMemberStatus `json:"status"`
 	// User
 	// Information about the user
 	User User `json:"user"`
@@ -1513,6 +2502,48 @@ type ChatMemberAdministrator struct {
 	CustomTitle *string `json:"custom_title,omitempty"`
 }
 
+// NewChatMemberAdministrator creates ChatMemberAdministrator with the required fields.
+func NewChatMemberAdministrator(canBeEdited bool, canChangeInfo bool, canDeleteMessages bool, canInviteUsers bool, canManageChat bool, canManageVideoChats bool, canPromoteMembers bool, canRestrictMembers bool, isAnonymous bool, user User) *ChatMemberAdministrator {
+	return &ChatMemberAdministrator{
+		CanBeEdited:         canBeEdited,
+		CanChangeInfo:       canChangeInfo,
+		CanDeleteMessages:   canDeleteMessages,
+		CanInviteUsers:      canInviteUsers,
+		CanManageChat:       canManageChat,
+		CanManageVideoChats: canManageVideoChats,
+		CanPromoteMembers:   canPromoteMembers,
+		CanRestrictMembers:  canRestrictMembers,
+		IsAnonymous:         isAnonymous,
+		Status:              ChatMemberStatusAdministrator,
+		User:                user,
+	}
+}
+
+func (t *ChatMemberAdministrator) SetCanEditMessages(canEditMessages bool) *ChatMemberAdministrator {
+	t.CanEditMessages = &canEditMessages
+	return t
+}
+
+func (t *ChatMemberAdministrator) SetCanManageTopics(canManageTopics bool) *ChatMemberAdministrator {
+	t.CanManageTopics = &canManageTopics
+	return t
+}
+
+func (t *ChatMemberAdministrator) SetCanPinMessages(canPinMessages bool) *ChatMemberAdministrator {
+	t.CanPinMessages = &canPinMessages
+	return t
+}
+
+func (t *ChatMemberAdministrator) SetCanPostMessages(canPostMessages bool) *ChatMemberAdministrator {
+	t.CanPostMessages = &canPostMessages
+	return t
+}
+
+func (t *ChatMemberAdministrator) SetCustomTitle(customTitle string) *ChatMemberAdministrator {
+	t.CustomTitle = &customTitle
+	return t
+}
+
 func (t *ChatMemberAdministrator) GetCanBeEdited() bool {
 	var res bool
 	if t == nil {
@@ -1640,12 +2671,11 @@ func (t *ChatMemberAdministrator) GetIsAnonymous() bool {
 	return t.IsAnonymous
 }
 
-func (t *ChatMemberAdministrator) GetStatus() string {
-	var res string
+func (t *ChatMemberAdministrator) GetStatus() *ChatMemberStatus {
 	if t == nil {
-		return res
+		return nil
 	}
-	return t.Status
+	return &t.Status
 }
 
 func (t *ChatMemberAdministrator) GetUser() *User {
@@ -1655,13 +2685,51 @@ func (t *ChatMemberAdministrator) GetUser() *User {
 	return &t.User
 }
 
+// Clone returns a deep copy of t.
+func (t *ChatMemberAdministrator) Clone() *ChatMemberAdministrator {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.CanEditMessages = cloneBoolPtr(t.CanEditMessages)
+	res.CanManageTopics = cloneBoolPtr(t.CanManageTopics)
+	res.CanPinMessages = cloneBoolPtr(t.CanPinMessages)
+	res.CanPostMessages = cloneBoolPtr(t.CanPostMessages)
+	res.CustomTitle = cloneStringPtr(t.CustomTitle)
+	res.User = *t.User.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *ChatMemberAdministrator) Equal(other *ChatMemberAdministrator) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.CanBeEdited == other.CanBeEdited &&
+		t.CanChangeInfo == other.CanChangeInfo &&
+		t.CanDeleteMessages == other.CanDeleteMessages &&
+		equalBoolPtr(t.CanEditMessages, other.CanEditMessages) &&
+		t.CanInviteUsers == other.CanInviteUsers &&
+		t.CanManageChat == other.CanManageChat &&
+		equalBoolPtr(t.CanManageTopics, other.CanManageTopics) &&
+		t.CanManageVideoChats == other.CanManageVideoChats &&
+		equalBoolPtr(t.CanPinMessages, other.CanPinMessages) &&
+		equalBoolPtr(t.CanPostMessages, other.CanPostMessages) &&
+		t.CanPromoteMembers == other.CanPromoteMembers &&
+		t.CanRestrictMembers == other.CanRestrictMembers &&
+		equalStringPtr(t.CustomTitle, other.CustomTitle) &&
+		t.IsAnonymous == other.IsAnonymous &&
+		t.Status == other.Status &&
+		t.User.Equal(&other.User)
+}
+
 // ChatMemberBanned
 // Represents a chat member that was banned in the chat and can't return to the chat or view chat
 // messages.
 type ChatMemberBanned struct {
 	// Status
 	// The member's status in the chat, always "kicked"
-	Status string `json:"status"`
+	Status ChatMemberStatus `json:"status"`
 	// UntilDate
 	// Date when restrictions will be lifted for this user; unix time. If 0, then the user is
 	// banned forever
@@ -1671,12 +2739,20 @@ type ChatMemberBanned struct {
 	User User `json:"user"`
 }
 
-func (t *ChatMemberBanned) GetStatus() string {
-	var res string
+// NewChatMemberBanned creates ChatMemberBanned with the required fields.
+func NewChatMemberBanned(untilDate int64, user User) *ChatMemberBanned {
+	return &ChatMemberBanned{
+		Status:    ChatMemberStatusKicked,
+		UntilDate: untilDate,
+		User:      user,
+	}
+}
+
+func (t *ChatMemberBanned) GetStatus() *ChatMemberStatus {
 	if t == nil {
-		return res
+		return nil
 	}
-	return t.Status
+	return &t.Status
 }
 
 func (t *ChatMemberBanned) GetUntilDate() int64 {
@@ -1694,23 +2770,50 @@ func (t *ChatMemberBanned) GetUser() *User {
 	return &t.User
 }
 
+// Clone returns a deep copy of t.
+func (t *ChatMemberBanned) Clone() *ChatMemberBanned {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.User = *t.User.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *ChatMemberBanned) Equal(other *ChatMemberBanned) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Status == other.Status &&
+		t.UntilDate == other.UntilDate &&
+		t.User.Equal(&other.User)
+}
+
 // ChatMemberLeft
 // Represents a chat member that isn't currently a member of the chat, but may join it themselves.
 type ChatMemberLeft struct {
 	// Status
 	// The member's status in the chat, always "left"
-	Status string `json:"status"`
+	Status ChatMemberStatus `json:"status"`
 	// User
 	// Information about the user
 	User User `json:"user"`
 }
 
-func (t *ChatMemberLeft) GetStatus() string {
-	var res string
+// NewChatMemberLeft creates ChatMemberLeft with the required fields.
+func NewChatMemberLeft(user User) *ChatMemberLeft {
+	return &ChatMemberLeft{
+		Status: ChatMemberStatusLeft,
+		User:   user,
+	}
+}
+
+func (t *ChatMemberLeft) GetStatus() *ChatMemberStatus {
 	if t == nil {
-		return res
+		return nil
 	}
-	return t.Status
+	return &t.Status
 }
 
 func (t *ChatMemberLeft) GetUser() *User {
@@ -1720,23 +2823,49 @@ func (t *ChatMemberLeft) GetUser() *User {
 	return &t.User
 }
 
+// Clone returns a deep copy of t.
+func (t *ChatMemberLeft) Clone() *ChatMemberLeft {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.User = *t.User.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *ChatMemberLeft) Equal(other *ChatMemberLeft) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Status == other.Status &&
+		t.User.Equal(&other.User)
+}
+
 // ChatMemberMember
 // Represents a chat member that has no additional privileges or restrictions.
 type ChatMemberMember struct {
 	// Status
 	// The member's status in the chat, always "member"
-	Status string `json:"status"`
+	Status ChatMemberStatus `json:"status"`
 	// User
 	// Information about the user
 	User User `json:"user"`
 }
 
-func (t *ChatMemberMember) GetStatus() string {
-	var res string
+// NewChatMemberMember creates ChatMemberMember with the required fields.
+func NewChatMemberMember(user User) *ChatMemberMember {
+	return &ChatMemberMember{
+		Status: ChatMemberStatusMember,
+		User:   user,
+	}
+}
+
+func (t *ChatMemberMember) GetStatus() *ChatMemberStatus {
 	if t == nil {
-		return res
+		return nil
 	}
-	return t.Status
+	return &t.Status
 }
 
 func (t *ChatMemberMember) GetUser() *User {
@@ -1746,6 +2875,25 @@ func (t *ChatMemberMember) GetUser() *User {
 	return &t.User
 }
 
+// Clone returns a deep copy of t.
+func (t *ChatMemberMember) Clone() *ChatMemberMember {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.User = *t.User.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *ChatMemberMember) Equal(other *ChatMemberMember) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Status == other.Status &&
+		t.User.Equal(&other.User)
+}
+
 // ChatMemberOwner
 // Represents a chat member that owns the chat and has all administrator privileges.
 type ChatMemberOwner struct {
@@ -1754,7 +2902,7 @@ type ChatMemberOwner struct {
 	IsAnonymous bool `json:"is_anonymous"`
 	// Status
 	// The member's status in the chat, always "creator"
-	Status string `json:"status"`
+	Status ChatMemberStatus `json:"status"`
 	// User
 	// Information about the user
 	User User `json:"user"`
@@ -1763,6 +2911,20 @@ type ChatMemberOwner struct {
 	CustomTitle *string `json:"custom_title,omitempty"`
 }
 
+// NewChatMemberOwner creates ChatMemberOwner with the required fields.
+func NewChatMemberOwner(isAnonymous bool, user User) *ChatMemberOwner {
+	return &ChatMemberOwner{
+		IsAnonymous: isAnonymous,
+		Status:      ChatMemberStatusCreator,
+		User:        user,
+	}
+}
+
+func (t *ChatMemberOwner) SetCustomTitle(customTitle string) *ChatMemberOwner {
+	t.CustomTitle = &customTitle
+	return t
+}
+
 func (t *ChatMemberOwner) GetCustomTitle() string {
 	var res string
 	if t == nil {
@@ -1782,12 +2944,11 @@ func (t *ChatMemberOwner) GetIsAnonymous() bool {
 	return t.IsAnonymous
 }
 
-func (t *ChatMemberOwner) GetStatus() string {
-	var res string
+func (t *ChatMemberOwner) GetStatus() *ChatMemberStatus {
 	if t == nil {
-		return res
+		return nil
 	}
-	return t.Status
+	return &t.Status
 }
 
 func (t *ChatMemberOwner) GetUser() *User {
@@ -1797,9 +2958,31 @@ func (t *ChatMemberOwner) GetUser() *User {
 	return &t.User
 }
 
-// ChatMemberRestricted
-// Represents a chat member that is under certain restrictions in the chat. Supergroups only.
-type ChatMemberRestricted struct {
+// Clone returns a deep copy of t.
+func (t *ChatMemberOwner) Clone() *ChatMemberOwner {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.CustomTitle = cloneStringPtr(t.CustomTitle)
+	res.User = *t.User.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *ChatMemberOwner) Equal(other *ChatMemberOwner) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.CustomTitle, other.CustomTitle) &&
+		t.IsAnonymous == other.IsAnonymous &&
+		t.Status == other.Status &&
+		t.User.Equal(&other.User)
+}
+
+// ChatMemberRestricted
+// Represents a chat member that is under certain restrictions in the chat. Supergroups only.
+type ChatMemberRestricted struct {
 	// CanAddWebPagePreviews
 	// True, if the user is allowed to add web page previews to their messages
 	CanAddWebPagePreviews bool `json:"can_add_web_page_previews"`
@@ -1847,7 +3030,7 @@ type ChatMemberRestricted struct {
 	IsMember bool `json:"is_member"`
 	// Status
 	// The member's status in the chat, always "restricted"
-	Status string `json:"status"`
+	Status ChatMemberStatus `json:"status"`
 	// UntilDate
 	// Date when restrictions will be lifted for this user; unix time. If 0, then the user is
 	// restricted forever
@@ -1857,6 +3040,30 @@ type ChatMemberRestricted struct {
 	User User `json:"user"`
 }
 
+// NewChatMemberRestricted creates ChatMemberRestricted with the required fields.
+func NewChatMemberRestricted(canAddWebPagePreviews bool, canChangeInfo bool, canInviteUsers bool, canManageTopics bool, canPinMessages bool, canSendAudios bool, canSendDocuments bool, canSendMessages bool, canSendOtherMessages bool, canSendPhotos bool, canSendPolls bool, canSendVideoNotes bool, canSendVideos bool, canSendVoiceNotes bool, isMember bool, untilDate int64, user User) *ChatMemberRestricted {
+	return &ChatMemberRestricted{
+		CanAddWebPagePreviews: canAddWebPagePreviews,
+		CanChangeInfo:         canChangeInfo,
+		CanInviteUsers:        canInviteUsers,
+		CanManageTopics:       canManageTopics,
+		CanPinMessages:        canPinMessages,
+		CanSendAudios:         canSendAudios,
+		CanSendDocuments:      canSendDocuments,
+		CanSendMessages:       canSendMessages,
+		CanSendOtherMessages:  canSendOtherMessages,
+		CanSendPhotos:         canSendPhotos,
+		CanSendPolls:          canSendPolls,
+		CanSendVideoNotes:     canSendVideoNotes,
+		CanSendVideos:         canSendVideos,
+		CanSendVoiceNotes:     canSendVoiceNotes,
+		IsMember:              isMember,
+		Status:                ChatMemberStatusRestricted,
+		UntilDate:             untilDate,
+		User:                  user,
+	}
+}
+
 func (t *ChatMemberRestricted) GetCanAddWebPagePreviews() bool {
 	var res bool
 	if t == nil {
@@ -1977,12 +3184,11 @@ func (t *ChatMemberRestricted) GetIsMember() bool {
 	return t.IsMember
 }
 
-func (t *ChatMemberRestricted) GetStatus() string {
-	var res string
+func (t *ChatMemberRestricted) GetStatus() *ChatMemberStatus {
 	if t == nil {
-		return res
+		return nil
 	}
-	return t.Status
+	return &t.Status
 }
 
 func (t *ChatMemberRestricted) GetUntilDate() int64 {
@@ -2000,6 +3206,41 @@ func (t *ChatMemberRestricted) GetUser() *User {
 	return &t.User
 }
 
+// Clone returns a deep copy of t.
+func (t *ChatMemberRestricted) Clone() *ChatMemberRestricted {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.User = *t.User.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *ChatMemberRestricted) Equal(other *ChatMemberRestricted) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.CanAddWebPagePreviews == other.CanAddWebPagePreviews &&
+		t.CanChangeInfo == other.CanChangeInfo &&
+		t.CanInviteUsers == other.CanInviteUsers &&
+		t.CanManageTopics == other.CanManageTopics &&
+		t.CanPinMessages == other.CanPinMessages &&
+		t.CanSendAudios == other.CanSendAudios &&
+		t.CanSendDocuments == other.CanSendDocuments &&
+		t.CanSendMessages == other.CanSendMessages &&
+		t.CanSendOtherMessages == other.CanSendOtherMessages &&
+		t.CanSendPhotos == other.CanSendPhotos &&
+		t.CanSendPolls == other.CanSendPolls &&
+		t.CanSendVideoNotes == other.CanSendVideoNotes &&
+		t.CanSendVideos == other.CanSendVideos &&
+		t.CanSendVoiceNotes == other.CanSendVoiceNotes &&
+		t.IsMember == other.IsMember &&
+		t.Status == other.Status &&
+		t.UntilDate == other.UntilDate &&
+		t.User.Equal(&other.User)
+}
+
 // ChatMemberUpdated
 // This object represents changes in the status of a chat member.
 type ChatMemberUpdated struct {
@@ -2027,6 +3268,27 @@ type ChatMemberUpdated struct {
 	ViaChatFolderInviteLink *bool `json:"via_chat_folder_invite_link,omitempty"`
 }
 
+// NewChatMemberUpdated creates ChatMemberUpdated with the required fields.
+func NewChatMemberUpdated(chat Chat, date int64, from User, newChatMember ChatMember, oldChatMember ChatMember) *ChatMemberUpdated {
+	return &ChatMemberUpdated{
+		Chat:          chat,
+		Date:          date,
+		From:          from,
+		NewChatMember: newChatMember,
+		OldChatMember: oldChatMember,
+	}
+}
+
+func (t *ChatMemberUpdated) SetInviteLink(inviteLink ChatInviteLink) *ChatMemberUpdated {
+	t.InviteLink = &inviteLink
+	return t
+}
+
+func (t *ChatMemberUpdated) SetViaChatFolderInviteLink(viaChatFolderInviteLink bool) *ChatMemberUpdated {
+	t.ViaChatFolderInviteLink = &viaChatFolderInviteLink
+	return t
+}
+
 func (t *ChatMemberUpdated) GetChat() *Chat {
 	if t == nil {
 		return nil
@@ -2081,6 +3343,35 @@ func (t *ChatMemberUpdated) GetViaChatFolderInviteLink() bool {
 	return res
 }
 
+// Clone returns a deep copy of t.
+func (t *ChatMemberUpdated) Clone() *ChatMemberUpdated {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Chat = *t.Chat.Clone()
+	res.From = *t.From.Clone()
+	res.InviteLink = t.InviteLink.Clone()
+	res.NewChatMember = *t.NewChatMember.Clone()
+	res.OldChatMember = *t.OldChatMember.Clone()
+	res.ViaChatFolderInviteLink = cloneBoolPtr(t.ViaChatFolderInviteLink)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *ChatMemberUpdated) Equal(other *ChatMemberUpdated) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Chat.Equal(&other.Chat) &&
+		t.Date == other.Date &&
+		t.From.Equal(&other.From) &&
+		t.InviteLink.Equal(other.InviteLink) &&
+		t.NewChatMember.Equal(&other.NewChatMember) &&
+		t.OldChatMember.Equal(&other.OldChatMember) &&
+		equalBoolPtr(t.ViaChatFolderInviteLink, other.ViaChatFolderInviteLink)
+}
+
 // ChatPermissions
 // Describes actions that a non-administrator user is allowed to take in a chat.
 type ChatPermissions struct {
@@ -2284,6 +3575,50 @@ func (t *ChatPermissions) GetCanSendVoiceNotes() bool {
 	return res
 }
 
+// Clone returns a deep copy of t.
+func (t *ChatPermissions) Clone() *ChatPermissions {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.CanAddWebPagePreviews = cloneBoolPtr(t.CanAddWebPagePreviews)
+	res.CanChangeInfo = cloneBoolPtr(t.CanChangeInfo)
+	res.CanInviteUsers = cloneBoolPtr(t.CanInviteUsers)
+	res.CanManageTopics = cloneBoolPtr(t.CanManageTopics)
+	res.CanPinMessages = cloneBoolPtr(t.CanPinMessages)
+	res.CanSendAudios = cloneBoolPtr(t.CanSendAudios)
+	res.CanSendDocuments = cloneBoolPtr(t.CanSendDocuments)
+	res.CanSendMessages = cloneBoolPtr(t.CanSendMessages)
+	res.CanSendOtherMessages = cloneBoolPtr(t.CanSendOtherMessages)
+	res.CanSendPhotos = cloneBoolPtr(t.CanSendPhotos)
+	res.CanSendPolls = cloneBoolPtr(t.CanSendPolls)
+	res.CanSendVideoNotes = cloneBoolPtr(t.CanSendVideoNotes)
+	res.CanSendVideos = cloneBoolPtr(t.CanSendVideos)
+	res.CanSendVoiceNotes = cloneBoolPtr(t.CanSendVoiceNotes)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *ChatPermissions) Equal(other *ChatPermissions) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalBoolPtr(t.CanAddWebPagePreviews, other.CanAddWebPagePreviews) &&
+		equalBoolPtr(t.CanChangeInfo, other.CanChangeInfo) &&
+		equalBoolPtr(t.CanInviteUsers, other.CanInviteUsers) &&
+		equalBoolPtr(t.CanManageTopics, other.CanManageTopics) &&
+		equalBoolPtr(t.CanPinMessages, other.CanPinMessages) &&
+		equalBoolPtr(t.CanSendAudios, other.CanSendAudios) &&
+		equalBoolPtr(t.CanSendDocuments, other.CanSendDocuments) &&
+		equalBoolPtr(t.CanSendMessages, other.CanSendMessages) &&
+		equalBoolPtr(t.CanSendOtherMessages, other.CanSendOtherMessages) &&
+		equalBoolPtr(t.CanSendPhotos, other.CanSendPhotos) &&
+		equalBoolPtr(t.CanSendPolls, other.CanSendPolls) &&
+		equalBoolPtr(t.CanSendVideoNotes, other.CanSendVideoNotes) &&
+		equalBoolPtr(t.CanSendVideos, other.CanSendVideos) &&
+		equalBoolPtr(t.CanSendVoiceNotes, other.CanSendVoiceNotes)
+}
+
 // ChatPhoto
 // This object represents a chat photo.
 type ChatPhoto struct {
@@ -2305,6 +3640,16 @@ type ChatPhoto struct {
 	SmallFileUniqueID string `json:"small_file_unique_id"`
 }
 
+// NewChatPhoto creates ChatPhoto with the required fields.
+func NewChatPhoto(bigFileID string, bigFileUniqueID string, smallFileID string, smallFileUniqueID string) *ChatPhoto {
+	return &ChatPhoto{
+		BigFileID:         bigFileID,
+		BigFileUniqueID:   bigFileUniqueID,
+		SmallFileID:       smallFileID,
+		SmallFileUniqueID: smallFileUniqueID,
+	}
+}
+
 func (t *ChatPhoto) GetBigFileID() string {
 	var res string
 	if t == nil {
@@ -2337,6 +3682,26 @@ func (t *ChatPhoto) GetSmallFileUniqueID() string {
 	return t.SmallFileUniqueID
 }
 
+// Clone returns a deep copy of t.
+func (t *ChatPhoto) Clone() *ChatPhoto {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *ChatPhoto) Equal(other *ChatPhoto) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.BigFileID == other.BigFileID &&
+		t.BigFileUniqueID == other.BigFileUniqueID &&
+		t.SmallFileID == other.SmallFileID &&
+		t.SmallFileUniqueID == other.SmallFileUniqueID
+}
+
 // ChatShared
 // This object contains information about the chat whose identifier was shared with the bot using a
 // KeyboardButtonRequestChat button.
@@ -2353,6 +3718,14 @@ type ChatShared struct {
 	RequestID int64 `json:"request_id"`
 }
 
+// NewChatShared creates ChatShared with the required fields.
+func NewChatShared(chatID int64, requestID int64) *ChatShared {
+	return &ChatShared{
+		ChatID:    chatID,
+		RequestID: requestID,
+	}
+}
+
 func (t *ChatShared) GetChatID() int64 {
 	var res int64
 	if t == nil {
@@ -2369,6 +3742,24 @@ func (t *ChatShared) GetRequestID() int64 {
 	return t.RequestID
 }
 
+// Clone returns a deep copy of t.
+func (t *ChatShared) Clone() *ChatShared {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *ChatShared) Equal(other *ChatShared) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.ChatID == other.ChatID &&
+		t.RequestID == other.RequestID
+}
+
 // ChosenInlineResult
 // Represents a result of an inline query that was chosen by the user and sent to their chat
 // partner.
@@ -2392,6 +3783,25 @@ type ChosenInlineResult struct {
 	Location *Location `json:"location,omitempty"`
 }
 
+// NewChosenInlineResult creates ChosenInlineResult with the required fields.
+func NewChosenInlineResult(from User, query string, resultID string) *ChosenInlineResult {
+	return &ChosenInlineResult{
+		From:     from,
+		Query:    query,
+		ResultID: resultID,
+	}
+}
+
+func (t *ChosenInlineResult) SetInlineMessageID(inlineMessageID string) *ChosenInlineResult {
+	t.InlineMessageID = &inlineMessageID
+	return t
+}
+
+func (t *ChosenInlineResult) SetLocation(location Location) *ChosenInlineResult {
+	t.Location = &location
+	return t
+}
+
 func (t *ChosenInlineResult) GetFrom() *User {
 	if t == nil {
 		return nil
@@ -2433,6 +3843,30 @@ func (t *ChosenInlineResult) GetResultID() string {
 	return t.ResultID
 }
 
+// Clone returns a deep copy of t.
+func (t *ChosenInlineResult) Clone() *ChosenInlineResult {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.From = *t.From.Clone()
+	res.InlineMessageID = cloneStringPtr(t.InlineMessageID)
+	res.Location = t.Location.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *ChosenInlineResult) Equal(other *ChosenInlineResult) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.From.Equal(&other.From) &&
+		equalStringPtr(t.InlineMessageID, other.InlineMessageID) &&
+		t.Location.Equal(other.Location) &&
+		t.Query == other.Query &&
+		t.ResultID == other.ResultID
+}
+
 // Contact
 // This object represents a phone contact.
 type Contact struct {
@@ -2456,6 +3890,29 @@ type Contact struct {
 	Vcard *string `json:"vcard,omitempty"`
 }
 
+// NewContact creates Contact with the required fields.
+func NewContact(firstName string, phoneNumber string) *Contact {
+	return &Contact{
+		FirstName:   firstName,
+		PhoneNumber: phoneNumber,
+	}
+}
+
+func (t *Contact) SetLastName(lastName string) *Contact {
+	t.LastName = &lastName
+	return t
+}
+
+func (t *Contact) SetUserID(userID int64) *Contact {
+	t.UserID = &userID
+	return t
+}
+
+func (t *Contact) SetVcard(vcard string) *Contact {
+	t.Vcard = &vcard
+	return t
+}
+
 func (t *Contact) GetFirstName() string {
 	var res string
 	if t == nil {
@@ -2505,24 +3962,55 @@ func (t *Contact) GetVcard() string {
 	return res
 }
 
+// Clone returns a deep copy of t.
+func (t *Contact) Clone() *Contact {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.LastName = cloneStringPtr(t.LastName)
+	res.UserID = cloneInt64Ptr(t.UserID)
+	res.Vcard = cloneStringPtr(t.Vcard)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *Contact) Equal(other *Contact) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.FirstName == other.FirstName &&
+		equalStringPtr(t.LastName, other.LastName) &&
+		t.PhoneNumber == other.PhoneNumber &&
+		equalInt64Ptr(t.UserID, other.UserID) &&
+		equalStringPtr(t.Vcard, other.Vcard)
+}
+
 // Dice
 // This object represents an animated emoji that displays a random value.
 type Dice struct {
 	// Emoji
 	// Emoji on which the dice throw animation is based
-	Emoji string `json:"emoji"`
+	Emoji DiceEmoji `json:"emoji"`
 	// Value
 	// Value of the dice, 1-6 for "", "" and "" base emoji, 1-5 for "" and "" base emoji, 1-64 for
 	// "" base emoji
 	Value int64 `json:"value"`
 }
 
-func (t *Dice) GetEmoji() string {
-	var res string
+// NewDice creates Dice with the required fields.
+func NewDice(emoji DiceEmoji, value int64) *Dice {
+	return &Dice{
+		Emoji: emoji,
+		Value: value,
+	}
+}
+
+func (t *Dice) GetEmoji() *DiceEmoji {
 	if t == nil {
-		return res
+		return nil
 	}
-	return t.Emoji
+	return &t.Emoji
 }
 
 func (t *Dice) GetValue() int64 {
@@ -2533,6 +4021,24 @@ func (t *Dice) GetValue() int64 {
 	return t.Value
 }
 
+// Clone returns a deep copy of t.
+func (t *Dice) Clone() *Dice {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *Dice) Equal(other *Dice) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Emoji == other.Emoji &&
+		t.Value == other.Value
+}
+
 // Document
 // This object represents a general file (as opposed to photos, voice messages and audio files).
 type Document struct {
@@ -2559,6 +4065,34 @@ type Document struct {
 	Thumbnail *PhotoSize `json:"thumbnail,omitempty"`
 }
 
+// NewDocument creates Document with the required fields.
+func NewDocument(fileID string, fileUniqueID string) *Document {
+	return &Document{
+		FileID:       fileID,
+		FileUniqueID: fileUniqueID,
+	}
+}
+
+func (t *Document) SetFileName(fileName string) *Document {
+	t.FileName = &fileName
+	return t
+}
+
+func (t *Document) SetFileSize(fileSize int64) *Document {
+	t.FileSize = &fileSize
+	return t
+}
+
+func (t *Document) SetMimeType(mimeType string) *Document {
+	t.MimeType = &mimeType
+	return t
+}
+
+func (t *Document) SetThumbnail(thumbnail PhotoSize) *Document {
+	t.Thumbnail = &thumbnail
+	return t
+}
+
 func (t *Document) GetFileID() string {
 	var res string
 	if t == nil {
@@ -2615,6 +4149,32 @@ func (t *Document) GetThumbnail() *PhotoSize {
 	return t.Thumbnail
 }
 
+// Clone returns a deep copy of t.
+func (t *Document) Clone() *Document {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.FileName = cloneStringPtr(t.FileName)
+	res.FileSize = cloneInt64Ptr(t.FileSize)
+	res.MimeType = cloneStringPtr(t.MimeType)
+	res.Thumbnail = t.Thumbnail.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *Document) Equal(other *Document) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.FileID == other.FileID &&
+		equalStringPtr(t.FileName, other.FileName) &&
+		equalInt64Ptr(t.FileSize, other.FileSize) &&
+		t.FileUniqueID == other.FileUniqueID &&
+		equalStringPtr(t.MimeType, other.MimeType) &&
+		t.Thumbnail.Equal(other.Thumbnail)
+}
+
 // EncryptedCredentials
 // Describes data required for decrypting and authenticating EncryptedPassportElement. See the
 // Telegram Passport Documentation for a complete description of the data decryption and
@@ -2632,6 +4192,15 @@ type EncryptedCredentials struct {
 	Secret string `json:"secret"`
 }
 
+// NewEncryptedCredentials creates EncryptedCredentials with the required fields.
+func NewEncryptedCredentials(data string, hash string, secret string) *EncryptedCredentials {
+	return &EncryptedCredentials{
+		Data:   data,
+		Hash:   hash,
+		Secret: secret,
+	}
+}
+
 func (t *EncryptedCredentials) GetData() string {
 	var res string
 	if t == nil {
@@ -2656,6 +4225,25 @@ func (t *EncryptedCredentials) GetSecret() string {
 	return t.Secret
 }
 
+// Clone returns a deep copy of t.
+func (t *EncryptedCredentials) Clone() *EncryptedCredentials {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *EncryptedCredentials) Equal(other *EncryptedCredentials) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Data == other.Data &&
+		t.Hash == other.Hash &&
+		t.Secret == other.Secret
+}
+
 // EncryptedPassportElement
 // Describes documents or other Telegram Passport elements shared with the bot by the user.
 type EncryptedPassportElement struct {
@@ -2707,6 +4295,54 @@ type EncryptedPassportElement struct {
 	Translation []PassportFile `json:"translation,omitempty"`
 }
 
+// NewEncryptedPassportElement creates EncryptedPassportElement with the required fields.
+func NewEncryptedPassportElement(hash string, typ EncryptedType) *EncryptedPassportElement {
+	return &EncryptedPassportElement{
+		Hash: hash,
+		Type: typ,
+	}
+}
+
+func (t *EncryptedPassportElement) SetData(data string) *EncryptedPassportElement {
+	t.Data = &data
+	return t
+}
+
+func (t *EncryptedPassportElement) SetEmail(email string) *EncryptedPassportElement {
+	t.Email = &email
+	return t
+}
+
+func (t *EncryptedPassportElement) SetFiles(files []PassportFile) *EncryptedPassportElement {
+	t.Files = files
+	return t
+}
+
+func (t *EncryptedPassportElement) SetFrontSide(frontSide PassportFile) *EncryptedPassportElement {
+	t.FrontSide = &frontSide
+	return t
+}
+
+func (t *EncryptedPassportElement) SetPhoneNumber(phoneNumber string) *EncryptedPassportElement {
+	t.PhoneNumber = &phoneNumber
+	return t
+}
+
+func (t *EncryptedPassportElement) SetReverseSide(reverseSide PassportFile) *EncryptedPassportElement {
+	t.ReverseSide = &reverseSide
+	return t
+}
+
+func (t *EncryptedPassportElement) SetSelfie(selfie PassportFile) *EncryptedPassportElement {
+	t.Selfie = &selfie
+	return t
+}
+
+func (t *EncryptedPassportElement) SetTranslation(translation []PassportFile) *EncryptedPassportElement {
+	t.Translation = translation
+	return t
+}
+
 func (t *EncryptedPassportElement) GetData() string {
 	var res string
 	if t == nil {
@@ -2776,6 +4412,40 @@ func (t *EncryptedPassportElement) GetType() *EncryptedType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *EncryptedPassportElement) Clone() *EncryptedPassportElement {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Data = cloneStringPtr(t.Data)
+	res.Email = cloneStringPtr(t.Email)
+	res.Files = clonePassportFileSlice(t.Files)
+	res.FrontSide = t.FrontSide.Clone()
+	res.PhoneNumber = cloneStringPtr(t.PhoneNumber)
+	res.ReverseSide = t.ReverseSide.Clone()
+	res.Selfie = t.Selfie.Clone()
+	res.Translation = clonePassportFileSlice(t.Translation)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *EncryptedPassportElement) Equal(other *EncryptedPassportElement) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.Data, other.Data) &&
+		equalStringPtr(t.Email, other.Email) &&
+		equalPassportFileSlice(t.Files, other.Files) &&
+		t.FrontSide.Equal(other.FrontSide) &&
+		t.Hash == other.Hash &&
+		equalStringPtr(t.PhoneNumber, other.PhoneNumber) &&
+		t.ReverseSide.Equal(other.ReverseSide) &&
+		t.Selfie.Equal(other.Selfie) &&
+		equalPassportFileSlice(t.Translation, other.Translation) &&
+		t.Type == other.Type
+}
+
 // File
 // This object represents a file ready to be downloaded. The file can be downloaded via the link
 // https://api.telegram.org/file/bot<token>/<file_path>. It is guaranteed that the link will be
@@ -2798,6 +4468,24 @@ type File struct {
 	FileSize *int64 `json:"file_size,omitempty"`
 }
 
+// NewFile creates File with the required fields.
+func NewFile(fileID string, fileUniqueID string) *File {
+	return &File{
+		FileID:       fileID,
+		FileUniqueID: fileUniqueID,
+	}
+}
+
+func (t *File) SetFilePath(filePath string) *File {
+	t.FilePath = &filePath
+	return t
+}
+
+func (t *File) SetFileSize(fileSize int64) *File {
+	t.FileSize = &fileSize
+	return t
+}
+
 func (t *File) GetFileID() string {
 	var res string
 	if t == nil {
@@ -2836,6 +4524,28 @@ func (t *File) GetFileUniqueID() string {
 	return t.FileUniqueID
 }
 
+// Clone returns a deep copy of t.
+func (t *File) Clone() *File {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.FilePath = cloneStringPtr(t.FilePath)
+	res.FileSize = cloneInt64Ptr(t.FileSize)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *File) Equal(other *File) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.FileID == other.FileID &&
+		equalStringPtr(t.FilePath, other.FilePath) &&
+		equalInt64Ptr(t.FileSize, other.FileSize) &&
+		t.FileUniqueID == other.FileUniqueID
+}
+
 // ForceReply
 // Upon receiving a message with this object, Telegram clients will display a reply interface to
 // the user (act as if the user has selected the bot's message and tapped 'Reply'). This can be
@@ -2856,6 +4566,23 @@ type ForceReply struct {
 	Selective *bool `json:"selective,omitempty"`
 }
 
+// NewForceReply creates ForceReply with the required fields.
+func NewForceReply(forceReply True) *ForceReply {
+	return &ForceReply{
+		ForceReply: forceReply,
+	}
+}
+
+func (t *ForceReply) SetInputFieldPlaceholder(inputFieldPlaceholder string) *ForceReply {
+	t.InputFieldPlaceholder = &inputFieldPlaceholder
+	return t
+}
+
+func (t *ForceReply) SetSelective(selective bool) *ForceReply {
+	t.Selective = &selective
+	return t
+}
+
 func (t *ForceReply) GetForceReply() *True {
 	if t == nil {
 		return nil
@@ -2885,6 +4612,27 @@ func (t *ForceReply) GetSelective() bool {
 	return res
 }
 
+// Clone returns a deep copy of t.
+func (t *ForceReply) Clone() *ForceReply {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.InputFieldPlaceholder = cloneStringPtr(t.InputFieldPlaceholder)
+	res.Selective = cloneBoolPtr(t.Selective)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *ForceReply) Equal(other *ForceReply) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.ForceReply == other.ForceReply &&
+		equalStringPtr(t.InputFieldPlaceholder, other.InputFieldPlaceholder) &&
+		equalBoolPtr(t.Selective, other.Selective)
+}
+
 // ForumTopic
 // This object represents a forum topic.
 type ForumTopic struct {
@@ -2902,6 +4650,20 @@ type ForumTopic struct {
 	IconCustomEmojiID *string `json:"icon_custom_emoji_id,omitempty"`
 }
 
+// NewForumTopic creates ForumTopic with the required fields.
+func NewForumTopic(iconColor int64, messageThreadID int64, name string) *ForumTopic {
+	return &ForumTopic{
+		IconColor:       iconColor,
+		MessageThreadID: messageThreadID,
+		Name:            name,
+	}
+}
+
+func (t *ForumTopic) SetIconCustomEmojiID(iconCustomEmojiID string) *ForumTopic {
+	t.IconCustomEmojiID = &iconCustomEmojiID
+	return t
+}
+
 func (t *ForumTopic) GetIconColor() int64 {
 	var res int64
 	if t == nil {
@@ -2937,6 +4699,27 @@ func (t *ForumTopic) GetName() string {
 	return t.Name
 }
 
+// Clone returns a deep copy of t.
+func (t *ForumTopic) Clone() *ForumTopic {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.IconCustomEmojiID = cloneStringPtr(t.IconCustomEmojiID)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *ForumTopic) Equal(other *ForumTopic) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.IconColor == other.IconColor &&
+		equalStringPtr(t.IconCustomEmojiID, other.IconCustomEmojiID) &&
+		t.MessageThreadID == other.MessageThreadID &&
+		t.Name == other.Name
+}
+
 // ForumTopicClosed
 // This object represents a service message about a forum topic closed in the chat. Currently holds
 // no information.
@@ -2972,6 +4755,26 @@ func (t *ForumTopicClosed) GetName() string {
 	return res
 }
 
+// Clone returns a deep copy of t.
+func (t *ForumTopicClosed) Clone() *ForumTopicClosed {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.IconCustomEmojiID = cloneStringPtr(t.IconCustomEmojiID)
+	res.Name = cloneStringPtr(t.Name)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *ForumTopicClosed) Equal(other *ForumTopicClosed) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.IconCustomEmojiID, other.IconCustomEmojiID) &&
+		equalStringPtr(t.Name, other.Name)
+}
+
 // ForumTopicCreated
 // This object represents a service message about a new forum topic created in the chat.
 type ForumTopicCreated struct {
@@ -2986,6 +4789,19 @@ type ForumTopicCreated struct {
 	IconCustomEmojiID *string `json:"icon_custom_emoji_id,omitempty"`
 }
 
+// NewForumTopicCreated creates ForumTopicCreated with the required fields.
+func NewForumTopicCreated(iconColor int64, name string) *ForumTopicCreated {
+	return &ForumTopicCreated{
+		IconColor: iconColor,
+		Name:      name,
+	}
+}
+
+func (t *ForumTopicCreated) SetIconCustomEmojiID(iconCustomEmojiID string) *ForumTopicCreated {
+	t.IconCustomEmojiID = &iconCustomEmojiID
+	return t
+}
+
 func (t *ForumTopicCreated) GetIconColor() int64 {
 	var res int64
 	if t == nil {
@@ -3013,6 +4829,26 @@ func (t *ForumTopicCreated) GetName() string {
 	return t.Name
 }
 
+// Clone returns a deep copy of t.
+func (t *ForumTopicCreated) Clone() *ForumTopicCreated {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.IconCustomEmojiID = cloneStringPtr(t.IconCustomEmojiID)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *ForumTopicCreated) Equal(other *ForumTopicCreated) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.IconColor == other.IconColor &&
+		equalStringPtr(t.IconCustomEmojiID, other.IconCustomEmojiID) &&
+		t.Name == other.Name
+}
+
 // ForumTopicEdited
 // This object represents a service message about an edited forum topic.
 type ForumTopicEdited struct {
@@ -3047,6 +4883,26 @@ func (t *ForumTopicEdited) GetName() string {
 	return res
 }
 
+// Clone returns a deep copy of t.
+func (t *ForumTopicEdited) Clone() *ForumTopicEdited {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.IconCustomEmojiID = cloneStringPtr(t.IconCustomEmojiID)
+	res.Name = cloneStringPtr(t.Name)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *ForumTopicEdited) Equal(other *ForumTopicEdited) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.IconCustomEmojiID, other.IconCustomEmojiID) &&
+		equalStringPtr(t.Name, other.Name)
+}
+
 // ForumTopicReopened
 // This object represents a service message about a forum topic reopened in the chat. Currently
 // holds no information.
@@ -3063,6 +4919,14 @@ type ForumTopicReopened struct {
 	UserID int64 `json:"user_id"`
 }
 
+// NewForumTopicReopened creates ForumTopicReopened with the required fields.
+func NewForumTopicReopened(requestID int64, userID int64) *ForumTopicReopened {
+	return &ForumTopicReopened{
+		RequestID: requestID,
+		UserID:    userID,
+	}
+}
+
 func (t *ForumTopicReopened) GetRequestID() int64 {
 	var res int64
 	if t == nil {
@@ -3079,6 +4943,24 @@ func (t *ForumTopicReopened) GetUserID() int64 {
 	return t.UserID
 }
 
+// Clone returns a deep copy of t.
+func (t *ForumTopicReopened) Clone() *ForumTopicReopened {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *ForumTopicReopened) Equal(other *ForumTopicReopened) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.RequestID == other.RequestID &&
+		t.UserID == other.UserID
+}
+
 // Game
 // This object represents a game. Use BotFather to create and edit games, their short names will
 // act as unique identifiers.
@@ -3105,6 +4987,30 @@ type Game struct {
 	TextEntities []MessageEntity `json:"text_entities,omitempty"`
 }
 
+// NewGame creates Game with the required fields.
+func NewGame(description string, photo []PhotoSize, title string) *Game {
+	return &Game{
+		Description: description,
+		Photo:       photo,
+		Title:       title,
+	}
+}
+
+func (t *Game) SetAnimation(animation Animation) *Game {
+	t.Animation = &animation
+	return t
+}
+
+func (t *Game) SetText(text string) *Game {
+	t.Text = &text
+	return t
+}
+
+func (t *Game) SetTextEntities(textEntities []MessageEntity) *Game {
+	t.TextEntities = textEntities
+	return t
+}
+
 func (t *Game) GetAnimation() *Animation {
 	if t == nil {
 		return nil
@@ -3139,6 +5045,32 @@ func (t *Game) GetTitle() string {
 	return t.Title
 }
 
+// Clone returns a deep copy of t.
+func (t *Game) Clone() *Game {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Animation = t.Animation.Clone()
+	res.Photo = clonePhotoSizeSlice(t.Photo)
+	res.Text = cloneStringPtr(t.Text)
+	res.TextEntities = cloneMessageEntitySlice(t.TextEntities)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *Game) Equal(other *Game) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Animation.Equal(other.Animation) &&
+		t.Description == other.Description &&
+		equalPhotoSizeSlice(t.Photo, other.Photo) &&
+		equalStringPtr(t.Text, other.Text) &&
+		equalMessageEntitySlice(t.TextEntities, other.TextEntities) &&
+		t.Title == other.Title
+}
+
 // GameHighScore
 // This object represents one row of the high scores table for a game.
 type GameHighScore struct {
@@ -3153,6 +5085,15 @@ type GameHighScore struct {
 	User User `json:"user"`
 }
 
+// NewGameHighScore creates GameHighScore with the required fields.
+func NewGameHighScore(position int64, score int64, user User) *GameHighScore {
+	return &GameHighScore{
+		Position: position,
+		Score:    score,
+		User:     user,
+	}
+}
+
 func (t *GameHighScore) GetPosition() int64 {
 	var res int64
 	if t == nil {
@@ -3176,6 +5117,26 @@ func (t *GameHighScore) GetUser() *User {
 	return &t.User
 }
 
+// Clone returns a deep copy of t.
+func (t *GameHighScore) Clone() *GameHighScore {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.User = *t.User.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *GameHighScore) Equal(other *GameHighScore) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Position == other.Position &&
+		t.Score == other.Score &&
+		t.User.Equal(&other.User)
+}
+
 // Games
 // Your bot can offer users HTML5 games to play solo or to compete against each other in groups and
 // one-on-one chats. Create games via @BotFather using the /newgame command. Please note that this
@@ -3212,6 +5173,44 @@ type Games struct {
 	ReplyToMessageID *int64 `json:"reply_to_message_id,omitempty"`
 }
 
+// NewGames creates Games with the required fields.
+func NewGames(chatID int64, gameShortName string) *Games {
+	return &Games{
+		ChatID:        chatID,
+		GameShortName: gameShortName,
+	}
+}
+
+func (t *Games) SetAllowSendingWithoutReply(allowSendingWithoutReply bool) *Games {
+	t.AllowSendingWithoutReply = &allowSendingWithoutReply
+	return t
+}
+
+func (t *Games) SetDisableNotification(disableNotification bool) *Games {
+	t.DisableNotification = &disableNotification
+	return t
+}
+
+func (t *Games) SetMessageThreadID(messageThreadID int64) *Games {
+	t.MessageThreadID = &messageThreadID
+	return t
+}
+
+func (t *Games) SetProtectContent(protectContent bool) *Games {
+	t.ProtectContent = &protectContent
+	return t
+}
+
+func (t *Games) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *Games {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
+func (t *Games) SetReplyToMessageID(replyToMessageID int64) *Games {
+	t.ReplyToMessageID = &replyToMessageID
+	return t
+}
+
 func (t *Games) GetAllowSendingWithoutReply() bool {
 	var res bool
 	if t == nil {
@@ -3290,6 +5289,36 @@ func (t *Games) GetReplyToMessageID() int64 {
 	return res
 }
 
+// Clone returns a deep copy of t.
+func (t *Games) Clone() *Games {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.AllowSendingWithoutReply = cloneBoolPtr(t.AllowSendingWithoutReply)
+	res.DisableNotification = cloneBoolPtr(t.DisableNotification)
+	res.MessageThreadID = cloneInt64Ptr(t.MessageThreadID)
+	res.ProtectContent = cloneBoolPtr(t.ProtectContent)
+	res.ReplyMarkup = t.ReplyMarkup.Clone()
+	res.ReplyToMessageID = cloneInt64Ptr(t.ReplyToMessageID)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *Games) Equal(other *Games) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalBoolPtr(t.AllowSendingWithoutReply, other.AllowSendingWithoutReply) &&
+		t.ChatID == other.ChatID &&
+		equalBoolPtr(t.DisableNotification, other.DisableNotification) &&
+		t.GameShortName == other.GameShortName &&
+		equalInt64Ptr(t.MessageThreadID, other.MessageThreadID) &&
+		equalBoolPtr(t.ProtectContent, other.ProtectContent) &&
+		t.ReplyMarkup.Equal(other.ReplyMarkup) &&
+		equalInt64Ptr(t.ReplyToMessageID, other.ReplyToMessageID)
+}
+
 // GeneralForumTopicHidden
 // This object represents a service message about General forum topic hidden in the chat. Currently
 // holds no information.
@@ -3306,6 +5335,14 @@ type GeneralForumTopicHidden struct {
 	UserID int64 `json:"user_id"`
 }
 
+// NewGeneralForumTopicHidden creates GeneralForumTopicHidden with the required fields.
+func NewGeneralForumTopicHidden(requestID int64, userID int64) *GeneralForumTopicHidden {
+	return &GeneralForumTopicHidden{
+		RequestID: requestID,
+		UserID:    userID,
+	}
+}
+
 func (t *GeneralForumTopicHidden) GetRequestID() int64 {
 	var res int64
 	if t == nil {
@@ -3322,6 +5359,24 @@ func (t *GeneralForumTopicHidden) GetUserID() int64 {
 	return t.UserID
 }
 
+// Clone returns a deep copy of t.
+func (t *GeneralForumTopicHidden) Clone() *GeneralForumTopicHidden {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *GeneralForumTopicHidden) Equal(other *GeneralForumTopicHidden) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.RequestID == other.RequestID &&
+		t.UserID == other.UserID
+}
+
 // GeneralForumTopicUnhidden
 // This object represents a service message about General forum topic unhidden in the chat.
 // Currently holds no information.
@@ -3338,6 +5393,14 @@ type GeneralForumTopicUnhidden struct {
 	UserID int64 `json:"user_id"`
 }
 
+// NewGeneralForumTopicUnhidden creates GeneralForumTopicUnhidden with the required fields.
+func NewGeneralForumTopicUnhidden(requestID int64, userID int64) *GeneralForumTopicUnhidden {
+	return &GeneralForumTopicUnhidden{
+		RequestID: requestID,
+		UserID:    userID,
+	}
+}
+
 func (t *GeneralForumTopicUnhidden) GetRequestID() int64 {
 	var res int64
 	if t == nil {
@@ -3354,6 +5417,24 @@ func (t *GeneralForumTopicUnhidden) GetUserID() int64 {
 	return t.UserID
 }
 
+// Clone returns a deep copy of t.
+func (t *GeneralForumTopicUnhidden) Clone() *GeneralForumTopicUnhidden {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *GeneralForumTopicUnhidden) Equal(other *GeneralForumTopicUnhidden) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.RequestID == other.RequestID &&
+		t.UserID == other.UserID
+}
+
 // InlineKeyboardButton
 // This object represents one button of an inline keyboard. You must use exactly one of the
 // optional fields.
@@ -3408,6 +5489,58 @@ type InlineKeyboardButton struct {
 	WebApp *WebAppInfo `json:"web_app,omitempty"`
 }
 
+// NewInlineKeyboardButton creates InlineKeyboardButton with the required fields.
+func NewInlineKeyboardButton(text string) *InlineKeyboardButton {
+	return &InlineKeyboardButton{
+		Text: text,
+	}
+}
+
+func (t *InlineKeyboardButton) SetCallbackData(callbackData string) *InlineKeyboardButton {
+	t.CallbackData = &callbackData
+	return t
+}
+
+func (t *InlineKeyboardButton) SetCallbackGame(callbackGame CallbackGame) *InlineKeyboardButton {
+	t.CallbackGame = &callbackGame
+	return t
+}
+
+func (t *InlineKeyboardButton) SetLoginURL(loginURL LoginURL) *InlineKeyboardButton {
+	t.LoginURL = &loginURL
+	return t
+}
+
+func (t *InlineKeyboardButton) SetPay(pay bool) *InlineKeyboardButton {
+	t.Pay = &pay
+	return t
+}
+
+func (t *InlineKeyboardButton) SetSwitchInlineQuery(switchInlineQuery string) *InlineKeyboardButton {
+	t.SwitchInlineQuery = &switchInlineQuery
+	return t
+}
+
+func (t *InlineKeyboardButton) SetSwitchInlineQueryChosenChat(switchInlineQueryChosenChat SwitchInlineQueryChosenChat) *InlineKeyboardButton {
+	t.SwitchInlineQueryChosenChat = &switchInlineQueryChosenChat
+	return t
+}
+
+func (t *InlineKeyboardButton) SetSwitchInlineQueryCurrentChat(switchInlineQueryCurrentChat string) *InlineKeyboardButton {
+	t.SwitchInlineQueryCurrentChat = &switchInlineQueryCurrentChat
+	return t
+}
+
+func (t *InlineKeyboardButton) SetURL(url string) *InlineKeyboardButton {
+	t.URL = &url
+	return t
+}
+
+func (t *InlineKeyboardButton) SetWebApp(webApp WebAppInfo) *InlineKeyboardButton {
+	t.WebApp = &webApp
+	return t
+}
+
 func (t *InlineKeyboardButton) GetCallbackData() string {
 	var res string
 	if t == nil {
@@ -3499,6 +5632,41 @@ func (t *InlineKeyboardButton) GetWebApp() *WebAppInfo {
 	return t.WebApp
 }
 
+// Clone returns a deep copy of t.
+func (t *InlineKeyboardButton) Clone() *InlineKeyboardButton {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.CallbackData = cloneStringPtr(t.CallbackData)
+	res.CallbackGame = t.CallbackGame.Clone()
+	res.LoginURL = t.LoginURL.Clone()
+	res.Pay = cloneBoolPtr(t.Pay)
+	res.SwitchInlineQuery = cloneStringPtr(t.SwitchInlineQuery)
+	res.SwitchInlineQueryChosenChat = t.SwitchInlineQueryChosenChat.Clone()
+	res.SwitchInlineQueryCurrentChat = cloneStringPtr(t.SwitchInlineQueryCurrentChat)
+	res.URL = cloneStringPtr(t.URL)
+	res.WebApp = t.WebApp.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InlineKeyboardButton) Equal(other *InlineKeyboardButton) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.CallbackData, other.CallbackData) &&
+		t.CallbackGame.Equal(other.CallbackGame) &&
+		t.LoginURL.Equal(other.LoginURL) &&
+		equalBoolPtr(t.Pay, other.Pay) &&
+		equalStringPtr(t.SwitchInlineQuery, other.SwitchInlineQuery) &&
+		t.SwitchInlineQueryChosenChat.Equal(other.SwitchInlineQueryChosenChat) &&
+		equalStringPtr(t.SwitchInlineQueryCurrentChat, other.SwitchInlineQueryCurrentChat) &&
+		t.Text == other.Text &&
+		equalStringPtr(t.URL, other.URL) &&
+		t.WebApp.Equal(other.WebApp)
+}
+
 // InlineKeyboardMarkup
 // This object represents an inline keyboard that appears right next to the message it belongs to.
 type InlineKeyboardMarkup struct {
@@ -3507,6 +5675,31 @@ type InlineKeyboardMarkup struct {
 	InlineKeyboard [][]InlineKeyboardButton `json:"inline_keyboard"`
 }
 
+// NewInlineKeyboardMarkup creates InlineKeyboardMarkup with the required fields.
+func NewInlineKeyboardMarkup(inlineKeyboard [][]InlineKeyboardButton) *InlineKeyboardMarkup {
+	return &InlineKeyboardMarkup{
+		InlineKeyboard: inlineKeyboard,
+	}
+}
+
+// Clone returns a deep copy of t.
+func (t *InlineKeyboardMarkup) Clone() *InlineKeyboardMarkup {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.InlineKeyboard = cloneInlineKeyboardButtonSliceSlice(t.InlineKeyboard)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InlineKeyboardMarkup) Equal(other *InlineKeyboardMarkup) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalInlineKeyboardButtonSliceSlice(t.InlineKeyboard, other.InlineKeyboard)
+}
+
 // InlineQuery
 // This object represents an incoming inline query. When the user sends an empty query, your bot
 // could return some default or trending results.
@@ -3528,21 +5721,37 @@ type InlineQuery struct {
 	// chat with the inline query sender, "private", "group", "supergroup", or "channel". The chat
 	// type should be always known for requests sent from official clients and most third-party
 	// clients, unless the request was sent from a secret chat
-	ChatType *string `json:"chat_type,omitempty"`
+	ChatType *ChatType `json:"chat_type,omitempty"`
 	// Location
 	// Sender location, only for bots that request user location
 	Location *Location `json:"location,omitempty"`
 }
 
-func (t *InlineQuery) GetChatType() string {
-	var res string
-	if t == nil {
-		return res
+// NewInlineQuery creates InlineQuery with the required fields.
+func NewInlineQuery(from User, id string, offset string, query string) *InlineQuery {
+	return &InlineQuery{
+		From:   from,
+		ID:     id,
+		Offset: offset,
+		Query:  query,
 	}
-	if field := t.ChatType; field != nil {
-		return *field
+}
+
+func (t *InlineQuery) SetChatType(chatType ChatType) *InlineQuery {
+	t.ChatType = &chatType
+	return t
+}
+
+func (t *InlineQuery) SetLocation(location Location) *InlineQuery {
+	t.Location = &location
+	return t
+}
+
+func (t *InlineQuery) GetChatType() *ChatType {
+	if t == nil {
+		return nil
 	}
-	return res
+	return t.ChatType
 }
 
 func (t *InlineQuery) GetFrom() *User {
@@ -3583,6 +5792,31 @@ func (t *InlineQuery) GetQuery() string {
 	return t.Query
 }
 
+// Clone returns a deep copy of t.
+func (t *InlineQuery) Clone() *InlineQuery {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.ChatType = cloneChatTypePtr(t.ChatType)
+	res.From = *t.From.Clone()
+	res.Location = t.Location.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InlineQuery) Equal(other *InlineQuery) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalChatTypePtr(t.ChatType, other.ChatType) &&
+		t.From.Equal(&other.From) &&
+		t.ID == other.ID &&
+		t.Location.Equal(other.Location) &&
+		t.Offset == other.Offset &&
+		t.Query == other.Query
+}
+
 // InlineQueryResult
 // This object represents one result of an inline query. Telegram clients currently support results
 // of the following 20 types:
@@ -3622,6 +5856,51 @@ type InlineQueryResult struct {
 	URL *string `json:"url,omitempty"`
 }
 
+// NewInlineQueryResult creates InlineQueryResult with the required fields.
+func NewInlineQueryResult(id string, inputMessageContent InputMessageContent, title string) *InlineQueryResult {
+	return &InlineQueryResult{
+		ID:                  id,
+		InputMessageContent: inputMessageContent,
+		Title:               title,
+		Type:                InlineTypeArticle,
+	}
+}
+
+func (t *InlineQueryResult) SetDescription(description string) *InlineQueryResult {
+	t.Description = &description
+	return t
+}
+
+func (t *InlineQueryResult) SetHideURL(hideURL bool) *InlineQueryResult {
+	t.HideURL = &hideURL
+	return t
+}
+
+func (t *InlineQueryResult) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *InlineQueryResult {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
+func (t *InlineQueryResult) SetThumbnailHeight(thumbnailHeight int64) *InlineQueryResult {
+	t.ThumbnailHeight = &thumbnailHeight
+	return t
+}
+
+func (t *InlineQueryResult) SetThumbnailURL(thumbnailURL string) *InlineQueryResult {
+	t.ThumbnailURL = &thumbnailURL
+	return t
+}
+
+func (t *InlineQueryResult) SetThumbnailWidth(thumbnailWidth int64) *InlineQueryResult {
+	t.ThumbnailWidth = &thumbnailWidth
+	return t
+}
+
+func (t *InlineQueryResult) SetURL(url string) *InlineQueryResult {
+	t.URL = &url
+	return t
+}
+
 func (t *InlineQueryResult) GetDescription() string {
 	var res string
 	if t == nil {
@@ -3725,6 +6004,41 @@ func (t *InlineQueryResult) GetURL() string {
 	return res
 }
 
+// Clone returns a deep copy of t.
+func (t *InlineQueryResult) Clone() *InlineQueryResult {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Description = cloneStringPtr(t.Description)
+	res.HideURL = cloneBoolPtr(t.HideURL)
+	res.InputMessageContent = *t.InputMessageContent.Clone()
+	res.ReplyMarkup = t.ReplyMarkup.Clone()
+	res.ThumbnailHeight = cloneInt64Ptr(t.ThumbnailHeight)
+	res.ThumbnailURL = cloneStringPtr(t.ThumbnailURL)
+	res.ThumbnailWidth = cloneInt64Ptr(t.ThumbnailWidth)
+	res.URL = cloneStringPtr(t.URL)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InlineQueryResult) Equal(other *InlineQueryResult) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.Description, other.Description) &&
+		equalBoolPtr(t.HideURL, other.HideURL) &&
+		t.ID == other.ID &&
+		t.InputMessageContent.Equal(&other.InputMessageContent) &&
+		t.ReplyMarkup.Equal(other.ReplyMarkup) &&
+		equalInt64Ptr(t.ThumbnailHeight, other.ThumbnailHeight) &&
+		equalStringPtr(t.ThumbnailURL, other.ThumbnailURL) &&
+		equalInt64Ptr(t.ThumbnailWidth, other.ThumbnailWidth) &&
+		t.Title == other.Title &&
+		t.Type == other.Type &&
+		equalStringPtr(t.URL, other.URL)
+}
+
 // InlineQueryResultArticle
 // Represents a link to an article or web page.
 type InlineQueryResultArticle struct {
@@ -3763,6 +6077,51 @@ type InlineQueryResultArticle struct {
 	URL *string `json:"url,omitempty"`
 }
 
+// NewInlineQueryResultArticle creates InlineQueryResultArticle with the required fields.
+func NewInlineQueryResultArticle(id string, inputMessageContent InputMessageContent, title string) *InlineQueryResultArticle {
+	return &InlineQueryResultArticle{
+		ID:                  id,
+		InputMessageContent: inputMessageContent,
+		Title:               title,
+		Type:                InlineTypeArticle,
+	}
+}
+
+func (t *InlineQueryResultArticle) SetDescription(description string) *InlineQueryResultArticle {
+	t.Description = &description
+	return t
+}
+
+func (t *InlineQueryResultArticle) SetHideURL(hideURL bool) *InlineQueryResultArticle {
+	t.HideURL = &hideURL
+	return t
+}
+
+func (t *InlineQueryResultArticle) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *InlineQueryResultArticle {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
+func (t *InlineQueryResultArticle) SetThumbnailHeight(thumbnailHeight int64) *InlineQueryResultArticle {
+	t.ThumbnailHeight = &thumbnailHeight
+	return t
+}
+
+func (t *InlineQueryResultArticle) SetThumbnailURL(thumbnailURL string) *InlineQueryResultArticle {
+	t.ThumbnailURL = &thumbnailURL
+	return t
+}
+
+func (t *InlineQueryResultArticle) SetThumbnailWidth(thumbnailWidth int64) *InlineQueryResultArticle {
+	t.ThumbnailWidth = &thumbnailWidth
+	return t
+}
+
+func (t *InlineQueryResultArticle) SetURL(url string) *InlineQueryResultArticle {
+	t.URL = &url
+	return t
+}
+
 func (t *InlineQueryResultArticle) GetDescription() string {
 	var res string
 	if t == nil {
@@ -3866,6 +6225,41 @@ func (t *InlineQueryResultArticle) GetURL() string {
 	return res
 }
 
+// Clone returns a deep copy of t.
+func (t *InlineQueryResultArticle) Clone() *InlineQueryResultArticle {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Description = cloneStringPtr(t.Description)
+	res.HideURL = cloneBoolPtr(t.HideURL)
+	res.InputMessageContent = *t.InputMessageContent.Clone()
+	res.ReplyMarkup = t.ReplyMarkup.Clone()
+	res.ThumbnailHeight = cloneInt64Ptr(t.ThumbnailHeight)
+	res.ThumbnailURL = cloneStringPtr(t.ThumbnailURL)
+	res.ThumbnailWidth = cloneInt64Ptr(t.ThumbnailWidth)
+	res.URL = cloneStringPtr(t.URL)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InlineQueryResultArticle) Equal(other *InlineQueryResultArticle) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.Description, other.Description) &&
+		equalBoolPtr(t.HideURL, other.HideURL) &&
+		t.ID == other.ID &&
+		t.InputMessageContent.Equal(&other.InputMessageContent) &&
+		t.ReplyMarkup.Equal(other.ReplyMarkup) &&
+		equalInt64Ptr(t.ThumbnailHeight, other.ThumbnailHeight) &&
+		equalStringPtr(t.ThumbnailURL, other.ThumbnailURL) &&
+		equalInt64Ptr(t.ThumbnailWidth, other.ThumbnailWidth) &&
+		t.Title == other.Title &&
+		t.Type == other.Type &&
+		equalStringPtr(t.URL, other.URL)
+}
+
 // InlineQueryResultAudio
 // Represents a link to an MP3 audio file. By default, this audio file will be sent by the user.
 // Alternatively, you can use input_message_content to send a message with the specified content
@@ -3898,7 +6292,7 @@ type InlineQueryResultAudio struct {
 	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
 	// ParseMode
 	// Mode for parsing entities in the audio caption. See formatting options for more details.
-	ParseMode *string `json:"parse_mode,omitempty"`
+	ParseMode *ParseMode `json:"parse_mode,omitempty"`
 	// Performer
 	// Performer
 	Performer *string `json:"performer,omitempty"`
@@ -3907,6 +6301,51 @@ type InlineQueryResultAudio struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewInlineQueryResultAudio creates InlineQueryResultAudio with the required fields.
+func NewInlineQueryResultAudio(audioURL string, id string, title string) *InlineQueryResultAudio {
+	return &InlineQueryResultAudio{
+		AudioURL: audioURL,
+		ID:       id,
+		Title:    title,
+		Type:     InlineTypeAudio,
+	}
+}
+
+func (t *InlineQueryResultAudio) SetAudioDuration(audioDuration int64) *InlineQueryResultAudio {
+	t.AudioDuration = &audioDuration
+	return t
+}
+
+func (t *InlineQueryResultAudio) SetCaption(caption string) *InlineQueryResultAudio {
+	t.Caption = &caption
+	return t
+}
+
+func (t *InlineQueryResultAudio) SetCaptionEntities(captionEntities []MessageEntity) *InlineQueryResultAudio {
+	t.CaptionEntities = captionEntities
+	return t
+}
+
+func (t *InlineQueryResultAudio) SetInputMessageContent(inputMessageContent InputMessageContent) *InlineQueryResultAudio {
+	t.InputMessageContent = &inputMessageContent
+	return t
+}
+
+func (t *InlineQueryResultAudio) SetParseMode(parseMode ParseMode) *InlineQueryResultAudio {
+	t.ParseMode = &parseMode
+	return t
+}
+
+func (t *InlineQueryResultAudio) SetPerformer(performer string) *InlineQueryResultAudio {
+	t.Performer = &performer
+	return t
+}
+
+func (t *InlineQueryResultAudio) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *InlineQueryResultAudio {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
 func (t *InlineQueryResultAudio) GetAudioDuration() int64 {
 	var res int64
 	if t == nil {
@@ -3952,15 +6391,11 @@ func (t *InlineQueryResultAudio) GetInputMessageContent() *InputMessageContent {
 	return t.InputMessageContent
 }
 
-func (t *InlineQueryResultAudio) GetParseMode() string {
-	var res string
+func (t *InlineQueryResultAudio) GetParseMode() *ParseMode {
 	if t == nil {
-		return res
-	}
-	if field := t.ParseMode; field != nil {
-		return *field
+		return nil
 	}
-	return res
+	return t.ParseMode
 }
 
 func (t *InlineQueryResultAudio) GetPerformer() string {
@@ -3996,6 +6431,40 @@ func (t *InlineQueryResultAudio) GetType() *InlineType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *InlineQueryResultAudio) Clone() *InlineQueryResultAudio {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.AudioDuration = cloneInt64Ptr(t.AudioDuration)
+	res.Caption = cloneStringPtr(t.Caption)
+	res.CaptionEntities = cloneMessageEntitySlice(t.CaptionEntities)
+	res.InputMessageContent = t.InputMessageContent.Clone()
+	res.ParseMode = cloneParseModePtr(t.ParseMode)
+	res.Performer = cloneStringPtr(t.Performer)
+	res.ReplyMarkup = t.ReplyMarkup.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InlineQueryResultAudio) Equal(other *InlineQueryResultAudio) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalInt64Ptr(t.AudioDuration, other.AudioDuration) &&
+		t.AudioURL == other.AudioURL &&
+		equalStringPtr(t.Caption, other.Caption) &&
+		equalMessageEntitySlice(t.CaptionEntities, other.CaptionEntities) &&
+		t.ID == other.ID &&
+		t.InputMessageContent.Equal(other.InputMessageContent) &&
+		equalParseModePtr(t.ParseMode, other.ParseMode) &&
+		equalStringPtr(t.Performer, other.Performer) &&
+		t.ReplyMarkup.Equal(other.ReplyMarkup) &&
+		t.Title == other.Title &&
+		t.Type == other.Type
+}
+
 // InlineQueryResultCachedAudio
 // Represents a link to an MP3 audio file stored on the Telegram servers. By default, this audio
 // file will be sent by the user. Alternatively, you can use input_message_content to send a
@@ -4022,12 +6491,46 @@ type InlineQueryResultCachedAudio struct {
 	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
 	// ParseMode
 	// Mode for parsing entities in the audio caption. See formatting options for more details.
-	ParseMode *string `json:"parse_mode,omitempty"`
+	ParseMode *ParseMode `json:"parse_mode,omitempty"`
 	// ReplyMarkup
 	// Inline keyboard attached to the message
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewInlineQueryResultCachedAudio creates InlineQueryResultCachedAudio with the required fields.
+func NewInlineQueryResultCachedAudio(audioFileID string, id string) *InlineQueryResultCachedAudio {
+	return &InlineQueryResultCachedAudio{
+		AudioFileID: audioFileID,
+		ID:          id,
+		Type:        InlineTypeAudio,
+	}
+}
+
+func (t *InlineQueryResultCachedAudio) SetCaption(caption string) *InlineQueryResultCachedAudio {
+	t.Caption = &caption
+	return t
+}
+
+func (t *InlineQueryResultCachedAudio) SetCaptionEntities(captionEntities []MessageEntity) *InlineQueryResultCachedAudio {
+	t.CaptionEntities = captionEntities
+	return t
+}
+
+func (t *InlineQueryResultCachedAudio) SetInputMessageContent(inputMessageContent InputMessageContent) *InlineQueryResultCachedAudio {
+	t.InputMessageContent = &inputMessageContent
+	return t
+}
+
+func (t *InlineQueryResultCachedAudio) SetParseMode(parseMode ParseMode) *InlineQueryResultCachedAudio {
+	t.ParseMode = &parseMode
+	return t
+}
+
+func (t *InlineQueryResultCachedAudio) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *InlineQueryResultCachedAudio {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
 func (t *InlineQueryResultCachedAudio) GetAudioFileID() string {
 	var res string
 	if t == nil {
@@ -4062,15 +6565,11 @@ func (t *InlineQueryResultCachedAudio) GetInputMessageContent() *InputMessageCon
 	return t.InputMessageContent
 }
 
-func (t *InlineQueryResultCachedAudio) GetParseMode() string {
-	var res string
+func (t *InlineQueryResultCachedAudio) GetParseMode() *ParseMode {
 	if t == nil {
-		return res
-	}
-	if field := t.ParseMode; field != nil {
-		return *field
+		return nil
 	}
-	return res
+	return t.ParseMode
 }
 
 func (t *InlineQueryResultCachedAudio) GetReplyMarkup() *InlineKeyboardMarkup {
@@ -4087,6 +6586,35 @@ func (t *InlineQueryResultCachedAudio) GetType() *InlineType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *InlineQueryResultCachedAudio) Clone() *InlineQueryResultCachedAudio {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Caption = cloneStringPtr(t.Caption)
+	res.CaptionEntities = cloneMessageEntitySlice(t.CaptionEntities)
+	res.InputMessageContent = t.InputMessageContent.Clone()
+	res.ParseMode = cloneParseModePtr(t.ParseMode)
+	res.ReplyMarkup = t.ReplyMarkup.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InlineQueryResultCachedAudio) Equal(other *InlineQueryResultCachedAudio) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.AudioFileID == other.AudioFileID &&
+		equalStringPtr(t.Caption, other.Caption) &&
+		equalMessageEntitySlice(t.CaptionEntities, other.CaptionEntities) &&
+		t.ID == other.ID &&
+		t.InputMessageContent.Equal(other.InputMessageContent) &&
+		equalParseModePtr(t.ParseMode, other.ParseMode) &&
+		t.ReplyMarkup.Equal(other.ReplyMarkup) &&
+		t.Type == other.Type
+}
+
 // InlineQueryResultCachedDocument
 // Represents a link to a file stored on the Telegram servers. By default, this file will be sent
 // by the user with an optional caption. Alternatively, you can use input_message_content to send a
@@ -4119,29 +6647,69 @@ type InlineQueryResultCachedDocument struct {
 	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
 	// ParseMode
 	// Mode for parsing entities in the document caption. See formatting options for more details.
-	ParseMode *string `json:"parse_mode,omitempty"`
+	ParseMode *ParseMode `json:"parse_mode,omitempty"`
 	// ReplyMarkup
 	// Inline keyboard attached to the message
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
-func (t *InlineQueryResultCachedDocument) GetCaption() string {
-	var res string
-	if t == nil {
-		return res
-	}
-	if field := t.Caption; field != nil {
-		return *field
+// NewInlineQueryResultCachedDocument creates InlineQueryResultCachedDocument with the required fields.
+func NewInlineQueryResultCachedDocument(documentFileID string, id string, title string) *InlineQueryResultCachedDocument {
+	return &InlineQueryResultCachedDocument{
+		DocumentFileID: documentFileID,
+		ID:             id,
+		Title:          title,
+		Type:           InlineTypeDocument,
 	}
-	return res
 }
 
-func (t *InlineQueryResultCachedDocument) GetDescription() string {
-	var res string
-	if t == nil {
-		return res
-	}
-	if field := t.Description; field != nil {
+func (t *InlineQueryResultCachedDocument) SetCaption(caption string) *InlineQueryResultCachedDocument {
+	t.Caption = &caption
+	return t
+}
+
+func (t *InlineQueryResultCachedDocument) SetCaptionEntities(captionEntities []MessageEntity) *InlineQueryResultCachedDocument {
+	t.CaptionEntities = captionEntities
+	return t
+}
+
+func (t *InlineQueryResultCachedDocument) SetDescription(description string) *InlineQueryResultCachedDocument {
+	t.Description = &description
+	return t
+}
+
+func (t *InlineQueryResultCachedDocument) SetInputMessageContent(inputMessageContent InputMessageContent) *InlineQueryResultCachedDocument {
+	t.InputMessageContent = &inputMessageContent
+	return t
+}
+
+func (t *InlineQueryResultCachedDocument) SetParseMode(parseMode ParseMode) *InlineQueryResultCachedDocument {
+	t.ParseMode = &parseMode
+	return t
+}
+
+func (t *InlineQueryResultCachedDocument) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *InlineQueryResultCachedDocument {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
+func (t *InlineQueryResultCachedDocument) GetCaption() string {
+	var res string
+	if t == nil {
+		return res
+	}
+	if field := t.Caption; field != nil {
+		return *field
+	}
+	return res
+}
+
+func (t *InlineQueryResultCachedDocument) GetDescription() string {
+	var res string
+	if t == nil {
+		return res
+	}
+	if field := t.Description; field != nil {
 		return *field
 	}
 	return res
@@ -4170,15 +6738,11 @@ func (t *InlineQueryResultCachedDocument) GetInputMessageContent() *InputMessage
 	return t.InputMessageContent
 }
 
-func (t *InlineQueryResultCachedDocument) GetParseMode() string {
-	var res string
+func (t *InlineQueryResultCachedDocument) GetParseMode() *ParseMode {
 	if t == nil {
-		return res
-	}
-	if field := t.ParseMode; field != nil {
-		return *field
+		return nil
 	}
-	return res
+	return t.ParseMode
 }
 
 func (t *InlineQueryResultCachedDocument) GetReplyMarkup() *InlineKeyboardMarkup {
@@ -4203,6 +6767,38 @@ func (t *InlineQueryResultCachedDocument) GetType() *InlineType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *InlineQueryResultCachedDocument) Clone() *InlineQueryResultCachedDocument {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Caption = cloneStringPtr(t.Caption)
+	res.CaptionEntities = cloneMessageEntitySlice(t.CaptionEntities)
+	res.Description = cloneStringPtr(t.Description)
+	res.InputMessageContent = t.InputMessageContent.Clone()
+	res.ParseMode = cloneParseModePtr(t.ParseMode)
+	res.ReplyMarkup = t.ReplyMarkup.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InlineQueryResultCachedDocument) Equal(other *InlineQueryResultCachedDocument) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.Caption, other.Caption) &&
+		equalMessageEntitySlice(t.CaptionEntities, other.CaptionEntities) &&
+		equalStringPtr(t.Description, other.Description) &&
+		t.DocumentFileID == other.DocumentFileID &&
+		t.ID == other.ID &&
+		t.InputMessageContent.Equal(other.InputMessageContent) &&
+		equalParseModePtr(t.ParseMode, other.ParseMode) &&
+		t.ReplyMarkup.Equal(other.ReplyMarkup) &&
+		t.Title == other.Title &&
+		t.Type == other.Type
+}
+
 // InlineQueryResultCachedGif
 // Represents a link to an animated GIF file stored on the Telegram servers. By default, this
 // animated GIF file will be sent by the user with an optional caption. Alternatively, you can use
@@ -4229,7 +6825,7 @@ type InlineQueryResultCachedGif struct {
 	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
 	// ParseMode
 	// Mode for parsing entities in the caption. See formatting options for more details.
-	ParseMode *string `json:"parse_mode,omitempty"`
+	ParseMode *ParseMode `json:"parse_mode,omitempty"`
 	// ReplyMarkup
 	// Inline keyboard attached to the message
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
@@ -4238,6 +6834,45 @@ type InlineQueryResultCachedGif struct {
 	Title *string `json:"title,omitempty"`
 }
 
+// NewInlineQueryResultCachedGif creates InlineQueryResultCachedGif with the required fields.
+func NewInlineQueryResultCachedGif(gifFileID string, id string) *InlineQueryResultCachedGif {
+	return &InlineQueryResultCachedGif{
+		GifFileID: gifFileID,
+		ID:        id,
+		Type:      InlineTypeGif,
+	}
+}
+
+func (t *InlineQueryResultCachedGif) SetCaption(caption string) *InlineQueryResultCachedGif {
+	t.Caption = &caption
+	return t
+}
+
+func (t *InlineQueryResultCachedGif) SetCaptionEntities(captionEntities []MessageEntity) *InlineQueryResultCachedGif {
+	t.CaptionEntities = captionEntities
+	return t
+}
+
+func (t *InlineQueryResultCachedGif) SetInputMessageContent(inputMessageContent InputMessageContent) *InlineQueryResultCachedGif {
+	t.InputMessageContent = &inputMessageContent
+	return t
+}
+
+func (t *InlineQueryResultCachedGif) SetParseMode(parseMode ParseMode) *InlineQueryResultCachedGif {
+	t.ParseMode = &parseMode
+	return t
+}
+
+func (t *InlineQueryResultCachedGif) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *InlineQueryResultCachedGif {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
+func (t *InlineQueryResultCachedGif) SetTitle(title string) *InlineQueryResultCachedGif {
+	t.Title = &title
+	return t
+}
+
 func (t *InlineQueryResultCachedGif) GetCaption() string {
 	var res string
 	if t == nil {
@@ -4272,15 +6907,11 @@ func (t *InlineQueryResultCachedGif) GetInputMessageContent() *InputMessageConte
 	return t.InputMessageContent
 }
 
-func (t *InlineQueryResultCachedGif) GetParseMode() string {
-	var res string
+func (t *InlineQueryResultCachedGif) GetParseMode() *ParseMode {
 	if t == nil {
-		return res
-	}
-	if field := t.ParseMode; field != nil {
-		return *field
+		return nil
 	}
-	return res
+	return t.ParseMode
 }
 
 func (t *InlineQueryResultCachedGif) GetReplyMarkup() *InlineKeyboardMarkup {
@@ -4308,6 +6939,37 @@ func (t *InlineQueryResultCachedGif) GetType() *InlineType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *InlineQueryResultCachedGif) Clone() *InlineQueryResultCachedGif {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Caption = cloneStringPtr(t.Caption)
+	res.CaptionEntities = cloneMessageEntitySlice(t.CaptionEntities)
+	res.InputMessageContent = t.InputMessageContent.Clone()
+	res.ParseMode = cloneParseModePtr(t.ParseMode)
+	res.ReplyMarkup = t.ReplyMarkup.Clone()
+	res.Title = cloneStringPtr(t.Title)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InlineQueryResultCachedGif) Equal(other *InlineQueryResultCachedGif) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.Caption, other.Caption) &&
+		equalMessageEntitySlice(t.CaptionEntities, other.CaptionEntities) &&
+		t.GifFileID == other.GifFileID &&
+		t.ID == other.ID &&
+		t.InputMessageContent.Equal(other.InputMessageContent) &&
+		equalParseModePtr(t.ParseMode, other.ParseMode) &&
+		t.ReplyMarkup.Equal(other.ReplyMarkup) &&
+		equalStringPtr(t.Title, other.Title) &&
+		t.Type == other.Type
+}
+
 // InlineQueryResultCachedMpeg4Gif
 // Represents a link to a video animation (H.264/MPEG-4 AVC video without sound) stored on the
 // Telegram servers. By default, this animated MPEG-4 file will be sent by the user with an
@@ -4335,7 +6997,7 @@ type InlineQueryResultCachedMpeg4Gif struct {
 	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
 	// ParseMode
 	// Mode for parsing entities in the caption. See formatting options for more details.
-	ParseMode *string `json:"parse_mode,omitempty"`
+	ParseMode *ParseMode `json:"parse_mode,omitempty"`
 	// ReplyMarkup
 	// Inline keyboard attached to the message
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
@@ -4344,6 +7006,45 @@ type InlineQueryResultCachedMpeg4Gif struct {
 	Title *string `json:"title,omitempty"`
 }
 
+// NewInlineQueryResultCachedMpeg4Gif creates InlineQueryResultCachedMpeg4Gif with the required fields.
+func NewInlineQueryResultCachedMpeg4Gif(id string, mpeg4FileID string) *InlineQueryResultCachedMpeg4Gif {
+	return &InlineQueryResultCachedMpeg4Gif{
+		ID:          id,
+		Mpeg4FileID: mpeg4FileID,
+		Type:        InlineTypeMpeg4Gif,
+	}
+}
+
+func (t *InlineQueryResultCachedMpeg4Gif) SetCaption(caption string) *InlineQueryResultCachedMpeg4Gif {
+	t.Caption = &caption
+	return t
+}
+
+func (t *InlineQueryResultCachedMpeg4Gif) SetCaptionEntities(captionEntities []MessageEntity) *InlineQueryResultCachedMpeg4Gif {
+	t.CaptionEntities = captionEntities
+	return t
+}
+
+func (t *InlineQueryResultCachedMpeg4Gif) SetInputMessageContent(inputMessageContent InputMessageContent) *InlineQueryResultCachedMpeg4Gif {
+	t.InputMessageContent = &inputMessageContent
+	return t
+}
+
+func (t *InlineQueryResultCachedMpeg4Gif) SetParseMode(parseMode ParseMode) *InlineQueryResultCachedMpeg4Gif {
+	t.ParseMode = &parseMode
+	return t
+}
+
+func (t *InlineQueryResultCachedMpeg4Gif) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *InlineQueryResultCachedMpeg4Gif {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
+func (t *InlineQueryResultCachedMpeg4Gif) SetTitle(title string) *InlineQueryResultCachedMpeg4Gif {
+	t.Title = &title
+	return t
+}
+
 func (t *InlineQueryResultCachedMpeg4Gif) GetCaption() string {
 	var res string
 	if t == nil {
@@ -4378,15 +7079,11 @@ func (t *InlineQueryResultCachedMpeg4Gif) GetMpeg4FileID() string {
 	return t.Mpeg4FileID
 }
 
-func (t *InlineQueryResultCachedMpeg4Gif) GetParseMode() string {
-	var res string
+func (t *InlineQueryResultCachedMpeg4Gif) GetParseMode() *ParseMode {
 	if t == nil {
-		return res
-	}
-	if field := t.ParseMode; field != nil {
-		return *field
+		return nil
 	}
-	return res
+	return t.ParseMode
 }
 
 func (t *InlineQueryResultCachedMpeg4Gif) GetReplyMarkup() *InlineKeyboardMarkup {
@@ -4414,6 +7111,37 @@ func (t *InlineQueryResultCachedMpeg4Gif) GetType() *InlineType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *InlineQueryResultCachedMpeg4Gif) Clone() *InlineQueryResultCachedMpeg4Gif {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Caption = cloneStringPtr(t.Caption)
+	res.CaptionEntities = cloneMessageEntitySlice(t.CaptionEntities)
+	res.InputMessageContent = t.InputMessageContent.Clone()
+	res.ParseMode = cloneParseModePtr(t.ParseMode)
+	res.ReplyMarkup = t.ReplyMarkup.Clone()
+	res.Title = cloneStringPtr(t.Title)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InlineQueryResultCachedMpeg4Gif) Equal(other *InlineQueryResultCachedMpeg4Gif) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.Caption, other.Caption) &&
+		equalMessageEntitySlice(t.CaptionEntities, other.CaptionEntities) &&
+		t.ID == other.ID &&
+		t.InputMessageContent.Equal(other.InputMessageContent) &&
+		t.Mpeg4FileID == other.Mpeg4FileID &&
+		equalParseModePtr(t.ParseMode, other.ParseMode) &&
+		t.ReplyMarkup.Equal(other.ReplyMarkup) &&
+		equalStringPtr(t.Title, other.Title) &&
+		t.Type == other.Type
+}
+
 // InlineQueryResultCachedPhoto
 // Represents a link to a photo stored on the Telegram servers. By default, this photo will be sent
 // by the user with an optional caption. Alternatively, you can use input_message_content to send a
@@ -4443,7 +7171,7 @@ type InlineQueryResultCachedPhoto struct {
 	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
 	// ParseMode
 	// Mode for parsing entities in the photo caption. See formatting options for more details.
-	ParseMode *string `json:"parse_mode,omitempty"`
+	ParseMode *ParseMode `json:"parse_mode,omitempty"`
 	// ReplyMarkup
 	// Inline keyboard attached to the message
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
@@ -4452,6 +7180,50 @@ type InlineQueryResultCachedPhoto struct {
 	Title *string `json:"title,omitempty"`
 }
 
+// NewInlineQueryResultCachedPhoto creates InlineQueryResultCachedPhoto with the required fields.
+func NewInlineQueryResultCachedPhoto(id string, photoFileID string) *InlineQueryResultCachedPhoto {
+	return &InlineQueryResultCachedPhoto{
+		ID:          id,
+		PhotoFileID: photoFileID,
+		Type:        InlineTypePhoto,
+	}
+}
+
+func (t *InlineQueryResultCachedPhoto) SetCaption(caption string) *InlineQueryResultCachedPhoto {
+	t.Caption = &caption
+	return t
+}
+
+func (t *InlineQueryResultCachedPhoto) SetCaptionEntities(captionEntities []MessageEntity) *InlineQueryResultCachedPhoto {
+	t.CaptionEntities = captionEntities
+	return t
+}
+
+func (t *InlineQueryResultCachedPhoto) SetDescription(description string) *InlineQueryResultCachedPhoto {
+	t.Description = &description
+	return t
+}
+
+func (t *InlineQueryResultCachedPhoto) SetInputMessageContent(inputMessageContent InputMessageContent) *InlineQueryResultCachedPhoto {
+	t.InputMessageContent = &inputMessageContent
+	return t
+}
+
+func (t *InlineQueryResultCachedPhoto) SetParseMode(parseMode ParseMode) *InlineQueryResultCachedPhoto {
+	t.ParseMode = &parseMode
+	return t
+}
+
+func (t *InlineQueryResultCachedPhoto) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *InlineQueryResultCachedPhoto {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
+func (t *InlineQueryResultCachedPhoto) SetTitle(title string) *InlineQueryResultCachedPhoto {
+	t.Title = &title
+	return t
+}
+
 func (t *InlineQueryResultCachedPhoto) GetCaption() string {
 	var res string
 	if t == nil {
@@ -4489,15 +7261,11 @@ func (t *InlineQueryResultCachedPhoto) GetInputMessageContent() *InputMessageCon
 	return t.InputMessageContent
 }
 
-func (t *InlineQueryResultCachedPhoto) GetParseMode() string {
-	var res string
+func (t *InlineQueryResultCachedPhoto) GetParseMode() *ParseMode {
 	if t == nil {
-		return res
-	}
-	if field := t.ParseMode; field != nil {
-		return *field
+		return nil
 	}
-	return res
+	return t.ParseMode
 }
 
 func (t *InlineQueryResultCachedPhoto) GetPhotoFileID() string {
@@ -4533,6 +7301,39 @@ func (t *InlineQueryResultCachedPhoto) GetType() *InlineType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *InlineQueryResultCachedPhoto) Clone() *InlineQueryResultCachedPhoto {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Caption = cloneStringPtr(t.Caption)
+	res.CaptionEntities = cloneMessageEntitySlice(t.CaptionEntities)
+	res.Description = cloneStringPtr(t.Description)
+	res.InputMessageContent = t.InputMessageContent.Clone()
+	res.ParseMode = cloneParseModePtr(t.ParseMode)
+	res.ReplyMarkup = t.ReplyMarkup.Clone()
+	res.Title = cloneStringPtr(t.Title)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InlineQueryResultCachedPhoto) Equal(other *InlineQueryResultCachedPhoto) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.Caption, other.Caption) &&
+		equalMessageEntitySlice(t.CaptionEntities, other.CaptionEntities) &&
+		equalStringPtr(t.Description, other.Description) &&
+		t.ID == other.ID &&
+		t.InputMessageContent.Equal(other.InputMessageContent) &&
+		equalParseModePtr(t.ParseMode, other.ParseMode) &&
+		t.PhotoFileID == other.PhotoFileID &&
+		t.ReplyMarkup.Equal(other.ReplyMarkup) &&
+		equalStringPtr(t.Title, other.Title) &&
+		t.Type == other.Type
+}
+
 // InlineQueryResultCachedSticker
 // Represents a link to a sticker stored on the Telegram servers. By default, this sticker will be
 // sent by the user. Alternatively, you can use input_message_content to send a message with the
@@ -4555,6 +7356,25 @@ type InlineQueryResultCachedSticker struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewInlineQueryResultCachedSticker creates InlineQueryResultCachedSticker with the required fields.
+func NewInlineQueryResultCachedSticker(id string, stickerFileID string) *InlineQueryResultCachedSticker {
+	return &InlineQueryResultCachedSticker{
+		ID:            id,
+		StickerFileID: stickerFileID,
+		Type:          InlineTypeSticker,
+	}
+}
+
+func (t *InlineQueryResultCachedSticker) SetInputMessageContent(inputMessageContent InputMessageContent) *InlineQueryResultCachedSticker {
+	t.InputMessageContent = &inputMessageContent
+	return t
+}
+
+func (t *InlineQueryResultCachedSticker) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *InlineQueryResultCachedSticker {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
 func (t *InlineQueryResultCachedSticker) GetID() string {
 	var res string
 	if t == nil {
@@ -4592,6 +7412,29 @@ func (t *InlineQueryResultCachedSticker) GetType() *InlineType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *InlineQueryResultCachedSticker) Clone() *InlineQueryResultCachedSticker {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.InputMessageContent = t.InputMessageContent.Clone()
+	res.ReplyMarkup = t.ReplyMarkup.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InlineQueryResultCachedSticker) Equal(other *InlineQueryResultCachedSticker) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.ID == other.ID &&
+		t.InputMessageContent.Equal(other.InputMessageContent) &&
+		t.ReplyMarkup.Equal(other.ReplyMarkup) &&
+		t.StickerFileID == other.StickerFileID &&
+		t.Type == other.Type
+}
+
 // InlineQueryResultCachedVideo
 // Represents a link to a video file stored on the Telegram servers. By default, this video file
 // will be sent by the user with an optional caption. Alternatively, you can use
@@ -4624,12 +7467,52 @@ type InlineQueryResultCachedVideo struct {
 	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
 	// ParseMode
 	// Mode for parsing entities in the video caption. See formatting options for more details.
-	ParseMode *string `json:"parse_mode,omitempty"`
+	ParseMode *ParseMode `json:"parse_mode,omitempty"`
 	// ReplyMarkup
 	// Inline keyboard attached to the message
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewInlineQueryResultCachedVideo creates InlineQueryResultCachedVideo with the required fields.
+func NewInlineQueryResultCachedVideo(id string, title string, videoFileID string) *InlineQueryResultCachedVideo {
+	return &InlineQueryResultCachedVideo{
+		ID:          id,
+		Title:       title,
+		Type:        InlineTypeVideo,
+		VideoFileID: videoFileID,
+	}
+}
+
+func (t *InlineQueryResultCachedVideo) SetCaption(caption string) *InlineQueryResultCachedVideo {
+	t.Caption = &caption
+	return t
+}
+
+func (t *InlineQueryResultCachedVideo) SetCaptionEntities(captionEntities []MessageEntity) *InlineQueryResultCachedVideo {
+	t.CaptionEntities = captionEntities
+	return t
+}
+
+func (t *InlineQueryResultCachedVideo) SetDescription(description string) *InlineQueryResultCachedVideo {
+	t.Description = &description
+	return t
+}
+
+func (t *InlineQueryResultCachedVideo) SetInputMessageContent(inputMessageContent InputMessageContent) *InlineQueryResultCachedVideo {
+	t.InputMessageContent = &inputMessageContent
+	return t
+}
+
+func (t *InlineQueryResultCachedVideo) SetParseMode(parseMode ParseMode) *InlineQueryResultCachedVideo {
+	t.ParseMode = &parseMode
+	return t
+}
+
+func (t *InlineQueryResultCachedVideo) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *InlineQueryResultCachedVideo {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
 func (t *InlineQueryResultCachedVideo) GetCaption() string {
 	var res string
 	if t == nil {
@@ -4667,15 +7550,11 @@ func (t *InlineQueryResultCachedVideo) GetInputMessageContent() *InputMessageCon
 	return t.InputMessageContent
 }
 
-func (t *InlineQueryResultCachedVideo) GetParseMode() string {
-	var res string
+func (t *InlineQueryResultCachedVideo) GetParseMode() *ParseMode {
 	if t == nil {
-		return res
-	}
-	if field := t.ParseMode; field != nil {
-		return *field
+		return nil
 	}
-	return res
+	return t.ParseMode
 }
 
 func (t *InlineQueryResultCachedVideo) GetReplyMarkup() *InlineKeyboardMarkup {
@@ -4708,6 +7587,38 @@ func (t *InlineQueryResultCachedVideo) GetVideoFileID() string {
 	return t.VideoFileID
 }
 
+// Clone returns a deep copy of t.
+func (t *InlineQueryResultCachedVideo) Clone() *InlineQueryResultCachedVideo {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Caption = cloneStringPtr(t.Caption)
+	res.CaptionEntities = cloneMessageEntitySlice(t.CaptionEntities)
+	res.Description = cloneStringPtr(t.Description)
+	res.InputMessageContent = t.InputMessageContent.Clone()
+	res.ParseMode = cloneParseModePtr(t.ParseMode)
+	res.ReplyMarkup = t.ReplyMarkup.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InlineQueryResultCachedVideo) Equal(other *InlineQueryResultCachedVideo) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.Caption, other.Caption) &&
+		equalMessageEntitySlice(t.CaptionEntities, other.CaptionEntities) &&
+		equalStringPtr(t.Description, other.Description) &&
+		t.ID == other.ID &&
+		t.InputMessageContent.Equal(other.InputMessageContent) &&
+		equalParseModePtr(t.ParseMode, other.ParseMode) &&
+		t.ReplyMarkup.Equal(other.ReplyMarkup) &&
+		t.Title == other.Title &&
+		t.Type == other.Type &&
+		t.VideoFileID == other.VideoFileID
+}
+
 // InlineQueryResultCachedVoice
 // Represents a link to a voice message stored on the Telegram servers. By default, this voice
 // message will be sent by the user. Alternatively, you can use input_message_content to send a
@@ -4738,12 +7649,47 @@ type InlineQueryResultCachedVoice struct {
 	// ParseMode
 	// Mode for parsing entities in the voice message caption. See formatting options for more
 	// details.
-	ParseMode *string `json:"parse_mode,omitempty"`
+	ParseMode *ParseMode `json:"parse_mode,omitempty"`
 	// ReplyMarkup
 	// Inline keyboard attached to the message
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewInlineQueryResultCachedVoice creates InlineQueryResultCachedVoice with the required fields.
+func NewInlineQueryResultCachedVoice(id string, title string, voiceFileID string) *InlineQueryResultCachedVoice {
+	return &InlineQueryResultCachedVoice{
+		ID:          id,
+		Title:       title,
+		Type:        InlineTypeVoice,
+		VoiceFileID: voiceFileID,
+	}
+}
+
+func (t *InlineQueryResultCachedVoice) SetCaption(caption string) *InlineQueryResultCachedVoice {
+	t.Caption = &caption
+	return t
+}
+
+func (t *InlineQueryResultCachedVoice) SetCaptionEntities(captionEntities []MessageEntity) *InlineQueryResultCachedVoice {
+	t.CaptionEntities = captionEntities
+	return t
+}
+
+func (t *InlineQueryResultCachedVoice) SetInputMessageContent(inputMessageContent InputMessageContent) *InlineQueryResultCachedVoice {
+	t.InputMessageContent = &inputMessageContent
+	return t
+}
+
+func (t *InlineQueryResultCachedVoice) SetParseMode(parseMode ParseMode) *InlineQueryResultCachedVoice {
+	t.ParseMode = &parseMode
+	return t
+}
+
+func (t *InlineQueryResultCachedVoice) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *InlineQueryResultCachedVoice {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
 func (t *InlineQueryResultCachedVoice) GetCaption() string {
 	var res string
 	if t == nil {
@@ -4770,15 +7716,11 @@ func (t *InlineQueryResultCachedVoice) GetInputMessageContent() *InputMessageCon
 	return t.InputMessageContent
 }
 
-func (t *InlineQueryResultCachedVoice) GetParseMode() string {
-	var res string
+func (t *InlineQueryResultCachedVoice) GetParseMode() *ParseMode {
 	if t == nil {
-		return res
-	}
-	if field := t.ParseMode; field != nil {
-		return *field
+		return nil
 	}
-	return res
+	return t.ParseMode
 }
 
 func (t *InlineQueryResultCachedVoice) GetReplyMarkup() *InlineKeyboardMarkup {
@@ -4811,6 +7753,36 @@ func (t *InlineQueryResultCachedVoice) GetVoiceFileID() string {
 	return t.VoiceFileID
 }
 
+// Clone returns a deep copy of t.
+func (t *InlineQueryResultCachedVoice) Clone() *InlineQueryResultCachedVoice {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Caption = cloneStringPtr(t.Caption)
+	res.CaptionEntities = cloneMessageEntitySlice(t.CaptionEntities)
+	res.InputMessageContent = t.InputMessageContent.Clone()
+	res.ParseMode = cloneParseModePtr(t.ParseMode)
+	res.ReplyMarkup = t.ReplyMarkup.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InlineQueryResultCachedVoice) Equal(other *InlineQueryResultCachedVoice) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.Caption, other.Caption) &&
+		equalMessageEntitySlice(t.CaptionEntities, other.CaptionEntities) &&
+		t.ID == other.ID &&
+		t.InputMessageContent.Equal(other.InputMessageContent) &&
+		equalParseModePtr(t.ParseMode, other.ParseMode) &&
+		t.ReplyMarkup.Equal(other.ReplyMarkup) &&
+		t.Title == other.Title &&
+		t.Type == other.Type &&
+		t.VoiceFileID == other.VoiceFileID
+}
+
 // InlineQueryResultContact
 // Represents a contact with a phone number. By default, this contact will be sent by the user.
 // Alternatively, you can use input_message_content to send a message with the specified content
@@ -4851,6 +7823,51 @@ type InlineQueryResultContact struct {
 	Vcard *string `json:"vcard,omitempty"`
 }
 
+// NewInlineQueryResultContact creates InlineQueryResultContact with the required fields.
+func NewInlineQueryResultContact(firstName string, id string, phoneNumber string) *InlineQueryResultContact {
+	return &InlineQueryResultContact{
+		FirstName:   firstName,
+		ID:          id,
+		PhoneNumber: phoneNumber,
+		Type:        InlineTypeContact,
+	}
+}
+
+func (t *InlineQueryResultContact) SetInputMessageContent(inputMessageContent InputMessageContent) *InlineQueryResultContact {
+	t.InputMessageContent = &inputMessageContent
+	return t
+}
+
+func (t *InlineQueryResultContact) SetLastName(lastName string) *InlineQueryResultContact {
+	t.LastName = &lastName
+	return t
+}
+
+func (t *InlineQueryResultContact) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *InlineQueryResultContact {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
+func (t *InlineQueryResultContact) SetThumbnailHeight(thumbnailHeight int64) *InlineQueryResultContact {
+	t.ThumbnailHeight = &thumbnailHeight
+	return t
+}
+
+func (t *InlineQueryResultContact) SetThumbnailURL(thumbnailURL string) *InlineQueryResultContact {
+	t.ThumbnailURL = &thumbnailURL
+	return t
+}
+
+func (t *InlineQueryResultContact) SetThumbnailWidth(thumbnailWidth int64) *InlineQueryResultContact {
+	t.ThumbnailWidth = &thumbnailWidth
+	return t
+}
+
+func (t *InlineQueryResultContact) SetVcard(vcard string) *InlineQueryResultContact {
+	t.Vcard = &vcard
+	return t
+}
+
 func (t *InlineQueryResultContact) GetFirstName() string {
 	var res string
 	if t == nil {
@@ -4951,6 +7968,40 @@ func (t *InlineQueryResultContact) GetVcard() string {
 	return res
 }
 
+// Clone returns a deep copy of t.
+func (t *InlineQueryResultContact) Clone() *InlineQueryResultContact {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.InputMessageContent = t.InputMessageContent.Clone()
+	res.LastName = cloneStringPtr(t.LastName)
+	res.ReplyMarkup = t.ReplyMarkup.Clone()
+	res.ThumbnailHeight = cloneInt64Ptr(t.ThumbnailHeight)
+	res.ThumbnailURL = cloneStringPtr(t.ThumbnailURL)
+	res.ThumbnailWidth = cloneInt64Ptr(t.ThumbnailWidth)
+	res.Vcard = cloneStringPtr(t.Vcard)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InlineQueryResultContact) Equal(other *InlineQueryResultContact) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.FirstName == other.FirstName &&
+		t.ID == other.ID &&
+		t.InputMessageContent.Equal(other.InputMessageContent) &&
+		equalStringPtr(t.LastName, other.LastName) &&
+		t.PhoneNumber == other.PhoneNumber &&
+		t.ReplyMarkup.Equal(other.ReplyMarkup) &&
+		equalInt64Ptr(t.ThumbnailHeight, other.ThumbnailHeight) &&
+		equalStringPtr(t.ThumbnailURL, other.ThumbnailURL) &&
+		equalInt64Ptr(t.ThumbnailWidth, other.ThumbnailWidth) &&
+		t.Type == other.Type &&
+		equalStringPtr(t.Vcard, other.Vcard)
+}
+
 // InlineQueryResultDocument
 // Represents a link to a file. By default, this file will be sent by the user with an optional
 // caption. Alternatively, you can use input_message_content to send a message with the specified
@@ -4986,7 +8037,7 @@ type InlineQueryResultDocument struct {
 	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
 	// ParseMode
 	// Mode for parsing entities in the document caption. See formatting options for more details.
-	ParseMode *string `json:"parse_mode,omitempty"`
+	ParseMode *ParseMode `json:"parse_mode,omitempty"`
 	// ReplyMarkup
 	// Inline keyboard attached to the message
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
@@ -5001,6 +8052,62 @@ type InlineQueryResultDocument struct {
 	ThumbnailWidth *int64 `json:"thumbnail_width,omitempty"`
 }
 
+// NewInlineQueryResultDocument creates InlineQueryResultDocument with the required fields.
+func NewInlineQueryResultDocument(documentURL string, id string, mimeType string, title string) *InlineQueryResultDocument {
+	return &InlineQueryResultDocument{
+		DocumentURL: documentURL,
+		ID:          id,
+		MimeType:    mimeType,
+		Title:       title,
+		Type:        InlineTypeDocument,
+	}
+}
+
+func (t *InlineQueryResultDocument) SetCaption(caption string) *InlineQueryResultDocument {
+	t.Caption = &caption
+	return t
+}
+
+func (t *InlineQueryResultDocument) SetCaptionEntities(captionEntities []MessageEntity) *InlineQueryResultDocument {
+	t.CaptionEntities = captionEntities
+	return t
+}
+
+func (t *InlineQueryResultDocument) SetDescription(description string) *InlineQueryResultDocument {
+	t.Description = &description
+	return t
+}
+
+func (t *InlineQueryResultDocument) SetInputMessageContent(inputMessageContent InputMessageContent) *InlineQueryResultDocument {
+	t.InputMessageContent = &inputMessageContent
+	return t
+}
+
+func (t *InlineQueryResultDocument) SetParseMode(parseMode ParseMode) *InlineQueryResultDocument {
+	t.ParseMode = &parseMode
+	return t
+}
+
+func (t *InlineQueryResultDocument) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *InlineQueryResultDocument {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
+func (t *InlineQueryResultDocument) SetThumbnailHeight(thumbnailHeight int64) *InlineQueryResultDocument {
+	t.ThumbnailHeight = &thumbnailHeight
+	return t
+}
+
+func (t *InlineQueryResultDocument) SetThumbnailURL(thumbnailURL string) *InlineQueryResultDocument {
+	t.ThumbnailURL = &thumbnailURL
+	return t
+}
+
+func (t *InlineQueryResultDocument) SetThumbnailWidth(thumbnailWidth int64) *InlineQueryResultDocument {
+	t.ThumbnailWidth = &thumbnailWidth
+	return t
+}
+
 func (t *InlineQueryResultDocument) GetCaption() string {
 	var res string
 	if t == nil {
@@ -5054,15 +8161,11 @@ func (t *InlineQueryResultDocument) GetMimeType() string {
 	return t.MimeType
 }
 
-func (t *InlineQueryResultDocument) GetParseMode() string {
-	var res string
+func (t *InlineQueryResultDocument) GetParseMode() *ParseMode {
 	if t == nil {
-		return res
-	}
-	if field := t.ParseMode; field != nil {
-		return *field
+		return nil
 	}
-	return res
+	return t.ParseMode
 }
 
 func (t *InlineQueryResultDocument) GetReplyMarkup() *InlineKeyboardMarkup {
@@ -5120,7 +8223,46 @@ func (t *InlineQueryResultDocument) GetType() *InlineType {
 	return &t.Type
 }
 
-// InlineQueryResultGame
+// Clone returns a deep copy of t.
+func (t *InlineQueryResultDocument) Clone() *InlineQueryResultDocument {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Caption = cloneStringPtr(t.Caption)
+	res.CaptionEntities = cloneMessageEntitySlice(t.CaptionEntities)
+	res.Description = cloneStringPtr(t.Description)
+	res.InputMessageContent = t.InputMessageContent.Clone()
+	res.ParseMode = cloneParseModePtr(t.ParseMode)
+	res.ReplyMarkup = t.ReplyMarkup.Clone()
+	res.ThumbnailHeight = cloneInt64Ptr(t.ThumbnailHeight)
+	res.ThumbnailURL = cloneStringPtr(t.ThumbnailURL)
+	res.ThumbnailWidth = cloneInt64Ptr(t.ThumbnailWidth)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InlineQueryResultDocument) Equal(other *InlineQueryResultDocument) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.Caption, other.Caption) &&
+		equalMessageEntitySlice(t.CaptionEntities, other.CaptionEntities) &&
+		equalStringPtr(t.Description, other.Description) &&
+		t.DocumentURL == other.DocumentURL &&
+		t.ID == other.ID &&
+		t.InputMessageContent.Equal(other.InputMessageContent) &&
+		t.MimeType == other.MimeType &&
+		equalParseModePtr(t.ParseMode, other.ParseMode) &&
+		t.ReplyMarkup.Equal(other.ReplyMarkup) &&
+		equalInt64Ptr(t.ThumbnailHeight, other.ThumbnailHeight) &&
+		equalStringPtr(t.ThumbnailURL, other.ThumbnailURL) &&
+		equalInt64Ptr(t.ThumbnailWidth, other.ThumbnailWidth) &&
+		t.Title == other.Title &&
+		t.Type == other.Type
+}
+
+// InlineQueryResultGame
 // Represents a Game.
 type InlineQueryResultGame struct {
 	// GameShortName
@@ -5137,6 +8279,20 @@ type InlineQueryResultGame struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
+// NewInlineQueryResultGame creates InlineQueryResultGame with the required fields.
+func NewInlineQueryResultGame(gameShortName string, id string) *InlineQueryResultGame {
+	return &InlineQueryResultGame{
+		GameShortName: gameShortName,
+		ID:            id,
+		Type:          InlineTypeGame,
+	}
+}
+
+func (t *InlineQueryResultGame) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *InlineQueryResultGame {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
 func (t *InlineQueryResultGame) GetGameShortName() string {
 	var res string
 	if t == nil {
@@ -5167,6 +8323,27 @@ func (t *InlineQueryResultGame) GetType() *InlineType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *InlineQueryResultGame) Clone() *InlineQueryResultGame {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.ReplyMarkup = t.ReplyMarkup.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InlineQueryResultGame) Equal(other *InlineQueryResultGame) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.GameShortName == other.GameShortName &&
+		t.ID == other.ID &&
+		t.ReplyMarkup.Equal(other.ReplyMarkup) &&
+		t.Type == other.Type
+}
+
 // InlineQueryResultGif
 // Represents a link to an animated GIF file. By default, this animated GIF file will be sent by
 // the user with optional caption. Alternatively, you can use input_message_content to send a
@@ -5205,19 +8382,79 @@ type InlineQueryResultGif struct {
 	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
 	// ParseMode
 	// Mode for parsing entities in the caption. See formatting options for more details.
-	ParseMode *string `json:"parse_mode,omitempty"`
+	ParseMode *ParseMode `json:"parse_mode,omitempty"`
 	// ReplyMarkup
 	// Inline keyboard attached to the message
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 	// ThumbnailMimeType
 	// MIME type of the thumbnail, must be one of "image/jpeg", "image/gif", or "video/mp4".
 	// Defaults to "image/jpeg"
-	ThumbnailMimeType *string `json:"thumbnail_mime_type,omitempty"`
+	ThumbnailMimeType *ThumbnailMimeType `json:"thumbnail_mime_type,omitempty"`
 	// Title
 	// Title for the result
 	Title *string `json:"title,omitempty"`
 }
 
+// NewInlineQueryResultGif creates InlineQueryResultGif with the required fields.
+func NewInlineQueryResultGif(gifURL string, id string, thumbnailURL string) *InlineQueryResultGif {
+	return &InlineQueryResultGif{
+		GifURL:       gifURL,
+		ID:           id,
+		ThumbnailURL: thumbnailURL,
+		Type:         InlineTypeGif,
+	}
+}
+
+func (t *InlineQueryResultGif) SetCaption(caption string) *InlineQueryResultGif {
+	t.Caption = &caption
+	return t
+}
+
+func (t *InlineQueryResultGif) SetCaptionEntities(captionEntities []MessageEntity) *InlineQueryResultGif {
+	t.CaptionEntities = captionEntities
+	return t
+}
+
+func (t *InlineQueryResultGif) SetGifDuration(gifDuration int64) *InlineQueryResultGif {
+	t.GifDuration = &gifDuration
+	return t
+}
+
+func (t *InlineQueryResultGif) SetGifHeight(gifHeight int64) *InlineQueryResultGif {
+	t.GifHeight = &gifHeight
+	return t
+}
+
+func (t *InlineQueryResultGif) SetGifWidth(gifWidth int64) *InlineQueryResultGif {
+	t.GifWidth = &gifWidth
+	return t
+}
+
+func (t *InlineQueryResultGif) SetInputMessageContent(inputMessageContent InputMessageContent) *InlineQueryResultGif {
+	t.InputMessageContent = &inputMessageContent
+	return t
+}
+
+func (t *InlineQueryResultGif) SetParseMode(parseMode ParseMode) *InlineQueryResultGif {
+	t.ParseMode = &parseMode
+	return t
+}
+
+func (t *InlineQueryResultGif) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *InlineQueryResultGif {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
+func (t *InlineQueryResultGif) SetThumbnailMimeType(thumbnailMimeType ThumbnailMimeType) *InlineQueryResultGif {
+	t.ThumbnailMimeType = &thumbnailMimeType
+	return t
+}
+
+func (t *InlineQueryResultGif) SetTitle(title string) *InlineQueryResultGif {
+	t.Title = &title
+	return t
+}
+
 func (t *InlineQueryResultGif) GetCaption() string {
 	var res string
 	if t == nil {
@@ -5285,15 +8522,11 @@ func (t *InlineQueryResultGif) GetInputMessageContent() *InputMessageContent {
 	return t.InputMessageContent
 }
 
-func (t *InlineQueryResultGif) GetParseMode() string {
-	var res string
+func (t *InlineQueryResultGif) GetParseMode() *ParseMode {
 	if t == nil {
-		return res
-	}
-	if field := t.ParseMode; field != nil {
-		return *field
+		return nil
 	}
-	return res
+	return t.ParseMode
 }
 
 func (t *InlineQueryResultGif) GetReplyMarkup() *InlineKeyboardMarkup {
@@ -5303,15 +8536,11 @@ func (t *InlineQueryResultGif) GetReplyMarkup() *InlineKeyboardMarkup {
 	return t.ReplyMarkup
 }
 
-func (t *InlineQueryResultGif) GetThumbnailMimeType() string {
-	var res string
+func (t *InlineQueryResultGif) GetThumbnailMimeType() *ThumbnailMimeType {
 	if t == nil {
-		return res
-	}
-	if field := t.ThumbnailMimeType; field != nil {
-		return *field
+		return nil
 	}
-	return res
+	return t.ThumbnailMimeType
 }
 
 func (t *InlineQueryResultGif) GetThumbnailURL() string {
@@ -5340,6 +8569,46 @@ func (t *InlineQueryResultGif) GetType() *InlineType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *InlineQueryResultGif) Clone() *InlineQueryResultGif {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Caption = cloneStringPtr(t.Caption)
+	res.CaptionEntities = cloneMessageEntitySlice(t.CaptionEntities)
+	res.GifDuration = cloneInt64Ptr(t.GifDuration)
+	res.GifHeight = cloneInt64Ptr(t.GifHeight)
+	res.GifWidth = cloneInt64Ptr(t.GifWidth)
+	res.InputMessageContent = t.InputMessageContent.Clone()
+	res.ParseMode = cloneParseModePtr(t.ParseMode)
+	res.ReplyMarkup = t.ReplyMarkup.Clone()
+	res.ThumbnailMimeType = cloneThumbnailMimeTypePtr(t.ThumbnailMimeType)
+	res.Title = cloneStringPtr(t.Title)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InlineQueryResultGif) Equal(other *InlineQueryResultGif) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.Caption, other.Caption) &&
+		equalMessageEntitySlice(t.CaptionEntities, other.CaptionEntities) &&
+		equalInt64Ptr(t.GifDuration, other.GifDuration) &&
+		equalInt64Ptr(t.GifHeight, other.GifHeight) &&
+		t.GifURL == other.GifURL &&
+		equalInt64Ptr(t.GifWidth, other.GifWidth) &&
+		t.ID == other.ID &&
+		t.InputMessageContent.Equal(other.InputMessageContent) &&
+		equalParseModePtr(t.ParseMode, other.ParseMode) &&
+		t.ReplyMarkup.Equal(other.ReplyMarkup) &&
+		equalThumbnailMimeTypePtr(t.ThumbnailMimeType, other.ThumbnailMimeType) &&
+		t.ThumbnailURL == other.ThumbnailURL &&
+		equalStringPtr(t.Title, other.Title) &&
+		t.Type == other.Type
+}
+
 // InlineQueryResultLocation
 // Represents a location on a map. By default, the location will be sent by the user.
 // Alternatively, you can use input_message_content to send a message with the specified content
@@ -5391,6 +8660,62 @@ type InlineQueryResultLocation struct {
 	ThumbnailWidth *int64 `json:"thumbnail_width,omitempty"`
 }
 
+// NewInlineQueryResultLocation creates InlineQueryResultLocation with the required fields.
+func NewInlineQueryResultLocation(id string, latitude float64, longitude float64, title string) *InlineQueryResultLocation {
+	return &InlineQueryResultLocation{
+		ID:        id,
+		Latitude:  latitude,
+		Longitude: longitude,
+		Title:     title,
+		Type:      InlineTypeLocation,
+	}
+}
+
+func (t *InlineQueryResultLocation) SetHeading(heading int64) *InlineQueryResultLocation {
+	t.Heading = &heading
+	return t
+}
+
+func (t *InlineQueryResultLocation) SetHorizontalAccuracy(horizontalAccuracy float64) *InlineQueryResultLocation {
+	t.HorizontalAccuracy = &horizontalAccuracy
+	return t
+}
+
+func (t *InlineQueryResultLocation) SetInputMessageContent(inputMessageContent InputMessageContent) *InlineQueryResultLocation {
+	t.InputMessageContent = &inputMessageContent
+	return t
+}
+
+func (t *InlineQueryResultLocation) SetLivePeriod(livePeriod int64) *InlineQueryResultLocation {
+	t.LivePeriod = &livePeriod
+	return t
+}
+
+func (t *InlineQueryResultLocation) SetProximityAlertRadius(proximityAlertRadius int64) *InlineQueryResultLocation {
+	t.ProximityAlertRadius = &proximityAlertRadius
+	return t
+}
+
+func (t *InlineQueryResultLocation) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *InlineQueryResultLocation {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
+func (t *InlineQueryResultLocation) SetThumbnailHeight(thumbnailHeight int64) *InlineQueryResultLocation {
+	t.ThumbnailHeight = &thumbnailHeight
+	return t
+}
+
+func (t *InlineQueryResultLocation) SetThumbnailURL(thumbnailURL string) *InlineQueryResultLocation {
+	t.ThumbnailURL = &thumbnailURL
+	return t
+}
+
+func (t *InlineQueryResultLocation) SetThumbnailWidth(thumbnailWidth int64) *InlineQueryResultLocation {
+	t.ThumbnailWidth = &thumbnailWidth
+	return t
+}
+
 func (t *InlineQueryResultLocation) GetHeading() int64 {
 	var res int64
 	if t == nil {
@@ -5515,6 +8840,45 @@ func (t *InlineQueryResultLocation) GetType() *InlineType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *InlineQueryResultLocation) Clone() *InlineQueryResultLocation {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Heading = cloneInt64Ptr(t.Heading)
+	res.HorizontalAccuracy = cloneFloat64Ptr(t.HorizontalAccuracy)
+	res.InputMessageContent = t.InputMessageContent.Clone()
+	res.LivePeriod = cloneInt64Ptr(t.LivePeriod)
+	res.ProximityAlertRadius = cloneInt64Ptr(t.ProximityAlertRadius)
+	res.ReplyMarkup = t.ReplyMarkup.Clone()
+	res.ThumbnailHeight = cloneInt64Ptr(t.ThumbnailHeight)
+	res.ThumbnailURL = cloneStringPtr(t.ThumbnailURL)
+	res.ThumbnailWidth = cloneInt64Ptr(t.ThumbnailWidth)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InlineQueryResultLocation) Equal(other *InlineQueryResultLocation) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalInt64Ptr(t.Heading, other.Heading) &&
+		equalFloat64Ptr(t.HorizontalAccuracy, other.HorizontalAccuracy) &&
+		t.ID == other.ID &&
+		t.InputMessageContent.Equal(other.InputMessageContent) &&
+		t.Latitude == other.Latitude &&
+		equalInt64Ptr(t.LivePeriod, other.LivePeriod) &&
+		t.Longitude == other.Longitude &&
+		equalInt64Ptr(t.ProximityAlertRadius, other.ProximityAlertRadius) &&
+		t.ReplyMarkup.Equal(other.ReplyMarkup) &&
+		equalInt64Ptr(t.ThumbnailHeight, other.ThumbnailHeight) &&
+		equalStringPtr(t.ThumbnailURL, other.ThumbnailURL) &&
+		equalInt64Ptr(t.ThumbnailWidth, other.ThumbnailWidth) &&
+		t.Title == other.Title &&
+		t.Type == other.Type
+}
+
 // InlineQueryResultMpeg4Gif
 // Represents a link to a video animation (H.264/MPEG-4 AVC video without sound). By default, this
 // animated MPEG-4 file will be sent by the user with optional caption. Alternatively, you can use
@@ -5553,19 +8917,79 @@ type InlineQueryResultMpeg4Gif struct {
 	Mpeg4Width *int64 `json:"mpeg4_width,omitempty"`
 	// ParseMode
 	// Mode for parsing entities in the caption. See formatting options for more details.
-	ParseMode *string `json:"parse_mode,omitempty"`
+	ParseMode *ParseMode `json:"parse_mode,omitempty"`
 	// ReplyMarkup
 	// Inline keyboard attached to the message
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 	// ThumbnailMimeType
 	// MIME type of the thumbnail, must be one of "image/jpeg", "image/gif", or "video/mp4".
 	// Defaults to "image/jpeg"
-	ThumbnailMimeType *string `json:"thumbnail_mime_type,omitempty"`
+	ThumbnailMimeType *ThumbnailMimeType `json:"thumbnail_mime_type,omitempty"`
 	// Title
 	// Title for the result
 	Title *string `json:"title,omitempty"`
 }
 
+// NewInlineQueryResultMpeg4Gif creates InlineQueryResultMpeg4Gif with the required fields.
+func NewInlineQueryResultMpeg4Gif(id string, mpeg4URL string, thumbnailURL string) *InlineQueryResultMpeg4Gif {
+	return &InlineQueryResultMpeg4Gif{
+		ID:           id,
+		Mpeg4URL:     mpeg4URL,
+		ThumbnailURL: thumbnailURL,
+		Type:         InlineTypeMpeg4Gif,
+	}
+}
+
+func (t *InlineQueryResultMpeg4Gif) SetCaption(caption string) *InlineQueryResultMpeg4Gif {
+	t.Caption = &caption
+	return t
+}
+
+func (t *InlineQueryResultMpeg4Gif) SetCaptionEntities(captionEntities []MessageEntity) *InlineQueryResultMpeg4Gif {
+	t.CaptionEntities = captionEntities
+	return t
+}
+
+func (t *InlineQueryResultMpeg4Gif) SetInputMessageContent(inputMessageContent InputMessageContent) *InlineQueryResultMpeg4Gif {
+	t.InputMessageContent = &inputMessageContent
+	return t
+}
+
+func (t *InlineQueryResultMpeg4Gif) SetMpeg4Duration(mpeg4Duration int64) *InlineQueryResultMpeg4Gif {
+	t.Mpeg4Duration = &mpeg4Duration
+	return t
+}
+
+func (t *InlineQueryResultMpeg4Gif) SetMpeg4Height(mpeg4Height int64) *InlineQueryResultMpeg4Gif {
+	t.Mpeg4Height = &mpeg4Height
+	return t
+}
+
+func (t *InlineQueryResultMpeg4Gif) SetMpeg4Width(mpeg4Width int64) *InlineQueryResultMpeg4Gif {
+	t.Mpeg4Width = &mpeg4Width
+	return t
+}
+
+func (t *InlineQueryResultMpeg4Gif) SetParseMode(parseMode ParseMode) *InlineQueryResultMpeg4Gif {
+	t.ParseMode = &parseMode
+	return t
+}
+
+func (t *InlineQueryResultMpeg4Gif) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *InlineQueryResultMpeg4Gif {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
+func (t *InlineQueryResultMpeg4Gif) SetThumbnailMimeType(thumbnailMimeType ThumbnailMimeType) *InlineQueryResultMpeg4Gif {
+	t.ThumbnailMimeType = &thumbnailMimeType
+	return t
+}
+
+func (t *InlineQueryResultMpeg4Gif) SetTitle(title string) *InlineQueryResultMpeg4Gif {
+	t.Title = &title
+	return t
+}
+
 func (t *InlineQueryResultMpeg4Gif) GetCaption() string {
 	var res string
 	if t == nil {
@@ -5633,15 +9057,11 @@ func (t *InlineQueryResultMpeg4Gif) GetMpeg4Width() int64 {
 	return res
 }
 
-func (t *InlineQueryResultMpeg4Gif) GetParseMode() string {
-	var res string
+func (t *InlineQueryResultMpeg4Gif) GetParseMode() *ParseMode {
 	if t == nil {
-		return res
-	}
-	if field := t.ParseMode; field != nil {
-		return *field
+		return nil
 	}
-	return res
+	return t.ParseMode
 }
 
 func (t *InlineQueryResultMpeg4Gif) GetReplyMarkup() *InlineKeyboardMarkup {
@@ -5651,15 +9071,11 @@ func (t *InlineQueryResultMpeg4Gif) GetReplyMarkup() *InlineKeyboardMarkup {
 	return t.ReplyMarkup
 }
 
-func (t *InlineQueryResultMpeg4Gif) GetThumbnailMimeType() string {
-	var res string
+func (t *InlineQueryResultMpeg4Gif) GetThumbnailMimeType() *ThumbnailMimeType {
 	if t == nil {
-		return res
-	}
-	if field := t.ThumbnailMimeType; field != nil {
-		return *field
+		return nil
 	}
-	return res
+	return t.ThumbnailMimeType
 }
 
 func (t *InlineQueryResultMpeg4Gif) GetThumbnailURL() string {
@@ -5688,6 +9104,46 @@ func (t *InlineQueryResultMpeg4Gif) GetType() *InlineType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *InlineQueryResultMpeg4Gif) Clone() *InlineQueryResultMpeg4Gif {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Caption = cloneStringPtr(t.Caption)
+	res.CaptionEntities = cloneMessageEntitySlice(t.CaptionEntities)
+	res.InputMessageContent = t.InputMessageContent.Clone()
+	res.Mpeg4Duration = cloneInt64Ptr(t.Mpeg4Duration)
+	res.Mpeg4Height = cloneInt64Ptr(t.Mpeg4Height)
+	res.Mpeg4Width = cloneInt64Ptr(t.Mpeg4Width)
+	res.ParseMode = cloneParseModePtr(t.ParseMode)
+	res.ReplyMarkup = t.ReplyMarkup.Clone()
+	res.ThumbnailMimeType = cloneThumbnailMimeTypePtr(t.ThumbnailMimeType)
+	res.Title = cloneStringPtr(t.Title)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InlineQueryResultMpeg4Gif) Equal(other *InlineQueryResultMpeg4Gif) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.Caption, other.Caption) &&
+		equalMessageEntitySlice(t.CaptionEntities, other.CaptionEntities) &&
+		t.ID == other.ID &&
+		t.InputMessageContent.Equal(other.InputMessageContent) &&
+		equalInt64Ptr(t.Mpeg4Duration, other.Mpeg4Duration) &&
+		equalInt64Ptr(t.Mpeg4Height, other.Mpeg4Height) &&
+		t.Mpeg4URL == other.Mpeg4URL &&
+		equalInt64Ptr(t.Mpeg4Width, other.Mpeg4Width) &&
+		equalParseModePtr(t.ParseMode, other.ParseMode) &&
+		t.ReplyMarkup.Equal(other.ReplyMarkup) &&
+		equalThumbnailMimeTypePtr(t.ThumbnailMimeType, other.ThumbnailMimeType) &&
+		t.ThumbnailURL == other.ThumbnailURL &&
+		equalStringPtr(t.Title, other.Title) &&
+		t.Type == other.Type
+}
+
 // InlineQueryResultPhoto
 // Represents a link to a photo. By default, this photo will be sent by the user with optional
 // caption. Alternatively, you can use input_message_content to send a message with the specified
@@ -5720,7 +9176,7 @@ type InlineQueryResultPhoto struct {
 	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
 	// ParseMode
 	// Mode for parsing entities in the photo caption. See formatting options for more details.
-	ParseMode *string `json:"parse_mode,omitempty"`
+	ParseMode *ParseMode `json:"parse_mode,omitempty"`
 	// PhotoHeight
 	// Height of the photo
 	PhotoHeight *int64 `json:"photo_height,omitempty"`
@@ -5735,6 +9191,61 @@ type InlineQueryResultPhoto struct {
 	Title *string `json:"title,omitempty"`
 }
 
+// NewInlineQueryResultPhoto creates InlineQueryResultPhoto with the required fields.
+func NewInlineQueryResultPhoto(id string, photoURL string, thumbnailURL string) *InlineQueryResultPhoto {
+	return &InlineQueryResultPhoto{
+		ID:           id,
+		PhotoURL:     photoURL,
+		ThumbnailURL: thumbnailURL,
+		Type:         InlineTypePhoto,
+	}
+}
+
+func (t *InlineQueryResultPhoto) SetCaption(caption string) *InlineQueryResultPhoto {
+	t.Caption = &caption
+	return t
+}
+
+func (t *InlineQueryResultPhoto) SetCaptionEntities(captionEntities []MessageEntity) *InlineQueryResultPhoto {
+	t.CaptionEntities = captionEntities
+	return t
+}
+
+func (t *InlineQueryResultPhoto) SetDescription(description string) *InlineQueryResultPhoto {
+	t.Description = &description
+	return t
+}
+
+func (t *InlineQueryResultPhoto) SetInputMessageContent(inputMessageContent InputMessageContent) *InlineQueryResultPhoto {
+	t.InputMessageContent = &inputMessageContent
+	return t
+}
+
+func (t *InlineQueryResultPhoto) SetParseMode(parseMode ParseMode) *InlineQueryResultPhoto {
+	t.ParseMode = &parseMode
+	return t
+}
+
+func (t *InlineQueryResultPhoto) SetPhotoHeight(photoHeight int64) *InlineQueryResultPhoto {
+	t.PhotoHeight = &photoHeight
+	return t
+}
+
+func (t *InlineQueryResultPhoto) SetPhotoWidth(photoWidth int64) *InlineQueryResultPhoto {
+	t.PhotoWidth = &photoWidth
+	return t
+}
+
+func (t *InlineQueryResultPhoto) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *InlineQueryResultPhoto {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
+func (t *InlineQueryResultPhoto) SetTitle(title string) *InlineQueryResultPhoto {
+	t.Title = &title
+	return t
+}
+
 func (t *InlineQueryResultPhoto) GetCaption() string {
 	var res string
 	if t == nil {
@@ -5772,15 +9283,11 @@ func (t *InlineQueryResultPhoto) GetInputMessageContent() *InputMessageContent {
 	return t.InputMessageContent
 }
 
-func (t *InlineQueryResultPhoto) GetParseMode() string {
-	var res string
+func (t *InlineQueryResultPhoto) GetParseMode() *ParseMode {
 	if t == nil {
-		return res
-	}
-	if field := t.ParseMode; field != nil {
-		return *field
+		return nil
 	}
-	return res
+	return t.ParseMode
 }
 
 func (t *InlineQueryResultPhoto) GetPhotoHeight() int64 {
@@ -5846,6 +9353,44 @@ func (t *InlineQueryResultPhoto) GetType() *InlineType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *InlineQueryResultPhoto) Clone() *InlineQueryResultPhoto {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Caption = cloneStringPtr(t.Caption)
+	res.CaptionEntities = cloneMessageEntitySlice(t.CaptionEntities)
+	res.Description = cloneStringPtr(t.Description)
+	res.InputMessageContent = t.InputMessageContent.Clone()
+	res.ParseMode = cloneParseModePtr(t.ParseMode)
+	res.PhotoHeight = cloneInt64Ptr(t.PhotoHeight)
+	res.PhotoWidth = cloneInt64Ptr(t.PhotoWidth)
+	res.ReplyMarkup = t.ReplyMarkup.Clone()
+	res.Title = cloneStringPtr(t.Title)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InlineQueryResultPhoto) Equal(other *InlineQueryResultPhoto) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.Caption, other.Caption) &&
+		equalMessageEntitySlice(t.CaptionEntities, other.CaptionEntities) &&
+		equalStringPtr(t.Description, other.Description) &&
+		t.ID == other.ID &&
+		t.InputMessageContent.Equal(other.InputMessageContent) &&
+		equalParseModePtr(t.ParseMode, other.ParseMode) &&
+		equalInt64Ptr(t.PhotoHeight, other.PhotoHeight) &&
+		t.PhotoURL == other.PhotoURL &&
+		equalInt64Ptr(t.PhotoWidth, other.PhotoWidth) &&
+		t.ReplyMarkup.Equal(other.ReplyMarkup) &&
+		t.ThumbnailURL == other.ThumbnailURL &&
+		equalStringPtr(t.Title, other.Title) &&
+		t.Type == other.Type
+}
+
 // InlineQueryResultVenue
 // Represents a venue. By default, the venue will be sent by the user. Alternatively, you can use
 // input_message_content to send a message with the specified content instead of the venue.
@@ -5898,6 +9443,63 @@ type InlineQueryResultVenue struct {
 	ThumbnailWidth *int64 `json:"thumbnail_width,omitempty"`
 }
 
+// NewInlineQueryResultVenue creates InlineQueryResultVenue with the required fields.
+func NewInlineQueryResultVenue(address string, id string, latitude float64, longitude float64, title string) *InlineQueryResultVenue {
+	return &InlineQueryResultVenue{
+		Address:   address,
+		ID:        id,
+		Latitude:  latitude,
+		Longitude: longitude,
+		Title:     title,
+		Type:      InlineTypeVenue,
+	}
+}
+
+func (t *InlineQueryResultVenue) SetFoursquareID(foursquareID string) *InlineQueryResultVenue {
+	t.FoursquareID = &foursquareID
+	return t
+}
+
+func (t *InlineQueryResultVenue) SetFoursquareType(foursquareType string) *InlineQueryResultVenue {
+	t.FoursquareType = &foursquareType
+	return t
+}
+
+func (t *InlineQueryResultVenue) SetGooglePlaceID(googlePlaceID string) *InlineQueryResultVenue {
+	t.GooglePlaceID = &googlePlaceID
+	return t
+}
+
+func (t *InlineQueryResultVenue) SetGooglePlaceType(googlePlaceType string) *InlineQueryResultVenue {
+	t.GooglePlaceType = &googlePlaceType
+	return t
+}
+
+func (t *InlineQueryResultVenue) SetInputMessageContent(inputMessageContent InputMessageContent) *InlineQueryResultVenue {
+	t.InputMessageContent = &inputMessageContent
+	return t
+}
+
+func (t *InlineQueryResultVenue) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *InlineQueryResultVenue {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
+func (t *InlineQueryResultVenue) SetThumbnailHeight(thumbnailHeight int64) *InlineQueryResultVenue {
+	t.ThumbnailHeight = &thumbnailHeight
+	return t
+}
+
+func (t *InlineQueryResultVenue) SetThumbnailURL(thumbnailURL string) *InlineQueryResultVenue {
+	t.ThumbnailURL = &thumbnailURL
+	return t
+}
+
+func (t *InlineQueryResultVenue) SetThumbnailWidth(thumbnailWidth int64) *InlineQueryResultVenue {
+	t.ThumbnailWidth = &thumbnailWidth
+	return t
+}
+
 func (t *InlineQueryResultVenue) GetAddress() string {
 	var res string
 	if t == nil {
@@ -6034,6 +9636,46 @@ func (t *InlineQueryResultVenue) GetType() *InlineType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *InlineQueryResultVenue) Clone() *InlineQueryResultVenue {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.FoursquareID = cloneStringPtr(t.FoursquareID)
+	res.FoursquareType = cloneStringPtr(t.FoursquareType)
+	res.GooglePlaceID = cloneStringPtr(t.GooglePlaceID)
+	res.GooglePlaceType = cloneStringPtr(t.GooglePlaceType)
+	res.InputMessageContent = t.InputMessageContent.Clone()
+	res.ReplyMarkup = t.ReplyMarkup.Clone()
+	res.ThumbnailHeight = cloneInt64Ptr(t.ThumbnailHeight)
+	res.ThumbnailURL = cloneStringPtr(t.ThumbnailURL)
+	res.ThumbnailWidth = cloneInt64Ptr(t.ThumbnailWidth)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InlineQueryResultVenue) Equal(other *InlineQueryResultVenue) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Address == other.Address &&
+		equalStringPtr(t.FoursquareID, other.FoursquareID) &&
+		equalStringPtr(t.FoursquareType, other.FoursquareType) &&
+		equalStringPtr(t.GooglePlaceID, other.GooglePlaceID) &&
+		equalStringPtr(t.GooglePlaceType, other.GooglePlaceType) &&
+		t.ID == other.ID &&
+		t.InputMessageContent.Equal(other.InputMessageContent) &&
+		t.Latitude == other.Latitude &&
+		t.Longitude == other.Longitude &&
+		t.ReplyMarkup.Equal(other.ReplyMarkup) &&
+		equalInt64Ptr(t.ThumbnailHeight, other.ThumbnailHeight) &&
+		equalStringPtr(t.ThumbnailURL, other.ThumbnailURL) &&
+		equalInt64Ptr(t.ThumbnailWidth, other.ThumbnailWidth) &&
+		t.Title == other.Title &&
+		t.Type == other.Type
+}
+
 // InlineQueryResultVideo
 // Represents a link to a page containing an embedded video player or a video file. By default,
 // this video file will be sent by the user with an optional caption. Alternatively, you can use
@@ -6073,7 +9715,7 @@ type InlineQueryResultVideo struct {
 	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
 	// ParseMode
 	// Mode for parsing entities in the video caption. See formatting options for more details.
-	ParseMode *string `json:"parse_mode,omitempty"`
+	ParseMode *ParseMode `json:"parse_mode,omitempty"`
 	// ReplyMarkup
 	// Inline keyboard attached to the message
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
@@ -6088,6 +9730,63 @@ type InlineQueryResultVideo struct {
 	VideoWidth *int64 `json:"video_width,omitempty"`
 }
 
+// NewInlineQueryResultVideo creates InlineQueryResultVideo with the required fields.
+func NewInlineQueryResultVideo(id string, mimeType string, thumbnailURL string, title string, videoURL string) *InlineQueryResultVideo {
+	return &InlineQueryResultVideo{
+		ID:           id,
+		MimeType:     mimeType,
+		ThumbnailURL: thumbnailURL,
+		Title:        title,
+		Type:         InlineTypeVideo,
+		VideoURL:     videoURL,
+	}
+}
+
+func (t *InlineQueryResultVideo) SetCaption(caption string) *InlineQueryResultVideo {
+	t.Caption = &caption
+	return t
+}
+
+func (t *InlineQueryResultVideo) SetCaptionEntities(captionEntities []MessageEntity) *InlineQueryResultVideo {
+	t.CaptionEntities = captionEntities
+	return t
+}
+
+func (t *InlineQueryResultVideo) SetDescription(description string) *InlineQueryResultVideo {
+	t.Description = &description
+	return t
+}
+
+func (t *InlineQueryResultVideo) SetInputMessageContent(inputMessageContent InputMessageContent) *InlineQueryResultVideo {
+	t.InputMessageContent = &inputMessageContent
+	return t
+}
+
+func (t *InlineQueryResultVideo) SetParseMode(parseMode ParseMode) *InlineQueryResultVideo {
+	t.ParseMode = &parseMode
+	return t
+}
+
+func (t *InlineQueryResultVideo) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *InlineQueryResultVideo {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
+func (t *InlineQueryResultVideo) SetVideoDuration(videoDuration int64) *InlineQueryResultVideo {
+	t.VideoDuration = &videoDuration
+	return t
+}
+
+func (t *InlineQueryResultVideo) SetVideoHeight(videoHeight int64) *InlineQueryResultVideo {
+	t.VideoHeight = &videoHeight
+	return t
+}
+
+func (t *InlineQueryResultVideo) SetVideoWidth(videoWidth int64) *InlineQueryResultVideo {
+	t.VideoWidth = &videoWidth
+	return t
+}
+
 func (t *InlineQueryResultVideo) GetCaption() string {
 	var res string
 	if t == nil {
@@ -6133,15 +9832,11 @@ func (t *InlineQueryResultVideo) GetMimeType() string {
 	return t.MimeType
 }
 
-func (t *InlineQueryResultVideo) GetParseMode() string {
-	var res string
+func (t *InlineQueryResultVideo) GetParseMode() *ParseMode {
 	if t == nil {
-		return res
-	}
-	if field := t.ParseMode; field != nil {
-		return *field
+		return nil
 	}
-	return res
+	return t.ParseMode
 }
 
 func (t *InlineQueryResultVideo) GetReplyMarkup() *InlineKeyboardMarkup {
@@ -6215,6 +9910,46 @@ func (t *InlineQueryResultVideo) GetVideoWidth() int64 {
 	return res
 }
 
+// Clone returns a deep copy of t.
+func (t *InlineQueryResultVideo) Clone() *InlineQueryResultVideo {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Caption = cloneStringPtr(t.Caption)
+	res.CaptionEntities = cloneMessageEntitySlice(t.CaptionEntities)
+	res.Description = cloneStringPtr(t.Description)
+	res.InputMessageContent = t.InputMessageContent.Clone()
+	res.ParseMode = cloneParseModePtr(t.ParseMode)
+	res.ReplyMarkup = t.ReplyMarkup.Clone()
+	res.VideoDuration = cloneInt64Ptr(t.VideoDuration)
+	res.VideoHeight = cloneInt64Ptr(t.VideoHeight)
+	res.VideoWidth = cloneInt64Ptr(t.VideoWidth)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InlineQueryResultVideo) Equal(other *InlineQueryResultVideo) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.Caption, other.Caption) &&
+		equalMessageEntitySlice(t.CaptionEntities, other.CaptionEntities) &&
+		equalStringPtr(t.Description, other.Description) &&
+		t.ID == other.ID &&
+		t.InputMessageContent.Equal(other.InputMessageContent) &&
+		t.MimeType == other.MimeType &&
+		equalParseModePtr(t.ParseMode, other.ParseMode) &&
+		t.ReplyMarkup.Equal(other.ReplyMarkup) &&
+		t.ThumbnailURL == other.ThumbnailURL &&
+		t.Title == other.Title &&
+		t.Type == other.Type &&
+		equalInt64Ptr(t.VideoDuration, other.VideoDuration) &&
+		equalInt64Ptr(t.VideoHeight, other.VideoHeight) &&
+		t.VideoURL == other.VideoURL &&
+		equalInt64Ptr(t.VideoWidth, other.VideoWidth)
+}
+
 // InlineQueryResultVoice
 // Represents a link to a voice recording in an .OGG container encoded with OPUS. By default, this
 // voice recording will be sent by the user. Alternatively, you can use input_message_content to
@@ -6245,7 +9980,7 @@ type InlineQueryResultVoice struct {
 	// ParseMode
 	// Mode for parsing entities in the voice message caption. See formatting options for more
 	// details.
-	ParseMode *string `json:"parse_mode,omitempty"`
+	ParseMode *ParseMode `json:"parse_mode,omitempty"`
 	// ReplyMarkup
 	// Inline keyboard attached to the message
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
@@ -6254,6 +9989,46 @@ type InlineQueryResultVoice struct {
 	VoiceDuration *int64 `json:"voice_duration,omitempty"`
 }
 
+// NewInlineQueryResultVoice creates InlineQueryResultVoice with the required fields.
+func NewInlineQueryResultVoice(id string, title string, voiceURL string) *InlineQueryResultVoice {
+	return &InlineQueryResultVoice{
+		ID:       id,
+		Title:    title,
+		Type:     InlineTypeVoice,
+		VoiceURL: voiceURL,
+	}
+}
+
+func (t *InlineQueryResultVoice) SetCaption(caption string) *InlineQueryResultVoice {
+	t.Caption = &caption
+	return t
+}
+
+func (t *InlineQueryResultVoice) SetCaptionEntities(captionEntities []MessageEntity) *InlineQueryResultVoice {
+	t.CaptionEntities = captionEntities
+	return t
+}
+
+func (t *InlineQueryResultVoice) SetInputMessageContent(inputMessageContent InputMessageContent) *InlineQueryResultVoice {
+	t.InputMessageContent = &inputMessageContent
+	return t
+}
+
+func (t *InlineQueryResultVoice) SetParseMode(parseMode ParseMode) *InlineQueryResultVoice {
+	t.ParseMode = &parseMode
+	return t
+}
+
+func (t *InlineQueryResultVoice) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *InlineQueryResultVoice {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
+func (t *InlineQueryResultVoice) SetVoiceDuration(voiceDuration int64) *InlineQueryResultVoice {
+	t.VoiceDuration = &voiceDuration
+	return t
+}
+
 func (t *InlineQueryResultVoice) GetCaption() string {
 	var res string
 	if t == nil {
@@ -6280,15 +10055,11 @@ func (t *InlineQueryResultVoice) GetInputMessageContent() *InputMessageContent {
 	return t.InputMessageContent
 }
 
-func (t *InlineQueryResultVoice) GetParseMode() string {
-	var res string
+func (t *InlineQueryResultVoice) GetParseMode() *ParseMode {
 	if t == nil {
-		return res
-	}
-	if field := t.ParseMode; field != nil {
-		return *field
+		return nil
 	}
-	return res
+	return t.ParseMode
 }
 
 func (t *InlineQueryResultVoice) GetReplyMarkup() *InlineKeyboardMarkup {
@@ -6332,6 +10103,38 @@ func (t *InlineQueryResultVoice) GetVoiceURL() string {
 	return t.VoiceURL
 }
 
+// Clone returns a deep copy of t.
+func (t *InlineQueryResultVoice) Clone() *InlineQueryResultVoice {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Caption = cloneStringPtr(t.Caption)
+	res.CaptionEntities = cloneMessageEntitySlice(t.CaptionEntities)
+	res.InputMessageContent = t.InputMessageContent.Clone()
+	res.ParseMode = cloneParseModePtr(t.ParseMode)
+	res.ReplyMarkup = t.ReplyMarkup.Clone()
+	res.VoiceDuration = cloneInt64Ptr(t.VoiceDuration)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InlineQueryResultVoice) Equal(other *InlineQueryResultVoice) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.Caption, other.Caption) &&
+		equalMessageEntitySlice(t.CaptionEntities, other.CaptionEntities) &&
+		t.ID == other.ID &&
+		t.InputMessageContent.Equal(other.InputMessageContent) &&
+		equalParseModePtr(t.ParseMode, other.ParseMode) &&
+		t.ReplyMarkup.Equal(other.ReplyMarkup) &&
+		t.Title == other.Title &&
+		t.Type == other.Type &&
+		equalInt64Ptr(t.VoiceDuration, other.VoiceDuration) &&
+		t.VoiceURL == other.VoiceURL
+}
+
 // InlineQueryResultsButton
 // This object represents a button to be shown above inline query results. You must use exactly one
 // of the optional fields.
@@ -6356,6 +10159,23 @@ type InlineQueryResultsButton struct {
 	WebApp *WebAppInfo `json:"web_app,omitempty"`
 }
 
+// NewInlineQueryResultsButton creates InlineQueryResultsButton with the required fields.
+func NewInlineQueryResultsButton(text string) *InlineQueryResultsButton {
+	return &InlineQueryResultsButton{
+		Text: text,
+	}
+}
+
+func (t *InlineQueryResultsButton) SetStartParameter(startParameter string) *InlineQueryResultsButton {
+	t.StartParameter = &startParameter
+	return t
+}
+
+func (t *InlineQueryResultsButton) SetWebApp(webApp WebAppInfo) *InlineQueryResultsButton {
+	t.WebApp = &webApp
+	return t
+}
+
 func (t *InlineQueryResultsButton) GetStartParameter() string {
 	var res string
 	if t == nil {
@@ -6382,6 +10202,27 @@ func (t *InlineQueryResultsButton) GetWebApp() *WebAppInfo {
 	return t.WebApp
 }
 
+// Clone returns a deep copy of t.
+func (t *InlineQueryResultsButton) Clone() *InlineQueryResultsButton {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.StartParameter = cloneStringPtr(t.StartParameter)
+	res.WebApp = t.WebApp.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InlineQueryResultsButton) Equal(other *InlineQueryResultsButton) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.StartParameter, other.StartParameter) &&
+		t.Text == other.Text &&
+		t.WebApp.Equal(other.WebApp)
+}
+
 // InputContactMessageContent
 // Represents the content of a contact message to be sent as the result of an inline query.
 type InputContactMessageContent struct {
@@ -6399,6 +10240,24 @@ type InputContactMessageContent struct {
 	Vcard *string `json:"vcard,omitempty"`
 }
 
+// NewInputContactMessageContent creates InputContactMessageContent with the required fields.
+func NewInputContactMessageContent(firstName string, phoneNumber string) *InputContactMessageContent {
+	return &InputContactMessageContent{
+		FirstName:   firstName,
+		PhoneNumber: phoneNumber,
+	}
+}
+
+func (t *InputContactMessageContent) SetLastName(lastName string) *InputContactMessageContent {
+	t.LastName = &lastName
+	return t
+}
+
+func (t *InputContactMessageContent) SetVcard(vcard string) *InputContactMessageContent {
+	t.Vcard = &vcard
+	return t
+}
+
 func (t *InputContactMessageContent) GetFirstName() string {
 	var res string
 	if t == nil {
@@ -6437,6 +10296,28 @@ func (t *InputContactMessageContent) GetVcard() string {
 	return res
 }
 
+// Clone returns a deep copy of t.
+func (t *InputContactMessageContent) Clone() *InputContactMessageContent {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.LastName = cloneStringPtr(t.LastName)
+	res.Vcard = cloneStringPtr(t.Vcard)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InputContactMessageContent) Equal(other *InputContactMessageContent) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.FirstName == other.FirstName &&
+		equalStringPtr(t.LastName, other.LastName) &&
+		t.PhoneNumber == other.PhoneNumber &&
+		equalStringPtr(t.Vcard, other.Vcard)
+}
+
 // InputInvoiceMessageContent
 // Represents the content of an invoice message to be sent as the result of an inline query.
 type InputInvoiceMessageContent struct {
@@ -6513,6 +10394,88 @@ type InputInvoiceMessageContent struct {
 	SuggestedTipAmounts []int64 `json:"suggested_tip_amounts,omitempty"`
 }
 
+// NewInputInvoiceMessageContent creates InputInvoiceMessageContent with the required fields.
+func NewInputInvoiceMessageContent(currency string, description string, payload string, prices []LabeledPrice, providerToken string, title string) *InputInvoiceMessageContent {
+	return &InputInvoiceMessageContent{
+		Currency:      currency,
+		Description:   description,
+		Payload:       payload,
+		Prices:        prices,
+		ProviderToken: providerToken,
+		Title:         title,
+	}
+}
+
+func (t *InputInvoiceMessageContent) SetIsFlexible(isFlexible bool) *InputInvoiceMessageContent {
+	t.IsFlexible = &isFlexible
+	return t
+}
+
+func (t *InputInvoiceMessageContent) SetMaxTipAmount(maxTipAmount int64) *InputInvoiceMessageContent {
+	t.MaxTipAmount = &maxTipAmount
+	return t
+}
+
+func (t *InputInvoiceMessageContent) SetNeedEmail(needEmail bool) *InputInvoiceMessageContent {
+	t.NeedEmail = &needEmail
+	return t
+}
+
+func (t *InputInvoiceMessageContent) SetNeedName(needName bool) *InputInvoiceMessageContent {
+	t.NeedName = &needName
+	return t
+}
+
+func (t *InputInvoiceMessageContent) SetNeedPhoneNumber(needPhoneNumber bool) *InputInvoiceMessageContent {
+	t.NeedPhoneNumber = &needPhoneNumber
+	return t
+}
+
+func (t *InputInvoiceMessageContent) SetNeedShippingAddress(needShippingAddress bool) *InputInvoiceMessageContent {
+	t.NeedShippingAddress = &needShippingAddress
+	return t
+}
+
+func (t *InputInvoiceMessageContent) SetPhotoHeight(photoHeight int64) *InputInvoiceMessageContent {
+	t.PhotoHeight = &photoHeight
+	return t
+}
+
+func (t *InputInvoiceMessageContent) SetPhotoSize(photoSize int64) *InputInvoiceMessageContent {
+	t.PhotoSize = &photoSize
+	return t
+}
+
+func (t *InputInvoiceMessageContent) SetPhotoURL(photoURL string) *InputInvoiceMessageContent {
+	t.PhotoURL = &photoURL
+	return t
+}
+
+func (t *InputInvoiceMessageContent) SetPhotoWidth(photoWidth int64) *InputInvoiceMessageContent {
+	t.PhotoWidth = &photoWidth
+	return t
+}
+
+func (t *InputInvoiceMessageContent) SetProviderData(providerData string) *InputInvoiceMessageContent {
+	t.ProviderData = &providerData
+	return t
+}
+
+func (t *InputInvoiceMessageContent) SetSendEmailToProvider(sendEmailToProvider bool) *InputInvoiceMessageContent {
+	t.SendEmailToProvider = &sendEmailToProvider
+	return t
+}
+
+func (t *InputInvoiceMessageContent) SetSendPhoneNumberToProvider(sendPhoneNumberToProvider bool) *InputInvoiceMessageContent {
+	t.SendPhoneNumberToProvider = &sendPhoneNumberToProvider
+	return t
+}
+
+func (t *InputInvoiceMessageContent) SetSuggestedTipAmounts(suggestedTipAmounts []int64) *InputInvoiceMessageContent {
+	t.SuggestedTipAmounts = suggestedTipAmounts
+	return t
+}
+
 func (t *InputInvoiceMessageContent) GetCurrency() string {
 	var res string
 	if t == nil {
@@ -6696,6 +10659,57 @@ func (t *InputInvoiceMessageContent) GetTitle() string {
 	return t.Title
 }
 
+// Clone returns a deep copy of t.
+func (t *InputInvoiceMessageContent) Clone() *InputInvoiceMessageContent {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.IsFlexible = cloneBoolPtr(t.IsFlexible)
+	res.MaxTipAmount = cloneInt64Ptr(t.MaxTipAmount)
+	res.NeedEmail = cloneBoolPtr(t.NeedEmail)
+	res.NeedName = cloneBoolPtr(t.NeedName)
+	res.NeedPhoneNumber = cloneBoolPtr(t.NeedPhoneNumber)
+	res.NeedShippingAddress = cloneBoolPtr(t.NeedShippingAddress)
+	res.PhotoHeight = cloneInt64Ptr(t.PhotoHeight)
+	res.PhotoSize = cloneInt64Ptr(t.PhotoSize)
+	res.PhotoURL = cloneStringPtr(t.PhotoURL)
+	res.PhotoWidth = cloneInt64Ptr(t.PhotoWidth)
+	res.Prices = cloneLabeledPriceSlice(t.Prices)
+	res.ProviderData = cloneStringPtr(t.ProviderData)
+	res.SendEmailToProvider = cloneBoolPtr(t.SendEmailToProvider)
+	res.SendPhoneNumberToProvider = cloneBoolPtr(t.SendPhoneNumberToProvider)
+	res.SuggestedTipAmounts = cloneInt64Slice(t.SuggestedTipAmounts)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InputInvoiceMessageContent) Equal(other *InputInvoiceMessageContent) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Currency == other.Currency &&
+		t.Description == other.Description &&
+		equalBoolPtr(t.IsFlexible, other.IsFlexible) &&
+		equalInt64Ptr(t.MaxTipAmount, other.MaxTipAmount) &&
+		equalBoolPtr(t.NeedEmail, other.NeedEmail) &&
+		equalBoolPtr(t.NeedName, other.NeedName) &&
+		equalBoolPtr(t.NeedPhoneNumber, other.NeedPhoneNumber) &&
+		equalBoolPtr(t.NeedShippingAddress, other.NeedShippingAddress) &&
+		t.Payload == other.Payload &&
+		equalInt64Ptr(t.PhotoHeight, other.PhotoHeight) &&
+		equalInt64Ptr(t.PhotoSize, other.PhotoSize) &&
+		equalStringPtr(t.PhotoURL, other.PhotoURL) &&
+		equalInt64Ptr(t.PhotoWidth, other.PhotoWidth) &&
+		equalLabeledPriceSlice(t.Prices, other.Prices) &&
+		equalStringPtr(t.ProviderData, other.ProviderData) &&
+		t.ProviderToken == other.ProviderToken &&
+		equalBoolPtr(t.SendEmailToProvider, other.SendEmailToProvider) &&
+		equalBoolPtr(t.SendPhoneNumberToProvider, other.SendPhoneNumberToProvider) &&
+		equalInt64Slice(t.SuggestedTipAmounts, other.SuggestedTipAmounts) &&
+		t.Title == other.Title
+}
+
 // InputLocationMessageContent
 // Represents the content of a location message to be sent as the result of an inline query.
 type InputLocationMessageContent struct {
@@ -6721,6 +10735,34 @@ type InputLocationMessageContent struct {
 	ProximityAlertRadius *int64 `json:"proximity_alert_radius,omitempty"`
 }
 
+// NewInputLocationMessageContent creates InputLocationMessageContent with the required fields.
+func NewInputLocationMessageContent(latitude float64, longitude float64) *InputLocationMessageContent {
+	return &InputLocationMessageContent{
+		Latitude:  latitude,
+		Longitude: longitude,
+	}
+}
+
+func (t *InputLocationMessageContent) SetHeading(heading int64) *InputLocationMessageContent {
+	t.Heading = &heading
+	return t
+}
+
+func (t *InputLocationMessageContent) SetHorizontalAccuracy(horizontalAccuracy float64) *InputLocationMessageContent {
+	t.HorizontalAccuracy = &horizontalAccuracy
+	return t
+}
+
+func (t *InputLocationMessageContent) SetLivePeriod(livePeriod int64) *InputLocationMessageContent {
+	t.LivePeriod = &livePeriod
+	return t
+}
+
+func (t *InputLocationMessageContent) SetProximityAlertRadius(proximityAlertRadius int64) *InputLocationMessageContent {
+	t.ProximityAlertRadius = &proximityAlertRadius
+	return t
+}
+
 func (t *InputLocationMessageContent) GetHeading() int64 {
 	var res int64
 	if t == nil {
@@ -6775,6 +10817,32 @@ func (t *InputLocationMessageContent) GetProximityAlertRadius() int64 {
 	return res
 }
 
+// Clone returns a deep copy of t.
+func (t *InputLocationMessageContent) Clone() *InputLocationMessageContent {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Heading = cloneInt64Ptr(t.Heading)
+	res.HorizontalAccuracy = cloneFloat64Ptr(t.HorizontalAccuracy)
+	res.LivePeriod = cloneInt64Ptr(t.LivePeriod)
+	res.ProximityAlertRadius = cloneInt64Ptr(t.ProximityAlertRadius)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InputLocationMessageContent) Equal(other *InputLocationMessageContent) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalInt64Ptr(t.Heading, other.Heading) &&
+		equalFloat64Ptr(t.HorizontalAccuracy, other.HorizontalAccuracy) &&
+		t.Latitude == other.Latitude &&
+		equalInt64Ptr(t.LivePeriod, other.LivePeriod) &&
+		t.Longitude == other.Longitude &&
+		equalInt64Ptr(t.ProximityAlertRadius, other.ProximityAlertRadius)
+}
+
 // InputMedia
 // This object represents the content of a media message to be sent. It should be one of
 type InputMedia struct {
@@ -6799,7 +10867,35 @@ type InputMedia struct {
 	HasSpoiler *bool `json:"has_spoiler,omitempty"`
 	// ParseMode
 	// Mode for parsing entities in the photo caption. See formatting options for more details.
-	ParseMode *string `json:"parse_mode,omitempty"`
+	ParseMode *ParseMode `json:"parse_mode,omitempty"`
+}
+
+// NewInputMedia creates InputMedia with the required fields.
+func NewInputMedia(media string) *InputMedia {
+	return &InputMedia{
+		Media: media,
+		Type:  InputTypePhoto,
+	}
+}
+
+func (t *InputMedia) SetCaption(caption string) *InputMedia {
+	t.Caption = &caption
+	return t
+}
+
+func (t *InputMedia) SetCaptionEntities(captionEntities []MessageEntity) *InputMedia {
+	t.CaptionEntities = captionEntities
+	return t
+}
+
+func (t *InputMedia) SetHasSpoiler(hasSpoiler bool) *InputMedia {
+	t.HasSpoiler = &hasSpoiler
+	return t
+}
+
+func (t *InputMedia) SetParseMode(parseMode ParseMode) *InputMedia {
+	t.ParseMode = &parseMode
+	return t
 }
 
 func (t *InputMedia) GetCaption() string {
@@ -6832,15 +10928,11 @@ func (t *InputMedia) GetMedia() string {
 	return t.Media
 }
 
-func (t *InputMedia) GetParseMode() string {
-	var res string
+func (t *InputMedia) GetParseMode() *ParseMode {
 	if t == nil {
-		return res
-	}
-	if field := t.ParseMode; field != nil {
-		return *field
+		return nil
 	}
-	return res
+	return t.ParseMode
 }
 
 func (t *InputMedia) GetType() *InputType {
@@ -6850,6 +10942,32 @@ func (t *InputMedia) GetType() *InputType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *InputMedia) Clone() *InputMedia {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Caption = cloneStringPtr(t.Caption)
+	res.CaptionEntities = cloneMessageEntitySlice(t.CaptionEntities)
+	res.HasSpoiler = cloneBoolPtr(t.HasSpoiler)
+	res.ParseMode = cloneParseModePtr(t.ParseMode)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InputMedia) Equal(other *InputMedia) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.Caption, other.Caption) &&
+		equalMessageEntitySlice(t.CaptionEntities, other.CaptionEntities) &&
+		equalBoolPtr(t.HasSpoiler, other.HasSpoiler) &&
+		t.Media == other.Media &&
+		equalParseModePtr(t.ParseMode, other.ParseMode) &&
+		t.Type == other.Type
+}
+
 // InputMediaAnimation
 // Represents an animation file (GIF or H.264/MPEG-4 AVC video without sound) to be sent.
 type InputMediaAnimation struct {
@@ -6880,7 +10998,7 @@ type InputMediaAnimation struct {
 	Height *int64 `json:"height,omitempty"`
 	// ParseMode
 	// Mode for parsing entities in the animation caption. See formatting options for more details.
-	ParseMode *string `json:"parse_mode,omitempty"`
+	ParseMode *ParseMode `json:"parse_mode,omitempty"`
 	// Thumbnail
 	// Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported
 	// server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A
@@ -6894,6 +11012,54 @@ type InputMediaAnimation struct {
 	Width *int64 `json:"width,omitempty"`
 }
 
+// NewInputMediaAnimation creates InputMediaAnimation with the required fields.
+func NewInputMediaAnimation(media string) *InputMediaAnimation {
+	return &InputMediaAnimation{
+		Media: media,
+		Type:  InputTypeAnimation,
+	}
+}
+
+func (t *InputMediaAnimation) SetCaption(caption string) *InputMediaAnimation {
+	t.Caption = &caption
+	return t
+}
+
+func (t *InputMediaAnimation) SetCaptionEntities(captionEntities []MessageEntity) *InputMediaAnimation {
+	t.CaptionEntities = captionEntities
+	return t
+}
+
+func (t *InputMediaAnimation) SetDuration(duration int64) *InputMediaAnimation {
+	t.Duration = &duration
+	return t
+}
+
+func (t *InputMediaAnimation) SetHasSpoiler(hasSpoiler bool) *InputMediaAnimation {
+	t.HasSpoiler = &hasSpoiler
+	return t
+}
+
+func (t *InputMediaAnimation) SetHeight(height int64) *InputMediaAnimation {
+	t.Height = &height
+	return t
+}
+
+func (t *InputMediaAnimation) SetParseMode(parseMode ParseMode) *InputMediaAnimation {
+	t.ParseMode = &parseMode
+	return t
+}
+
+func (t *InputMediaAnimation) SetThumbnail(thumbnail FileID) *InputMediaAnimation {
+	t.Thumbnail = &thumbnail
+	return t
+}
+
+func (t *InputMediaAnimation) SetWidth(width int64) *InputMediaAnimation {
+	t.Width = &width
+	return t
+}
+
 func (t *InputMediaAnimation) GetCaption() string {
 	var res string
 	if t == nil {
@@ -6946,15 +11112,11 @@ func (t *InputMediaAnimation) GetMedia() string {
 	return t.Media
 }
 
-func (t *InputMediaAnimation) GetParseMode() string {
-	var res string
+func (t *InputMediaAnimation) GetParseMode() *ParseMode {
 	if t == nil {
-		return res
-	}
-	if field := t.ParseMode; field != nil {
-		return *field
+		return nil
 	}
-	return res
+	return t.ParseMode
 }
 
 func (t *InputMediaAnimation) GetThumbnail() *FileID {
@@ -6982,6 +11144,40 @@ func (t *InputMediaAnimation) GetWidth() int64 {
 	return res
 }
 
+// Clone returns a deep copy of t.
+func (t *InputMediaAnimation) Clone() *InputMediaAnimation {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Caption = cloneStringPtr(t.Caption)
+	res.CaptionEntities = cloneMessageEntitySlice(t.CaptionEntities)
+	res.Duration = cloneInt64Ptr(t.Duration)
+	res.HasSpoiler = cloneBoolPtr(t.HasSpoiler)
+	res.Height = cloneInt64Ptr(t.Height)
+	res.ParseMode = cloneParseModePtr(t.ParseMode)
+	res.Thumbnail = cloneFileIDPtr(t.Thumbnail)
+	res.Width = cloneInt64Ptr(t.Width)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InputMediaAnimation) Equal(other *InputMediaAnimation) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.Caption, other.Caption) &&
+		equalMessageEntitySlice(t.CaptionEntities, other.CaptionEntities) &&
+		equalInt64Ptr(t.Duration, other.Duration) &&
+		equalBoolPtr(t.HasSpoiler, other.HasSpoiler) &&
+		equalInt64Ptr(t.Height, other.Height) &&
+		t.Media == other.Media &&
+		equalParseModePtr(t.ParseMode, other.ParseMode) &&
+		equalFileIDPtr(t.Thumbnail, other.Thumbnail) &&
+		t.Type == other.Type &&
+		equalInt64Ptr(t.Width, other.Width)
+}
+
 // InputMediaAudio
 // Represents an audio file to be treated as music to be sent.
 type InputMediaAudio struct {
@@ -7006,7 +11202,7 @@ type InputMediaAudio struct {
 	Duration *int64 `json:"duration,omitempty"`
 	// ParseMode
 	// Mode for parsing entities in the audio caption. See formatting options for more details.
-	ParseMode *string `json:"parse_mode,omitempty"`
+	ParseMode *ParseMode `json:"parse_mode,omitempty"`
 	// Performer
 	// Performer of the audio
 	Performer *string `json:"performer,omitempty"`
@@ -7023,6 +11219,49 @@ type InputMediaAudio struct {
 	Title *string `json:"title,omitempty"`
 }
 
+// NewInputMediaAudio creates InputMediaAudio with the required fields.
+func NewInputMediaAudio(media string) *InputMediaAudio {
+	return &InputMediaAudio{
+		Media: media,
+		Type:  InputTypeAudio,
+	}
+}
+
+func (t *InputMediaAudio) SetCaption(caption string) *InputMediaAudio {
+	t.Caption = &caption
+	return t
+}
+
+func (t *InputMediaAudio) SetCaptionEntities(captionEntities []MessageEntity) *InputMediaAudio {
+	t.CaptionEntities = captionEntities
+	return t
+}
+
+func (t *InputMediaAudio) SetDuration(duration int64) *InputMediaAudio {
+	t.Duration = &duration
+	return t
+}
+
+func (t *InputMediaAudio) SetParseMode(parseMode ParseMode) *InputMediaAudio {
+	t.ParseMode = &parseMode
+	return t
+}
+
+func (t *InputMediaAudio) SetPerformer(performer string) *InputMediaAudio {
+	t.Performer = &performer
+	return t
+}
+
+func (t *InputMediaAudio) SetThumbnail(thumbnail FileID) *InputMediaAudio {
+	t.Thumbnail = &thumbnail
+	return t
+}
+
+func (t *InputMediaAudio) SetTitle(title string) *InputMediaAudio {
+	t.Title = &title
+	return t
+}
+
 func (t *InputMediaAudio) GetCaption() string {
 	var res string
 	if t == nil {
@@ -7053,15 +11292,11 @@ func (t *InputMediaAudio) GetMedia() string {
 	return t.Media
 }
 
-func (t *InputMediaAudio) GetParseMode() string {
-	var res string
+func (t *InputMediaAudio) GetParseMode() *ParseMode {
 	if t == nil {
-		return res
-	}
-	if field := t.ParseMode; field != nil {
-		return *field
+		return nil
 	}
-	return res
+	return t.ParseMode
 }
 
 func (t *InputMediaAudio) GetPerformer() string {
@@ -7100,6 +11335,38 @@ func (t *InputMediaAudio) GetType() *InputType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *InputMediaAudio) Clone() *InputMediaAudio {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Caption = cloneStringPtr(t.Caption)
+	res.CaptionEntities = cloneMessageEntitySlice(t.CaptionEntities)
+	res.Duration = cloneInt64Ptr(t.Duration)
+	res.ParseMode = cloneParseModePtr(t.ParseMode)
+	res.Performer = cloneStringPtr(t.Performer)
+	res.Thumbnail = cloneFileIDPtr(t.Thumbnail)
+	res.Title = cloneStringPtr(t.Title)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InputMediaAudio) Equal(other *InputMediaAudio) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.Caption, other.Caption) &&
+		equalMessageEntitySlice(t.CaptionEntities, other.CaptionEntities) &&
+		equalInt64Ptr(t.Duration, other.Duration) &&
+		t.Media == other.Media &&
+		equalParseModePtr(t.ParseMode, other.ParseMode) &&
+		equalStringPtr(t.Performer, other.Performer) &&
+		equalFileIDPtr(t.Thumbnail, other.Thumbnail) &&
+		equalStringPtr(t.Title, other.Title) &&
+		t.Type == other.Type
+}
+
 // InputMediaDocument
 // Represents a general file to be sent.
 type InputMediaDocument struct {
@@ -7125,7 +11392,7 @@ type InputMediaDocument struct {
 	DisableContentTypeDetection *bool `json:"disable_content_type_detection,omitempty"`
 	// ParseMode
 	// Mode for parsing entities in the document caption. See formatting options for more details.
-	ParseMode *string `json:"parse_mode,omitempty"`
+	ParseMode *ParseMode `json:"parse_mode,omitempty"`
 	// Thumbnail
 	// Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported
 	// server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A
@@ -7136,6 +11403,39 @@ type InputMediaDocument struct {
 	Thumbnail *FileID `json:"thumbnail,omitempty"`
 }
 
+// NewInputMediaDocument creates InputMediaDocument with the required fields.
+func NewInputMediaDocument(media string) *InputMediaDocument {
+	return &InputMediaDocument{
+		Media: media,
+		Type:  InputTypeDocument,
+	}
+}
+
+func (t *InputMediaDocument) SetCaption(caption string) *InputMediaDocument {
+	t.Caption = &caption
+	return t
+}
+
+func (t *InputMediaDocument) SetCaptionEntities(captionEntities []MessageEntity) *InputMediaDocument {
+	t.CaptionEntities = captionEntities
+	return t
+}
+
+func (t *InputMediaDocument) SetDisableContentTypeDetection(disableContentTypeDetection bool) *InputMediaDocument {
+	t.DisableContentTypeDetection = &disableContentTypeDetection
+	return t
+}
+
+func (t *InputMediaDocument) SetParseMode(parseMode ParseMode) *InputMediaDocument {
+	t.ParseMode = &parseMode
+	return t
+}
+
+func (t *InputMediaDocument) SetThumbnail(thumbnail FileID) *InputMediaDocument {
+	t.Thumbnail = &thumbnail
+	return t
+}
+
 func (t *InputMediaDocument) GetCaption() string {
 	var res string
 	if t == nil {
@@ -7166,15 +11466,11 @@ func (t *InputMediaDocument) GetMedia() string {
 	return t.Media
 }
 
-func (t *InputMediaDocument) GetParseMode() string {
-	var res string
+func (t *InputMediaDocument) GetParseMode() *ParseMode {
 	if t == nil {
-		return res
-	}
-	if field := t.ParseMode; field != nil {
-		return *field
+		return nil
 	}
-	return res
+	return t.ParseMode
 }
 
 func (t *InputMediaDocument) GetThumbnail() *FileID {
@@ -7191,6 +11487,34 @@ func (t *InputMediaDocument) GetType() *InputType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *InputMediaDocument) Clone() *InputMediaDocument {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Caption = cloneStringPtr(t.Caption)
+	res.CaptionEntities = cloneMessageEntitySlice(t.CaptionEntities)
+	res.DisableContentTypeDetection = cloneBoolPtr(t.DisableContentTypeDetection)
+	res.ParseMode = cloneParseModePtr(t.ParseMode)
+	res.Thumbnail = cloneFileIDPtr(t.Thumbnail)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InputMediaDocument) Equal(other *InputMediaDocument) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.Caption, other.Caption) &&
+		equalMessageEntitySlice(t.CaptionEntities, other.CaptionEntities) &&
+		equalBoolPtr(t.DisableContentTypeDetection, other.DisableContentTypeDetection) &&
+		t.Media == other.Media &&
+		equalParseModePtr(t.ParseMode, other.ParseMode) &&
+		equalFileIDPtr(t.Thumbnail, other.Thumbnail) &&
+		t.Type == other.Type
+}
+
 // InputMediaPhoto
 // Represents a photo to be sent.
 type InputMediaPhoto struct {
@@ -7215,7 +11539,35 @@ type InputMediaPhoto struct {
 	HasSpoiler *bool `json:"has_spoiler,omitempty"`
 	// ParseMode
 	// Mode for parsing entities in the photo caption. See formatting options for more details.
-	ParseMode *string `json:"parse_mode,omitempty"`
+	ParseMode *ParseMode `json:"parse_mode,omitempty"`
+}
+
+// NewInputMediaPhoto creates InputMediaPhoto with the required fields.
+func NewInputMediaPhoto(media string) *InputMediaPhoto {
+	return &InputMediaPhoto{
+		Media: media,
+		Type:  InputTypePhoto,
+	}
+}
+
+func (t *InputMediaPhoto) SetCaption(caption string) *InputMediaPhoto {
+	t.Caption = &caption
+	return t
+}
+
+func (t *InputMediaPhoto) SetCaptionEntities(captionEntities []MessageEntity) *InputMediaPhoto {
+	t.CaptionEntities = captionEntities
+	return t
+}
+
+func (t *InputMediaPhoto) SetHasSpoiler(hasSpoiler bool) *InputMediaPhoto {
+	t.HasSpoiler = &hasSpoiler
+	return t
+}
+
+func (t *InputMediaPhoto) SetParseMode(parseMode ParseMode) *InputMediaPhoto {
+	t.ParseMode = &parseMode
+	return t
 }
 
 func (t *InputMediaPhoto) GetCaption() string {
@@ -7248,15 +11600,11 @@ func (t *InputMediaPhoto) GetMedia() string {
 	return t.Media
 }
 
-func (t *InputMediaPhoto) GetParseMode() string {
-	var res string
+func (t *InputMediaPhoto) GetParseMode() *ParseMode {
 	if t == nil {
-		return res
-	}
-	if field := t.ParseMode; field != nil {
-		return *field
+		return nil
 	}
-	return res
+	return t.ParseMode
 }
 
 func (t *InputMediaPhoto) GetType() *InputType {
@@ -7266,6 +11614,32 @@ func (t *InputMediaPhoto) GetType() *InputType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *InputMediaPhoto) Clone() *InputMediaPhoto {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Caption = cloneStringPtr(t.Caption)
+	res.CaptionEntities = cloneMessageEntitySlice(t.CaptionEntities)
+	res.HasSpoiler = cloneBoolPtr(t.HasSpoiler)
+	res.ParseMode = cloneParseModePtr(t.ParseMode)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InputMediaPhoto) Equal(other *InputMediaPhoto) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.Caption, other.Caption) &&
+		equalMessageEntitySlice(t.CaptionEntities, other.CaptionEntities) &&
+		equalBoolPtr(t.HasSpoiler, other.HasSpoiler) &&
+		t.Media == other.Media &&
+		equalParseModePtr(t.ParseMode, other.ParseMode) &&
+		t.Type == other.Type
+}
+
 // InputMediaVideo
 // Represents a video to be sent.
 type InputMediaVideo struct {
@@ -7296,7 +11670,7 @@ type InputMediaVideo struct {
 	Height *int64 `json:"height,omitempty"`
 	// ParseMode
 	// Mode for parsing entities in the video caption. See formatting options for more details.
-	ParseMode *string `json:"parse_mode,omitempty"`
+	ParseMode *ParseMode `json:"parse_mode,omitempty"`
 	// SupportsStreaming
 	// Pass True if the uploaded video is suitable for streaming
 	SupportsStreaming *bool `json:"supports_streaming,omitempty"`
@@ -7313,6 +11687,59 @@ type InputMediaVideo struct {
 	Width *int64 `json:"width,omitempty"`
 }
 
+// NewInputMediaVideo creates InputMediaVideo with the required fields.
+func NewInputMediaVideo(media string) *InputMediaVideo {
+	return &InputMediaVideo{
+		Media: media,
+		Type:  InputTypeVideo,
+	}
+}
+
+func (t *InputMediaVideo) SetCaption(caption string) *InputMediaVideo {
+	t.Caption = &caption
+	return t
+}
+
+func (t *InputMediaVideo) SetCaptionEntities(captionEntities []MessageEntity) *InputMediaVideo {
+	t.CaptionEntities = captionEntities
+	return t
+}
+
+func (t *InputMediaVideo) SetDuration(duration int64) *InputMediaVideo {
+	t.Duration = &duration
+	return t
+}
+
+func (t *InputMediaVideo) SetHasSpoiler(hasSpoiler bool) *InputMediaVideo {
+	t.HasSpoiler = &hasSpoiler
+	return t
+}
+
+func (t *InputMediaVideo) SetHeight(height int64) *InputMediaVideo {
+	t.Height = &height
+	return t
+}
+
+func (t *InputMediaVideo) SetParseMode(parseMode ParseMode) *InputMediaVideo {
+	t.ParseMode = &parseMode
+	return t
+}
+
+func (t *InputMediaVideo) SetSupportsStreaming(supportsStreaming bool) *InputMediaVideo {
+	t.SupportsStreaming = &supportsStreaming
+	return t
+}
+
+func (t *InputMediaVideo) SetThumbnail(thumbnail FileID) *InputMediaVideo {
+	t.Thumbnail = &thumbnail
+	return t
+}
+
+func (t *InputMediaVideo) SetWidth(width int64) *InputMediaVideo {
+	t.Width = &width
+	return t
+}
+
 func (t *InputMediaVideo) GetCaption() string {
 	var res string
 	if t == nil {
@@ -7365,15 +11792,11 @@ func (t *InputMediaVideo) GetMedia() string {
 	return t.Media
 }
 
-func (t *InputMediaVideo) GetParseMode() string {
-	var res string
+func (t *InputMediaVideo) GetParseMode() *ParseMode {
 	if t == nil {
-		return res
+		return nil
 	}
-	if field := t.ParseMode; field != nil {
-		return *field
-	}
-	return res
+	return t.ParseMode
 }
 
 func (t *InputMediaVideo) GetSupportsStreaming() bool {
@@ -7412,6 +11835,42 @@ func (t *InputMediaVideo) GetWidth() int64 {
 	return res
 }
 
+// Clone returns a deep copy of t.
+func (t *InputMediaVideo) Clone() *InputMediaVideo {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Caption = cloneStringPtr(t.Caption)
+	res.CaptionEntities = cloneMessageEntitySlice(t.CaptionEntities)
+	res.Duration = cloneInt64Ptr(t.Duration)
+	res.HasSpoiler = cloneBoolPtr(t.HasSpoiler)
+	res.Height = cloneInt64Ptr(t.Height)
+	res.ParseMode = cloneParseModePtr(t.ParseMode)
+	res.SupportsStreaming = cloneBoolPtr(t.SupportsStreaming)
+	res.Thumbnail = cloneFileIDPtr(t.Thumbnail)
+	res.Width = cloneInt64Ptr(t.Width)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InputMediaVideo) Equal(other *InputMediaVideo) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.Caption, other.Caption) &&
+		equalMessageEntitySlice(t.CaptionEntities, other.CaptionEntities) &&
+		equalInt64Ptr(t.Duration, other.Duration) &&
+		equalBoolPtr(t.HasSpoiler, other.HasSpoiler) &&
+		equalInt64Ptr(t.Height, other.Height) &&
+		t.Media == other.Media &&
+		equalParseModePtr(t.ParseMode, other.ParseMode) &&
+		equalBoolPtr(t.SupportsStreaming, other.SupportsStreaming) &&
+		equalFileIDPtr(t.Thumbnail, other.Thumbnail) &&
+		t.Type == other.Type &&
+		equalInt64Ptr(t.Width, other.Width)
+}
+
 // InputMessageContent
 // This object represents the content of a message to be sent as a result of an inline query.
 // Telegram clients currently support the following 5 types:
@@ -7428,7 +11887,29 @@ type InputMessageContent struct {
 	Entities []MessageEntity `json:"entities,omitempty"`
 	// ParseMode
 	// Mode for parsing entities in the message text. See formatting options for more details.
-	ParseMode *string `json:"parse_mode,omitempty"`
+	ParseMode *ParseMode `json:"parse_mode,omitempty"`
+}
+
+// NewInputMessageContent creates InputMessageContent with the required fields.
+func NewInputMessageContent(messageText string) *InputMessageContent {
+	return &InputMessageContent{
+		MessageText: messageText,
+	}
+}
+
+func (t *InputMessageContent) SetDisableWebPagePreview(disableWebPagePreview bool) *InputMessageContent {
+	t.DisableWebPagePreview = &disableWebPagePreview
+	return t
+}
+
+func (t *InputMessageContent) SetEntities(entities []MessageEntity) *InputMessageContent {
+	t.Entities = entities
+	return t
+}
+
+func (t *InputMessageContent) SetParseMode(parseMode ParseMode) *InputMessageContent {
+	t.ParseMode = &parseMode
+	return t
 }
 
 func (t *InputMessageContent) GetDisableWebPagePreview() bool {
@@ -7450,15 +11931,34 @@ func (t *InputMessageContent) GetMessageText() string {
 	return t.MessageText
 }
 
-func (t *InputMessageContent) GetParseMode() string {
-	var res string
+func (t *InputMessageContent) GetParseMode() *ParseMode {
 	if t == nil {
-		return res
+		return nil
 	}
-	if field := t.ParseMode; field != nil {
-		return *field
+	return t.ParseMode
+}
+
+// Clone returns a deep copy of t.
+func (t *InputMessageContent) Clone() *InputMessageContent {
+	if t == nil {
+		return nil
 	}
-	return res
+	res := *t
+	res.DisableWebPagePreview = cloneBoolPtr(t.DisableWebPagePreview)
+	res.Entities = cloneMessageEntitySlice(t.Entities)
+	res.ParseMode = cloneParseModePtr(t.ParseMode)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InputMessageContent) Equal(other *InputMessageContent) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalBoolPtr(t.DisableWebPagePreview, other.DisableWebPagePreview) &&
+		equalMessageEntitySlice(t.Entities, other.Entities) &&
+		t.MessageText == other.MessageText &&
+		equalParseModePtr(t.ParseMode, other.ParseMode)
 }
 
 // InputSticker
@@ -7483,6 +11983,24 @@ type InputSticker struct {
 	MaskPosition *MaskPosition `json:"mask_position,omitempty"`
 }
 
+// NewInputSticker creates InputSticker with the required fields.
+func NewInputSticker(emojiList []string, sticker FileID) *InputSticker {
+	return &InputSticker{
+		EmojiList: emojiList,
+		Sticker:   sticker,
+	}
+}
+
+func (t *InputSticker) SetKeywords(keywords []string) *InputSticker {
+	t.Keywords = keywords
+	return t
+}
+
+func (t *InputSticker) SetMaskPosition(maskPosition MaskPosition) *InputSticker {
+	t.MaskPosition = &maskPosition
+	return t
+}
+
 func (t *InputSticker) GetMaskPosition() *MaskPosition {
 	if t == nil {
 		return nil
@@ -7497,6 +12015,29 @@ func (t *InputSticker) GetSticker() *FileID {
 	return &t.Sticker
 }
 
+// Clone returns a deep copy of t.
+func (t *InputSticker) Clone() *InputSticker {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.EmojiList = cloneStringSlice(t.EmojiList)
+	res.Keywords = cloneStringSlice(t.Keywords)
+	res.MaskPosition = t.MaskPosition.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InputSticker) Equal(other *InputSticker) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringSlice(t.EmojiList, other.EmojiList) &&
+		equalStringSlice(t.Keywords, other.Keywords) &&
+		t.MaskPosition.Equal(other.MaskPosition) &&
+		t.Sticker == other.Sticker
+}
+
 // InputTextMessageContent
 // Represents the content of a text message to be sent as the result of an inline query.
 type InputTextMessageContent struct {
@@ -7512,7 +12053,29 @@ type InputTextMessageContent struct {
 	Entities []MessageEntity `json:"entities,omitempty"`
 	// ParseMode
 	// Mode for parsing entities in the message text. See formatting options for more details.
-	ParseMode *string `json:"parse_mode,omitempty"`
+	ParseMode *ParseMode `json:"parse_mode,omitempty"`
+}
+
+// NewInputTextMessageContent creates InputTextMessageContent with the required fields.
+func NewInputTextMessageContent(messageText string) *InputTextMessageContent {
+	return &InputTextMessageContent{
+		MessageText: messageText,
+	}
+}
+
+func (t *InputTextMessageContent) SetDisableWebPagePreview(disableWebPagePreview bool) *InputTextMessageContent {
+	t.DisableWebPagePreview = &disableWebPagePreview
+	return t
+}
+
+func (t *InputTextMessageContent) SetEntities(entities []MessageEntity) *InputTextMessageContent {
+	t.Entities = entities
+	return t
+}
+
+func (t *InputTextMessageContent) SetParseMode(parseMode ParseMode) *InputTextMessageContent {
+	t.ParseMode = &parseMode
+	return t
 }
 
 func (t *InputTextMessageContent) GetDisableWebPagePreview() bool {
@@ -7534,15 +12097,34 @@ func (t *InputTextMessageContent) GetMessageText() string {
 	return t.MessageText
 }
 
-func (t *InputTextMessageContent) GetParseMode() string {
-	var res string
+func (t *InputTextMessageContent) GetParseMode() *ParseMode {
 	if t == nil {
-		return res
+		return nil
 	}
-	if field := t.ParseMode; field != nil {
-		return *field
+	return t.ParseMode
+}
+
+// Clone returns a deep copy of t.
+func (t *InputTextMessageContent) Clone() *InputTextMessageContent {
+	if t == nil {
+		return nil
 	}
-	return res
+	res := *t
+	res.DisableWebPagePreview = cloneBoolPtr(t.DisableWebPagePreview)
+	res.Entities = cloneMessageEntitySlice(t.Entities)
+	res.ParseMode = cloneParseModePtr(t.ParseMode)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InputTextMessageContent) Equal(other *InputTextMessageContent) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalBoolPtr(t.DisableWebPagePreview, other.DisableWebPagePreview) &&
+		equalMessageEntitySlice(t.Entities, other.Entities) &&
+		t.MessageText == other.MessageText &&
+		equalParseModePtr(t.ParseMode, other.ParseMode)
 }
 
 // InputVenueMessageContent
@@ -7575,6 +12157,36 @@ type InputVenueMessageContent struct {
 	GooglePlaceType *string `json:"google_place_type,omitempty"`
 }
 
+// NewInputVenueMessageContent creates InputVenueMessageContent with the required fields.
+func NewInputVenueMessageContent(address string, latitude float64, longitude float64, title string) *InputVenueMessageContent {
+	return &InputVenueMessageContent{
+		Address:   address,
+		Latitude:  latitude,
+		Longitude: longitude,
+		Title:     title,
+	}
+}
+
+func (t *InputVenueMessageContent) SetFoursquareID(foursquareID string) *InputVenueMessageContent {
+	t.FoursquareID = &foursquareID
+	return t
+}
+
+func (t *InputVenueMessageContent) SetFoursquareType(foursquareType string) *InputVenueMessageContent {
+	t.FoursquareType = &foursquareType
+	return t
+}
+
+func (t *InputVenueMessageContent) SetGooglePlaceID(googlePlaceID string) *InputVenueMessageContent {
+	t.GooglePlaceID = &googlePlaceID
+	return t
+}
+
+func (t *InputVenueMessageContent) SetGooglePlaceType(googlePlaceType string) *InputVenueMessageContent {
+	t.GooglePlaceType = &googlePlaceType
+	return t
+}
+
 func (t *InputVenueMessageContent) GetAddress() string {
 	var res string
 	if t == nil {
@@ -7649,6 +12261,34 @@ func (t *InputVenueMessageContent) GetTitle() string {
 	return t.Title
 }
 
+// Clone returns a deep copy of t.
+func (t *InputVenueMessageContent) Clone() *InputVenueMessageContent {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.FoursquareID = cloneStringPtr(t.FoursquareID)
+	res.FoursquareType = cloneStringPtr(t.FoursquareType)
+	res.GooglePlaceID = cloneStringPtr(t.GooglePlaceID)
+	res.GooglePlaceType = cloneStringPtr(t.GooglePlaceType)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *InputVenueMessageContent) Equal(other *InputVenueMessageContent) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Address == other.Address &&
+		equalStringPtr(t.FoursquareID, other.FoursquareID) &&
+		equalStringPtr(t.FoursquareType, other.FoursquareType) &&
+		equalStringPtr(t.GooglePlaceID, other.GooglePlaceID) &&
+		equalStringPtr(t.GooglePlaceType, other.GooglePlaceType) &&
+		t.Latitude == other.Latitude &&
+		t.Longitude == other.Longitude &&
+		t.Title == other.Title
+}
+
 // Invoice
 // This object contains basic information about an invoice.
 type Invoice struct {
@@ -7672,6 +12312,17 @@ type Invoice struct {
 	TotalAmount int64 `json:"total_amount"`
 }
 
+// NewInvoice creates Invoice with the required fields.
+func NewInvoice(currency string, description string, startParameter string, title string, totalAmount int64) *Invoice {
+	return &Invoice{
+		Currency:       currency,
+		Description:    description,
+		StartParameter: startParameter,
+		Title:          title,
+		TotalAmount:    totalAmount,
+	}
+}
+
 func (t *Invoice) GetCurrency() string {
 	var res string
 	if t == nil {
@@ -7712,6 +12363,27 @@ func (t *Invoice) GetTotalAmount() int64 {
 	return t.TotalAmount
 }
 
+// Clone returns a deep copy of t.
+func (t *Invoice) Clone() *Invoice {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *Invoice) Equal(other *Invoice) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Currency == other.Currency &&
+		t.Description == other.Description &&
+		t.StartParameter == other.StartParameter &&
+		t.Title == other.Title &&
+		t.TotalAmount == other.TotalAmount
+}
+
 // KeyboardButton
 // This object represents one button of the reply keyboard. For simple text buttons, String can be
 // used instead of this object to specify the button text. The optional fields web_app,
@@ -7750,6 +12422,43 @@ type KeyboardButton struct {
 	WebApp *WebAppInfo `json:"web_app,omitempty"`
 }
 
+// NewKeyboardButton creates KeyboardButton with the required fields.
+func NewKeyboardButton(text string) *KeyboardButton {
+	return &KeyboardButton{
+		Text: text,
+	}
+}
+
+func (t *KeyboardButton) SetRequestChat(requestChat KeyboardButtonRequestChat) *KeyboardButton {
+	t.RequestChat = &requestChat
+	return t
+}
+
+func (t *KeyboardButton) SetRequestContact(requestContact bool) *KeyboardButton {
+	t.RequestContact = &requestContact
+	return t
+}
+
+func (t *KeyboardButton) SetRequestLocation(requestLocation bool) *KeyboardButton {
+	t.RequestLocation = &requestLocation
+	return t
+}
+
+func (t *KeyboardButton) SetRequestPoll(requestPoll KeyboardButtonPollType) *KeyboardButton {
+	t.RequestPoll = &requestPoll
+	return t
+}
+
+func (t *KeyboardButton) SetRequestUser(requestUser KeyboardButtonRequestUser) *KeyboardButton {
+	t.RequestUser = &requestUser
+	return t
+}
+
+func (t *KeyboardButton) SetWebApp(webApp WebAppInfo) *KeyboardButton {
+	t.WebApp = &webApp
+	return t
+}
+
 func (t *KeyboardButton) GetRequestChat() *KeyboardButtonRequestChat {
 	if t == nil {
 		return nil
@@ -7808,6 +12517,35 @@ func (t *KeyboardButton) GetWebApp() *WebAppInfo {
 	return t.WebApp
 }
 
+// Clone returns a deep copy of t.
+func (t *KeyboardButton) Clone() *KeyboardButton {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.RequestChat = t.RequestChat.Clone()
+	res.RequestContact = cloneBoolPtr(t.RequestContact)
+	res.RequestLocation = cloneBoolPtr(t.RequestLocation)
+	res.RequestPoll = t.RequestPoll.Clone()
+	res.RequestUser = t.RequestUser.Clone()
+	res.WebApp = t.WebApp.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *KeyboardButton) Equal(other *KeyboardButton) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.RequestChat.Equal(other.RequestChat) &&
+		equalBoolPtr(t.RequestContact, other.RequestContact) &&
+		equalBoolPtr(t.RequestLocation, other.RequestLocation) &&
+		t.RequestPoll.Equal(other.RequestPoll) &&
+		t.RequestUser.Equal(other.RequestUser) &&
+		t.Text == other.Text &&
+		t.WebApp.Equal(other.WebApp)
+}
+
 // KeyboardButtonPollType
 // This object represents type of a poll, which is allowed to be created and sent when the
 // corresponding button is pressed.
@@ -7826,6 +12564,24 @@ func (t *KeyboardButtonPollType) GetType() *KeyboardButtonType {
 	return t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *KeyboardButtonPollType) Clone() *KeyboardButtonPollType {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Type = cloneKeyboardButtonTypePtr(t.Type)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *KeyboardButtonPollType) Equal(other *KeyboardButtonPollType) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalKeyboardButtonTypePtr(t.Type, other.Type)
+}
+
 // KeyboardButtonRequestChat
 // This object defines the criteria used to request a suitable chat. The identifier of the selected
 // chat will be shared with the bot when the corresponding button is pressed. More about requesting
@@ -7866,6 +12622,44 @@ type KeyboardButtonRequestChat struct {
 	UserAdministratorRights *ChatAdministratorRights `json:"user_administrator_rights,omitempty"`
 }
 
+// NewKeyboardButtonRequestChat creates KeyboardButtonRequestChat with the required fields.
+func NewKeyboardButtonRequestChat(chatIsChannel bool, requestID int64) *KeyboardButtonRequestChat {
+	return &KeyboardButtonRequestChat{
+		ChatIsChannel: chatIsChannel,
+		RequestID:     requestID,
+	}
+}
+
+func (t *KeyboardButtonRequestChat) SetBotAdministratorRights(botAdministratorRights ChatAdministratorRights) *KeyboardButtonRequestChat {
+	t.BotAdministratorRights = &botAdministratorRights
+	return t
+}
+
+func (t *KeyboardButtonRequestChat) SetBotIsMember(botIsMember bool) *KeyboardButtonRequestChat {
+	t.BotIsMember = &botIsMember
+	return t
+}
+
+func (t *KeyboardButtonRequestChat) SetChatHasUsername(chatHasUsername bool) *KeyboardButtonRequestChat {
+	t.ChatHasUsername = &chatHasUsername
+	return t
+}
+
+func (t *KeyboardButtonRequestChat) SetChatIsCreated(chatIsCreated bool) *KeyboardButtonRequestChat {
+	t.ChatIsCreated = &chatIsCreated
+	return t
+}
+
+func (t *KeyboardButtonRequestChat) SetChatIsForum(chatIsForum bool) *KeyboardButtonRequestChat {
+	t.ChatIsForum = &chatIsForum
+	return t
+}
+
+func (t *KeyboardButtonRequestChat) SetUserAdministratorRights(userAdministratorRights ChatAdministratorRights) *KeyboardButtonRequestChat {
+	t.UserAdministratorRights = &userAdministratorRights
+	return t
+}
+
 func (t *KeyboardButtonRequestChat) GetBotAdministratorRights() *ChatAdministratorRights {
 	if t == nil {
 		return nil
@@ -7940,6 +12734,36 @@ func (t *KeyboardButtonRequestChat) GetUserAdministratorRights() *ChatAdministra
 	return t.UserAdministratorRights
 }
 
+// Clone returns a deep copy of t.
+func (t *KeyboardButtonRequestChat) Clone() *KeyboardButtonRequestChat {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.BotAdministratorRights = t.BotAdministratorRights.Clone()
+	res.BotIsMember = cloneBoolPtr(t.BotIsMember)
+	res.ChatHasUsername = cloneBoolPtr(t.ChatHasUsername)
+	res.ChatIsCreated = cloneBoolPtr(t.ChatIsCreated)
+	res.ChatIsForum = cloneBoolPtr(t.ChatIsForum)
+	res.UserAdministratorRights = t.UserAdministratorRights.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *KeyboardButtonRequestChat) Equal(other *KeyboardButtonRequestChat) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.BotAdministratorRights.Equal(other.BotAdministratorRights) &&
+		equalBoolPtr(t.BotIsMember, other.BotIsMember) &&
+		equalBoolPtr(t.ChatHasUsername, other.ChatHasUsername) &&
+		t.ChatIsChannel == other.ChatIsChannel &&
+		equalBoolPtr(t.ChatIsCreated, other.ChatIsCreated) &&
+		equalBoolPtr(t.ChatIsForum, other.ChatIsForum) &&
+		t.RequestID == other.RequestID &&
+		t.UserAdministratorRights.Equal(other.UserAdministratorRights)
+}
+
 // KeyboardButtonRequestUser
 // This object defines the criteria used to request a suitable user. The identifier of the selected
 // user will be shared with the bot when the corresponding button is pressed. More about requesting
@@ -7959,6 +12783,23 @@ type KeyboardButtonRequestUser struct {
 	UserIsPremium *bool `json:"user_is_premium,omitempty"`
 }
 
+// NewKeyboardButtonRequestUser creates KeyboardButtonRequestUser with the required fields.
+func NewKeyboardButtonRequestUser(requestID int64) *KeyboardButtonRequestUser {
+	return &KeyboardButtonRequestUser{
+		RequestID: requestID,
+	}
+}
+
+func (t *KeyboardButtonRequestUser) SetUserIsBot(userIsBot bool) *KeyboardButtonRequestUser {
+	t.UserIsBot = &userIsBot
+	return t
+}
+
+func (t *KeyboardButtonRequestUser) SetUserIsPremium(userIsPremium bool) *KeyboardButtonRequestUser {
+	t.UserIsPremium = &userIsPremium
+	return t
+}
+
 func (t *KeyboardButtonRequestUser) GetRequestID() int64 {
 	var res int64
 	if t == nil {
@@ -7989,6 +12830,27 @@ func (t *KeyboardButtonRequestUser) GetUserIsPremium() bool {
 	return res
 }
 
+// Clone returns a deep copy of t.
+func (t *KeyboardButtonRequestUser) Clone() *KeyboardButtonRequestUser {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.UserIsBot = cloneBoolPtr(t.UserIsBot)
+	res.UserIsPremium = cloneBoolPtr(t.UserIsPremium)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *KeyboardButtonRequestUser) Equal(other *KeyboardButtonRequestUser) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.RequestID == other.RequestID &&
+		equalBoolPtr(t.UserIsBot, other.UserIsBot) &&
+		equalBoolPtr(t.UserIsPremium, other.UserIsPremium)
+}
+
 // LabeledPrice
 // This object represents a portion of the price for goods or services.
 type LabeledPrice struct {
@@ -8003,6 +12865,14 @@ type LabeledPrice struct {
 	Label string `json:"label"`
 }
 
+// NewLabeledPrice creates LabeledPrice with the required fields.
+func NewLabeledPrice(amount int64, label string) *LabeledPrice {
+	return &LabeledPrice{
+		Amount: amount,
+		Label:  label,
+	}
+}
+
 func (t *LabeledPrice) GetAmount() int64 {
 	var res int64
 	if t == nil {
@@ -8019,6 +12889,24 @@ func (t *LabeledPrice) GetLabel() string {
 	return t.Label
 }
 
+// Clone returns a deep copy of t.
+func (t *LabeledPrice) Clone() *LabeledPrice {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *LabeledPrice) Equal(other *LabeledPrice) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Amount == other.Amount &&
+		t.Label == other.Label
+}
+
 // Location
 // This object represents a point on the map.
 type Location struct {
@@ -8044,6 +12932,34 @@ type Location struct {
 	ProximityAlertRadius *int64 `json:"proximity_alert_radius,omitempty"`
 }
 
+// NewLocation creates Location with the required fields.
+func NewLocation(latitude float64, longitude float64) *Location {
+	return &Location{
+		Latitude:  latitude,
+		Longitude: longitude,
+	}
+}
+
+func (t *Location) SetHeading(heading int64) *Location {
+	t.Heading = &heading
+	return t
+}
+
+func (t *Location) SetHorizontalAccuracy(horizontalAccuracy float64) *Location {
+	t.HorizontalAccuracy = &horizontalAccuracy
+	return t
+}
+
+func (t *Location) SetLivePeriod(livePeriod int64) *Location {
+	t.LivePeriod = &livePeriod
+	return t
+}
+
+func (t *Location) SetProximityAlertRadius(proximityAlertRadius int64) *Location {
+	t.ProximityAlertRadius = &proximityAlertRadius
+	return t
+}
+
 func (t *Location) GetHeading() int64 {
 	var res int64
 	if t == nil {
@@ -8098,6 +13014,32 @@ func (t *Location) GetProximityAlertRadius() int64 {
 	return res
 }
 
+// Clone returns a deep copy of t.
+func (t *Location) Clone() *Location {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Heading = cloneInt64Ptr(t.Heading)
+	res.HorizontalAccuracy = cloneFloat64Ptr(t.HorizontalAccuracy)
+	res.LivePeriod = cloneInt64Ptr(t.LivePeriod)
+	res.ProximityAlertRadius = cloneInt64Ptr(t.ProximityAlertRadius)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *Location) Equal(other *Location) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalInt64Ptr(t.Heading, other.Heading) &&
+		equalFloat64Ptr(t.HorizontalAccuracy, other.HorizontalAccuracy) &&
+		t.Latitude == other.Latitude &&
+		equalInt64Ptr(t.LivePeriod, other.LivePeriod) &&
+		t.Longitude == other.Longitude &&
+		equalInt64Ptr(t.ProximityAlertRadius, other.ProximityAlertRadius)
+}
+
 // LoginURL
 // This object represents a parameter of the inline keyboard button used to automatically authorize
 // a user. Serves as a great replacement for the Telegram Login Widget when the user is coming from
@@ -8125,6 +13067,28 @@ type LoginURL struct {
 	RequestWriteAccess *bool `json:"request_write_access,omitempty"`
 }
 
+// NewLoginURL creates LoginURL with the required fields.
+func NewLoginURL(url string) *LoginURL {
+	return &LoginURL{
+		URL: url,
+	}
+}
+
+func (t *LoginURL) SetBotUsername(botUsername string) *LoginURL {
+	t.BotUsername = &botUsername
+	return t
+}
+
+func (t *LoginURL) SetForwardText(forwardText string) *LoginURL {
+	t.ForwardText = &forwardText
+	return t
+}
+
+func (t *LoginURL) SetRequestWriteAccess(requestWriteAccess bool) *LoginURL {
+	t.RequestWriteAccess = &requestWriteAccess
+	return t
+}
+
 func (t *LoginURL) GetBotUsername() string {
 	var res string
 	if t == nil {
@@ -8166,13 +13130,36 @@ func (t *LoginURL) GetURL() string {
 	return t.URL
 }
 
+// Clone returns a deep copy of t.
+func (t *LoginURL) Clone() *LoginURL {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.BotUsername = cloneStringPtr(t.BotUsername)
+	res.ForwardText = cloneStringPtr(t.ForwardText)
+	res.RequestWriteAccess = cloneBoolPtr(t.RequestWriteAccess)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *LoginURL) Equal(other *LoginURL) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.BotUsername, other.BotUsername) &&
+		equalStringPtr(t.ForwardText, other.ForwardText) &&
+		equalBoolPtr(t.RequestWriteAccess, other.RequestWriteAccess) &&
+		t.URL == other.URL
+}
+
 // MaskPosition
 // This object describes the position on faces where a mask should be placed by default.
 type MaskPosition struct {
 	// Point
 	// The part of the face relative to which the mask should be placed. One of "forehead", "eyes",
 	// "mouth", or "chin".
-	Point string `json:"point"`
+	Point MaskPoint `json:"point"`
 	// Scale
 	// Mask scaling coefficient. For example, 2.0 means double size.
 	Scale float64 `json:"scale"`
@@ -8186,12 +13173,21 @@ type MaskPosition struct {
 	YShift float64 `json:"y_shift"`
 }
 
-func (t *MaskPosition) GetPoint() string {
-	var res string
+// NewMaskPosition creates MaskPosition with the required fields.
+func NewMaskPosition(point MaskPoint, scale float64, xShift float64, yShift float64) *MaskPosition {
+	return &MaskPosition{
+		Point:  point,
+		Scale:  scale,
+		XShift: xShift,
+		YShift: yShift,
+	}
+}
+
+func (t *MaskPosition) GetPoint() *MaskPoint {
 	if t == nil {
-		return res
+		return nil
 	}
-	return t.Point
+	return &t.Point
 }
 
 func (t *MaskPosition) GetScale() *float64 {
@@ -8215,6 +13211,26 @@ func (t *MaskPosition) GetYShift() *float64 {
 	return &t.YShift
 }
 
+// Clone returns a deep copy of t.
+func (t *MaskPosition) Clone() *MaskPosition {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *MaskPosition) Equal(other *MaskPosition) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Point == other.Point &&
+		t.Scale == other.Scale &&
+		t.XShift == other.XShift &&
+		t.YShift == other.YShift
+}
+
 // MenuButton
 // This object describes the bot's menu button in a private chat. It should be one of
 type MenuButton struct {
@@ -8223,6 +13239,13 @@ type MenuButton struct {
 	Type MenuType `json:"type"`
 }
 
+// NewMenuButton creates MenuButton with the required fields.
+func NewMenuButton() *MenuButton {
+	return &MenuButton{
+		Type: MenuTypeCommands,
+	}
+}
+
 func (t *MenuButton) GetType() *MenuType {
 	if t == nil {
 		return nil
@@ -8230,6 +13253,23 @@ func (t *MenuButton) GetType() *MenuType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *MenuButton) Clone() *MenuButton {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *MenuButton) Equal(other *MenuButton) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Type == other.Type
+}
+
 // MenuButtonCommands
 // Represents a menu button, which opens the bot's list of commands.
 type MenuButtonCommands struct {
@@ -8238,6 +13278,13 @@ type MenuButtonCommands struct {
 	Type MenuType `json:"type"`
 }
 
+// NewMenuButtonCommands creates MenuButtonCommands with the required fields.
+func NewMenuButtonCommands() *MenuButtonCommands {
+	return &MenuButtonCommands{
+		Type: MenuTypeCommands,
+	}
+}
+
 func (t *MenuButtonCommands) GetType() *MenuType {
 	if t == nil {
 		return nil
@@ -8245,6 +13292,23 @@ func (t *MenuButtonCommands) GetType() *MenuType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *MenuButtonCommands) Clone() *MenuButtonCommands {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *MenuButtonCommands) Equal(other *MenuButtonCommands) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Type == other.Type
+}
+
 // MenuButtonDefault
 // Describes that no specific value for the menu button was set.
 type MenuButtonDefault struct {
@@ -8253,6 +13317,13 @@ type MenuButtonDefault struct {
 	Type MenuType `json:"type"`
 }
 
+// NewMenuButtonDefault creates MenuButtonDefault with the required fields.
+func NewMenuButtonDefault() *MenuButtonDefault {
+	return &MenuButtonDefault{
+		Type: MenuTypeDefault,
+	}
+}
+
 func (t *MenuButtonDefault) GetType() *MenuType {
 	if t == nil {
 		return nil
@@ -8260,9 +13331,26 @@ func (t *MenuButtonDefault) GetType() *MenuType {
 	return &t.Type
 }
 
-// MenuButtonWebApp
-// Represents a menu button, which launches a Web App.
-type MenuButtonWebApp struct {
+// Clone returns a deep copy of t.
+func (t *MenuButtonDefault) Clone() *MenuButtonDefault {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *MenuButtonDefault) Equal(other *MenuButtonDefault) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Type == other.Type
+}
+
+// MenuButtonWebApp
+// Represents a menu button, which launches a Web App.
+type MenuButtonWebApp struct {
 	// Text
 	// Text on the button
 	Text string `json:"text"`
@@ -8276,6 +13364,15 @@ type MenuButtonWebApp struct {
 	WebApp WebAppInfo `json:"web_app"`
 }
 
+// NewMenuButtonWebApp creates MenuButtonWebApp with the required fields.
+func NewMenuButtonWebApp(text string, webApp WebAppInfo) *MenuButtonWebApp {
+	return &MenuButtonWebApp{
+		Text:   text,
+		Type:   MenuTypeWebApp,
+		WebApp: webApp,
+	}
+}
+
 func (t *MenuButtonWebApp) GetText() string {
 	var res string
 	if t == nil {
@@ -8298,6 +13395,26 @@ func (t *MenuButtonWebApp) GetWebApp() *WebAppInfo {
 	return &t.WebApp
 }
 
+// Clone returns a deep copy of t.
+func (t *MenuButtonWebApp) Clone() *MenuButtonWebApp {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.WebApp = *t.WebApp.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *MenuButtonWebApp) Equal(other *MenuButtonWebApp) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Text == other.Text &&
+		t.Type == other.Type &&
+		t.WebApp.Equal(&other.WebApp)
+}
+
 // Message
 // This object represents a message.
 type Message struct {
@@ -8550,6 +13667,355 @@ type Message struct {
 	WriteAccessAllowed *WriteAccessAllowed `json:"write_access_allowed,omitempty"`
 }
 
+// NewMessage creates Message with the required fields.
+func NewMessage(chat Chat, date int64, messageID int64) *Message {
+	return &Message{
+		Chat:      chat,
+		Date:      date,
+		MessageID: messageID,
+	}
+}
+
+func (t *Message) SetAnimation(animation Animation) *Message {
+	t.Animation = &animation
+	return t
+}
+
+func (t *Message) SetAudio(audio Audio) *Message {
+	t.Audio = &audio
+	return t
+}
+
+func (t *Message) SetAuthorSignature(authorSignature string) *Message {
+	t.AuthorSignature = &authorSignature
+	return t
+}
+
+func (t *Message) SetCaption(caption string) *Message {
+	t.Caption = &caption
+	return t
+}
+
+func (t *Message) SetCaptionEntities(captionEntities []MessageEntity) *Message {
+	t.CaptionEntities = captionEntities
+	return t
+}
+
+func (t *Message) SetChannelChatCreated(channelChatCreated True) *Message {
+	t.ChannelChatCreated = &channelChatCreated
+	return t
+}
+
+func (t *Message) SetChatShared(chatShared ChatShared) *Message {
+	t.ChatShared = &chatShared
+	return t
+}
+
+func (t *Message) SetConnectedWebsite(connectedWebsite string) *Message {
+	t.ConnectedWebsite = &connectedWebsite
+	return t
+}
+
+func (t *Message) SetContact(contact Contact) *Message {
+	t.Contact = &contact
+	return t
+}
+
+func (t *Message) SetDeleteChatPhoto(deleteChatPhoto True) *Message {
+	t.DeleteChatPhoto = &deleteChatPhoto
+	return t
+}
+
+func (t *Message) SetDice(dice Dice) *Message {
+	t.Dice = &dice
+	return t
+}
+
+func (t *Message) SetDocument(document Document) *Message {
+	t.Document = &document
+	return t
+}
+
+func (t *Message) SetEditDate(editDate int64) *Message {
+	t.EditDate = &editDate
+	return t
+}
+
+func (t *Message) SetEntities(entities []MessageEntity) *Message {
+	t.Entities = entities
+	return t
+}
+
+func (t *Message) SetForumTopicClosed(forumTopicClosed ForumTopicClosed) *Message {
+	t.ForumTopicClosed = &forumTopicClosed
+	return t
+}
+
+func (t *Message) SetForumTopicCreated(forumTopicCreated ForumTopicCreated) *Message {
+	t.ForumTopicCreated = &forumTopicCreated
+	return t
+}
+
+func (t *Message) SetForumTopicEdited(forumTopicEdited ForumTopicEdited) *Message {
+	t.ForumTopicEdited = &forumTopicEdited
+	return t
+}
+
+func (t *Message) SetForumTopicReopened(forumTopicReopened ForumTopicReopened) *Message {
+	t.ForumTopicReopened = &forumTopicReopened
+	return t
+}
+
+func (t *Message) SetForwardDate(forwardDate int64) *Message {
+	t.ForwardDate = &forwardDate
+	return t
+}
+
+func (t *Message) SetForwardFrom(forwardFrom User) *Message {
+	t.ForwardFrom = &forwardFrom
+	return t
+}
+
+func (t *Message) SetForwardFromChat(forwardFromChat Chat) *Message {
+	t.ForwardFromChat = &forwardFromChat
+	return t
+}
+
+func (t *Message) SetForwardFromMessageID(forwardFromMessageID int64) *Message {
+	t.ForwardFromMessageID = &forwardFromMessageID
+	return t
+}
+
+func (t *Message) SetForwardSenderName(forwardSenderName string) *Message {
+	t.ForwardSenderName = &forwardSenderName
+	return t
+}
+
+func (t *Message) SetForwardSignature(forwardSignature string) *Message {
+	t.ForwardSignature = &forwardSignature
+	return t
+}
+
+func (t *Message) SetFrom(from User) *Message {
+	t.From = &from
+	return t
+}
+
+func (t *Message) SetGame(game Game) *Message {
+	t.Game = &game
+	return t
+}
+
+func (t *Message) SetGeneralForumTopicHidden(generalForumTopicHidden GeneralForumTopicHidden) *Message {
+	t.GeneralForumTopicHidden = &generalForumTopicHidden
+	return t
+}
+
+func (t *Message) SetGeneralForumTopicUnhidden(generalForumTopicUnhidden GeneralForumTopicUnhidden) *Message {
+	t.GeneralForumTopicUnhidden = &generalForumTopicUnhidden
+	return t
+}
+
+func (t *Message) SetGroupChatCreated(groupChatCreated True) *Message {
+	t.GroupChatCreated = &groupChatCreated
+	return t
+}
+
+func (t *Message) SetHasMediaSpoiler(hasMediaSpoiler True) *Message {
+	t.HasMediaSpoiler = &hasMediaSpoiler
+	return t
+}
+
+func (t *Message) SetHasProtectedContent(hasProtectedContent True) *Message {
+	t.HasProtectedContent = &hasProtectedContent
+	return t
+}
+
+func (t *Message) SetInvoice(invoice Invoice) *Message {
+	t.Invoice = &invoice
+	return t
+}
+
+func (t *Message) SetIsAutomaticForward(isAutomaticForward True) *Message {
+	t.IsAutomaticForward = &isAutomaticForward
+	return t
+}
+
+func (t *Message) SetIsTopicMessage(isTopicMessage True) *Message {
+	t.IsTopicMessage = &isTopicMessage
+	return t
+}
+
+func (t *Message) SetLeftChatMember(leftChatMember User) *Message {
+	t.LeftChatMember = &leftChatMember
+	return t
+}
+
+func (t *Message) SetLocation(location Location) *Message {
+	t.Location = &location
+	return t
+}
+
+func (t *Message) SetMediaGroupID(mediaGroupID string) *Message {
+	t.MediaGroupID = &mediaGroupID
+	return t
+}
+
+func (t *Message) SetMessageAutoDeleteTimerChanged(messageAutoDeleteTimerChanged MessageAutoDeleteTimerChanged) *Message {
+	t.MessageAutoDeleteTimerChanged = &messageAutoDeleteTimerChanged
+	return t
+}
+
+func (t *Message) SetMessageThreadID(messageThreadID int64) *Message {
+	t.MessageThreadID = &messageThreadID
+	return t
+}
+
+func (t *Message) SetMigrateFromChatID(migrateFromChatID int64) *Message {
+	t.MigrateFromChatID = &migrateFromChatID
+	return t
+}
+
+func (t *Message) SetMigrateToChatID(migrateToChatID int64) *Message {
+	t.MigrateToChatID = &migrateToChatID
+	return t
+}
+
+func (t *Message) SetNewChatMembers(newChatMembers []User) *Message {
+	t.NewChatMembers = newChatMembers
+	return t
+}
+
+func (t *Message) SetNewChatPhoto(newChatPhoto []PhotoSize) *Message {
+	t.NewChatPhoto = newChatPhoto
+	return t
+}
+
+func (t *Message) SetNewChatTitle(newChatTitle string) *Message {
+	t.NewChatTitle = &newChatTitle
+	return t
+}
+
+func (t *Message) SetPassportData(passportData PassportData) *Message {
+	t.PassportData = &passportData
+	return t
+}
+
+func (t *Message) SetPhoto(photo []PhotoSize) *Message {
+	t.Photo = photo
+	return t
+}
+
+func (t *Message) SetPinnedMessage(pinnedMessage Message) *Message {
+	t.PinnedMessage = &pinnedMessage
+	return t
+}
+
+func (t *Message) SetPoll(poll Poll) *Message {
+	t.Poll = &poll
+	return t
+}
+
+func (t *Message) SetProximityAlertTriggered(proximityAlertTriggered ProximityAlertTriggered) *Message {
+	t.ProximityAlertTriggered = &proximityAlertTriggered
+	return t
+}
+
+func (t *Message) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *Message {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
+func (t *Message) SetReplyToMessage(replyToMessage Message) *Message {
+	t.ReplyToMessage = &replyToMessage
+	return t
+}
+
+func (t *Message) SetSenderChat(senderChat Chat) *Message {
+	t.SenderChat = &senderChat
+	return t
+}
+
+func (t *Message) SetSticker(sticker Sticker) *Message {
+	t.Sticker = &sticker
+	return t
+}
+
+func (t *Message) SetSuccessfulPayment(successfulPayment SuccessfulPayment) *Message {
+	t.SuccessfulPayment = &successfulPayment
+	return t
+}
+
+func (t *Message) SetSupergroupChatCreated(supergroupChatCreated True) *Message {
+	t.SupergroupChatCreated = &supergroupChatCreated
+	return t
+}
+
+func (t *Message) SetText(text string) *Message {
+	t.Text = &text
+	return t
+}
+
+func (t *Message) SetUserShared(userShared UserShared) *Message {
+	t.UserShared = &userShared
+	return t
+}
+
+func (t *Message) SetVenue(venue Venue) *Message {
+	t.Venue = &venue
+	return t
+}
+
+func (t *Message) SetViaBot(viaBot User) *Message {
+	t.ViaBot = &viaBot
+	return t
+}
+
+func (t *Message) SetVideo(video Video) *Message {
+	t.Video = &video
+	return t
+}
+
+func (t *Message) SetVideoChatEnded(videoChatEnded VideoChatEnded) *Message {
+	t.VideoChatEnded = &videoChatEnded
+	return t
+}
+
+func (t *Message) SetVideoChatParticipantsInvited(videoChatParticipantsInvited VideoChatParticipantsInvited) *Message {
+	t.VideoChatParticipantsInvited = &videoChatParticipantsInvited
+	return t
+}
+
+func (t *Message) SetVideoChatScheduled(videoChatScheduled VideoChatScheduled) *Message {
+	t.VideoChatScheduled = &videoChatScheduled
+	return t
+}
+
+func (t *Message) SetVideoChatStarted(videoChatStarted VideoChatStarted) *Message {
+	t.VideoChatStarted = &videoChatStarted
+	return t
+}
+
+func (t *Message) SetVideoNote(videoNote VideoNote) *Message {
+	t.VideoNote = &videoNote
+	return t
+}
+
+func (t *Message) SetVoice(voice Voice) *Message {
+	t.Voice = &voice
+	return t
+}
+
+func (t *Message) SetWebAppData(webAppData WebAppData) *Message {
+	t.WebAppData = &webAppData
+	return t
+}
+
+func (t *Message) SetWriteAccessAllowed(writeAccessAllowed WriteAccessAllowed) *Message {
+	t.WriteAccessAllowed = &writeAccessAllowed
+	return t
+}
+
 func (t *Message) GetAnimation() *Animation {
 	if t == nil {
 		return nil
@@ -9070,6 +14536,162 @@ func (t *Message) GetWriteAccessAllowed() *WriteAccessAllowed {
 	return t.WriteAccessAllowed
 }
 
+// Clone returns a deep copy of t.
+func (t *Message) Clone() *Message {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Animation = t.Animation.Clone()
+	res.Audio = t.Audio.Clone()
+	res.AuthorSignature = cloneStringPtr(t.AuthorSignature)
+	res.Caption = cloneStringPtr(t.Caption)
+	res.CaptionEntities = cloneMessageEntitySlice(t.CaptionEntities)
+	res.ChannelChatCreated = cloneTruePtr(t.ChannelChatCreated)
+	res.Chat = *t.Chat.Clone()
+	res.ChatShared = t.ChatShared.Clone()
+	res.ConnectedWebsite = cloneStringPtr(t.ConnectedWebsite)
+	res.Contact = t.Contact.Clone()
+	res.DeleteChatPhoto = cloneTruePtr(t.DeleteChatPhoto)
+	res.Dice = t.Dice.Clone()
+	res.Document = t.Document.Clone()
+	res.EditDate = cloneInt64Ptr(t.EditDate)
+	res.Entities = cloneMessageEntitySlice(t.Entities)
+	res.ForumTopicClosed = t.ForumTopicClosed.Clone()
+	res.ForumTopicCreated = t.ForumTopicCreated.Clone()
+	res.ForumTopicEdited = t.ForumTopicEdited.Clone()
+	res.ForumTopicReopened = t.ForumTopicReopened.Clone()
+	res.ForwardDate = cloneInt64Ptr(t.ForwardDate)
+	res.ForwardFrom = t.ForwardFrom.Clone()
+	res.ForwardFromChat = t.ForwardFromChat.Clone()
+	res.ForwardFromMessageID = cloneInt64Ptr(t.ForwardFromMessageID)
+	res.ForwardSenderName = cloneStringPtr(t.ForwardSenderName)
+	res.ForwardSignature = cloneStringPtr(t.ForwardSignature)
+	res.From = t.From.Clone()
+	res.Game = t.Game.Clone()
+	res.GeneralForumTopicHidden = t.GeneralForumTopicHidden.Clone()
+	res.GeneralForumTopicUnhidden = t.GeneralForumTopicUnhidden.Clone()
+	res.GroupChatCreated = cloneTruePtr(t.GroupChatCreated)
+	res.HasMediaSpoiler = cloneTruePtr(t.HasMediaSpoiler)
+	res.HasProtectedContent = cloneTruePtr(t.HasProtectedContent)
+	res.Invoice = t.Invoice.Clone()
+	res.IsAutomaticForward = cloneTruePtr(t.IsAutomaticForward)
+	res.IsTopicMessage = cloneTruePtr(t.IsTopicMessage)
+	res.LeftChatMember = t.LeftChatMember.Clone()
+	res.Location = t.Location.Clone()
+	res.MediaGroupID = cloneStringPtr(t.MediaGroupID)
+	res.MessageAutoDeleteTimerChanged = t.MessageAutoDeleteTimerChanged.Clone()
+	res.MessageThreadID = cloneInt64Ptr(t.MessageThreadID)
+	res.MigrateFromChatID = cloneInt64Ptr(t.MigrateFromChatID)
+	res.MigrateToChatID = cloneInt64Ptr(t.MigrateToChatID)
+	res.NewChatMembers = cloneUserSlice(t.NewChatMembers)
+	res.NewChatPhoto = clonePhotoSizeSlice(t.NewChatPhoto)
+	res.NewChatTitle = cloneStringPtr(t.NewChatTitle)
+	res.PassportData = t.PassportData.Clone()
+	res.Photo = clonePhotoSizeSlice(t.Photo)
+	res.PinnedMessage = t.PinnedMessage.Clone()
+	res.Poll = t.Poll.Clone()
+	res.ProximityAlertTriggered = t.ProximityAlertTriggered.Clone()
+	res.ReplyMarkup = t.ReplyMarkup.Clone()
+	res.ReplyToMessage = t.ReplyToMessage.Clone()
+	res.SenderChat = t.SenderChat.Clone()
+	res.Sticker = t.Sticker.Clone()
+	res.SuccessfulPayment = t.SuccessfulPayment.Clone()
+	res.SupergroupChatCreated = cloneTruePtr(t.SupergroupChatCreated)
+	res.Text = cloneStringPtr(t.Text)
+	res.UserShared = t.UserShared.Clone()
+	res.Venue = t.Venue.Clone()
+	res.ViaBot = t.ViaBot.Clone()
+	res.Video = t.Video.Clone()
+	res.VideoChatEnded = t.VideoChatEnded.Clone()
+	res.VideoChatParticipantsInvited = t.VideoChatParticipantsInvited.Clone()
+	res.VideoChatScheduled = t.VideoChatScheduled.Clone()
+	res.VideoChatStarted = t.VideoChatStarted.Clone()
+	res.VideoNote = t.VideoNote.Clone()
+	res.Voice = t.Voice.Clone()
+	res.WebAppData = t.WebAppData.Clone()
+	res.WriteAccessAllowed = t.WriteAccessAllowed.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *Message) Equal(other *Message) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Animation.Equal(other.Animation) &&
+		t.Audio.Equal(other.Audio) &&
+		equalStringPtr(t.AuthorSignature, other.AuthorSignature) &&
+		equalStringPtr(t.Caption, other.Caption) &&
+		equalMessageEntitySlice(t.CaptionEntities, other.CaptionEntities) &&
+		equalTruePtr(t.ChannelChatCreated, other.ChannelChatCreated) &&
+		t.Chat.Equal(&other.Chat) &&
+		t.ChatShared.Equal(other.ChatShared) &&
+		equalStringPtr(t.ConnectedWebsite, other.ConnectedWebsite) &&
+		t.Contact.Equal(other.Contact) &&
+		t.Date == other.Date &&
+		equalTruePtr(t.DeleteChatPhoto, other.DeleteChatPhoto) &&
+		t.Dice.Equal(other.Dice) &&
+		t.Document.Equal(other.Document) &&
+		equalInt64Ptr(t.EditDate, other.EditDate) &&
+		equalMessageEntitySlice(t.Entities, other.Entities) &&
+		t.ForumTopicClosed.Equal(other.ForumTopicClosed) &&
+		t.ForumTopicCreated.Equal(other.ForumTopicCreated) &&
+		t.ForumTopicEdited.Equal(other.ForumTopicEdited) &&
+		t.ForumTopicReopened.Equal(other.ForumTopicReopened) &&
+		equalInt64Ptr(t.ForwardDate, other.ForwardDate) &&
+		t.ForwardFrom.Equal(other.ForwardFrom) &&
+		t.ForwardFromChat.Equal(other.ForwardFromChat) &&
+		equalInt64Ptr(t.ForwardFromMessageID, other.ForwardFromMessageID) &&
+		equalStringPtr(t.ForwardSenderName, other.ForwardSenderName) &&
+		equalStringPtr(t.ForwardSignature, other.ForwardSignature) &&
+		t.From.Equal(other.From) &&
+		t.Game.Equal(other.Game) &&
+		t.GeneralForumTopicHidden.Equal(other.GeneralForumTopicHidden) &&
+		t.GeneralForumTopicUnhidden.Equal(other.GeneralForumTopicUnhidden) &&
+		equalTruePtr(t.GroupChatCreated, other.GroupChatCreated) &&
+		equalTruePtr(t.HasMediaSpoiler, other.HasMediaSpoiler) &&
+		equalTruePtr(t.HasProtectedContent, other.HasProtectedContent) &&
+		t.Invoice.Equal(other.Invoice) &&
+		equalTruePtr(t.IsAutomaticForward, other.IsAutomaticForward) &&
+		equalTruePtr(t.IsTopicMessage, other.IsTopicMessage) &&
+		t.LeftChatMember.Equal(other.LeftChatMember) &&
+		t.Location.Equal(other.Location) &&
+		equalStringPtr(t.MediaGroupID, other.MediaGroupID) &&
+		t.MessageAutoDeleteTimerChanged.Equal(other.MessageAutoDeleteTimerChanged) &&
+		t.MessageID == other.MessageID &&
+		equalInt64Ptr(t.MessageThreadID, other.MessageThreadID) &&
+		equalInt64Ptr(t.MigrateFromChatID, other.MigrateFromChatID) &&
+		equalInt64Ptr(t.MigrateToChatID, other.MigrateToChatID) &&
+		equalUserSlice(t.NewChatMembers, other.NewChatMembers) &&
+		equalPhotoSizeSlice(t.NewChatPhoto, other.NewChatPhoto) &&
+		equalStringPtr(t.NewChatTitle, other.NewChatTitle) &&
+		t.PassportData.Equal(other.PassportData) &&
+		equalPhotoSizeSlice(t.Photo, other.Photo) &&
+		t.PinnedMessage.Equal(other.PinnedMessage) &&
+		t.Poll.Equal(other.Poll) &&
+		t.ProximityAlertTriggered.Equal(other.ProximityAlertTriggered) &&
+		t.ReplyMarkup.Equal(other.ReplyMarkup) &&
+		t.ReplyToMessage.Equal(other.ReplyToMessage) &&
+		t.SenderChat.Equal(other.SenderChat) &&
+		t.Sticker.Equal(other.Sticker) &&
+		t.SuccessfulPayment.Equal(other.SuccessfulPayment) &&
+		equalTruePtr(t.SupergroupChatCreated, other.SupergroupChatCreated) &&
+		equalStringPtr(t.Text, other.Text) &&
+		t.UserShared.Equal(other.UserShared) &&
+		t.Venue.Equal(other.Venue) &&
+		t.ViaBot.Equal(other.ViaBot) &&
+		t.Video.Equal(other.Video) &&
+		t.VideoChatEnded.Equal(other.VideoChatEnded) &&
+		t.VideoChatParticipantsInvited.Equal(other.VideoChatParticipantsInvited) &&
+		t.VideoChatScheduled.Equal(other.VideoChatScheduled) &&
+		t.VideoChatStarted.Equal(other.VideoChatStarted) &&
+		t.VideoNote.Equal(other.VideoNote) &&
+		t.Voice.Equal(other.Voice) &&
+		t.WebAppData.Equal(other.WebAppData) &&
+		t.WriteAccessAllowed.Equal(other.WriteAccessAllowed)
+}
+
 // MessageAutoDeleteTimerChanged
 // This object represents a service message about a change in auto-delete timer settings.
 type MessageAutoDeleteTimerChanged struct {
@@ -9078,6 +14700,13 @@ type MessageAutoDeleteTimerChanged struct {
 	MessageAutoDeleteTime int64 `json:"message_auto_delete_time"`
 }
 
+// NewMessageAutoDeleteTimerChanged creates MessageAutoDeleteTimerChanged with the required fields.
+func NewMessageAutoDeleteTimerChanged(messageAutoDeleteTime int64) *MessageAutoDeleteTimerChanged {
+	return &MessageAutoDeleteTimerChanged{
+		MessageAutoDeleteTime: messageAutoDeleteTime,
+	}
+}
+
 func (t *MessageAutoDeleteTimerChanged) GetMessageAutoDeleteTime() int64 {
 	var res int64
 	if t == nil {
@@ -9086,6 +14715,23 @@ func (t *MessageAutoDeleteTimerChanged) GetMessageAutoDeleteTime() int64 {
 	return t.MessageAutoDeleteTime
 }
 
+// Clone returns a deep copy of t.
+func (t *MessageAutoDeleteTimerChanged) Clone() *MessageAutoDeleteTimerChanged {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *MessageAutoDeleteTimerChanged) Equal(other *MessageAutoDeleteTimerChanged) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.MessageAutoDeleteTime == other.MessageAutoDeleteTime
+}
+
 // MessageEntity
 // This object represents one special entity in a text message. For example, hashtags, usernames,
 // URLs, etc.
@@ -9120,6 +14766,35 @@ type MessageEntity struct {
 	User *User `json:"user,omitempty"`
 }
 
+// NewMessageEntity creates MessageEntity with the required fields.
+func NewMessageEntity(length int64, offset int64, typ EntityType) *MessageEntity {
+	return &MessageEntity{
+		Length: length,
+		Offset: offset,
+		Type:   typ,
+	}
+}
+
+func (t *MessageEntity) SetCustomEmojiID(customEmojiID string) *MessageEntity {
+	t.CustomEmojiID = &customEmojiID
+	return t
+}
+
+func (t *MessageEntity) SetLanguage(language string) *MessageEntity {
+	t.Language = &language
+	return t
+}
+
+func (t *MessageEntity) SetURL(url string) *MessageEntity {
+	t.URL = &url
+	return t
+}
+
+func (t *MessageEntity) SetUser(user User) *MessageEntity {
+	t.User = &user
+	return t
+}
+
 func (t *MessageEntity) GetCustomEmojiID() string {
 	var res string
 	if t == nil {
@@ -9183,6 +14858,33 @@ func (t *MessageEntity) GetUser() *User {
 	return t.User
 }
 
+// Clone returns a deep copy of t.
+func (t *MessageEntity) Clone() *MessageEntity {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.CustomEmojiID = cloneStringPtr(t.CustomEmojiID)
+	res.Language = cloneStringPtr(t.Language)
+	res.URL = cloneStringPtr(t.URL)
+	res.User = t.User.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *MessageEntity) Equal(other *MessageEntity) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.CustomEmojiID, other.CustomEmojiID) &&
+		equalStringPtr(t.Language, other.Language) &&
+		t.Length == other.Length &&
+		t.Offset == other.Offset &&
+		t.Type == other.Type &&
+		equalStringPtr(t.URL, other.URL) &&
+		t.User.Equal(other.User)
+}
+
 // MessageID
 // This object represents a unique message identifier.
 type MessageID struct {
@@ -9191,6 +14893,13 @@ type MessageID struct {
 	MessageID int64 `json:"message_id"`
 }
 
+// NewMessageID creates MessageID with the required fields.
+func NewMessageID(messageID int64) *MessageID {
+	return &MessageID{
+		MessageID: messageID,
+	}
+}
+
 func (t *MessageID) GetMessageID() int64 {
 	var res int64
 	if t == nil {
@@ -9199,6 +14908,23 @@ func (t *MessageID) GetMessageID() int64 {
 	return t.MessageID
 }
 
+// Clone returns a deep copy of t.
+func (t *MessageID) Clone() *MessageID {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *MessageID) Equal(other *MessageID) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.MessageID == other.MessageID
+}
+
 // OrderInfo
 // This object represents information about an order.
 type OrderInfo struct {
@@ -9256,6 +14982,30 @@ func (t *OrderInfo) GetShippingAddress() *ShippingAddress {
 	return t.ShippingAddress
 }
 
+// Clone returns a deep copy of t.
+func (t *OrderInfo) Clone() *OrderInfo {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Email = cloneStringPtr(t.Email)
+	res.Name = cloneStringPtr(t.Name)
+	res.PhoneNumber = cloneStringPtr(t.PhoneNumber)
+	res.ShippingAddress = t.ShippingAddress.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *OrderInfo) Equal(other *OrderInfo) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.Email, other.Email) &&
+		equalStringPtr(t.Name, other.Name) &&
+		equalStringPtr(t.PhoneNumber, other.PhoneNumber) &&
+		t.ShippingAddress.Equal(other.ShippingAddress)
+}
+
 // PassportData
 // Describes Telegram Passport data shared with the bot by the user.
 type PassportData struct {
@@ -9268,6 +15018,14 @@ type PassportData struct {
 	Data []EncryptedPassportElement `json:"data"`
 }
 
+// NewPassportData creates PassportData with the required fields.
+func NewPassportData(credentials EncryptedCredentials, data []EncryptedPassportElement) *PassportData {
+	return &PassportData{
+		Credentials: credentials,
+		Data:        data,
+	}
+}
+
 func (t *PassportData) GetCredentials() *EncryptedCredentials {
 	if t == nil {
 		return nil
@@ -9275,6 +15033,26 @@ func (t *PassportData) GetCredentials() *EncryptedCredentials {
 	return &t.Credentials
 }
 
+// Clone returns a deep copy of t.
+func (t *PassportData) Clone() *PassportData {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Credentials = *t.Credentials.Clone()
+	res.Data = cloneEncryptedPassportElementSlice(t.Data)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *PassportData) Equal(other *PassportData) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Credentials.Equal(&other.Credentials) &&
+		equalEncryptedPassportElementSlice(t.Data, other.Data)
+}
+
 // PassportElementError
 // This object represents an error in the Telegram Passport element which was submitted that should
 // be resolved by the user. It should be one of:
@@ -9297,6 +15075,17 @@ type PassportElementError struct {
 	Type PassportType `json:"type"`
 }
 
+// NewPassportElementError creates PassportElementError with the required fields.
+func NewPassportElementError(dataHash string, fieldName string, message string, source string, typ PassportType) *PassportElementError {
+	return &PassportElementError{
+		DataHash:  dataHash,
+		FieldName: fieldName,
+		Message:   message,
+		Source:    source,
+		Type:      typ,
+	}
+}
+
 func (t *PassportElementError) GetDataHash() string {
 	var res string
 	if t == nil {
@@ -9336,6 +15125,27 @@ func (t *PassportElementError) GetType() *PassportType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *PassportElementError) Clone() *PassportElementError {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *PassportElementError) Equal(other *PassportElementError) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.DataHash == other.DataHash &&
+		t.FieldName == other.FieldName &&
+		t.Message == other.Message &&
+		t.Source == other.Source &&
+		t.Type == other.Type
+}
+
 // PassportElementErrorDataField
 // Represents an issue in one of the data fields that was provided by the user. The error is
 // considered resolved when the field's value changes.
@@ -9358,6 +15168,17 @@ type PassportElementErrorDataField struct {
 	Type PassportType `json:"type"`
 }
 
+// NewPassportElementErrorDataField creates PassportElementErrorDataField with the required fields.
+func NewPassportElementErrorDataField(dataHash string, fieldName string, message string, source string, typ PassportType) *PassportElementErrorDataField {
+	return &PassportElementErrorDataField{
+		DataHash:  dataHash,
+		FieldName: fieldName,
+		Message:   message,
+		Source:    source,
+		Type:      typ,
+	}
+}
+
 func (t *PassportElementErrorDataField) GetDataHash() string {
 	var res string
 	if t == nil {
@@ -9397,6 +15218,27 @@ func (t *PassportElementErrorDataField) GetType() *PassportType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *PassportElementErrorDataField) Clone() *PassportElementErrorDataField {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *PassportElementErrorDataField) Equal(other *PassportElementErrorDataField) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.DataHash == other.DataHash &&
+		t.FieldName == other.FieldName &&
+		t.Message == other.Message &&
+		t.Source == other.Source &&
+		t.Type == other.Type
+}
+
 // PassportElementErrorFile
 // Represents an issue with a document scan. The error is considered resolved when the file with
 // the document scan changes.
@@ -9416,6 +15258,16 @@ type PassportElementErrorFile struct {
 	Type PassportType `json:"type"`
 }
 
+// NewPassportElementErrorFile creates PassportElementErrorFile with the required fields.
+func NewPassportElementErrorFile(fileHash string, message string, source string, typ PassportType) *PassportElementErrorFile {
+	return &PassportElementErrorFile{
+		FileHash: fileHash,
+		Message:  message,
+		Source:   source,
+		Type:     typ,
+	}
+}
+
 func (t *PassportElementErrorFile) GetFileHash() string {
 	var res string
 	if t == nil {
@@ -9447,6 +15299,26 @@ func (t *PassportElementErrorFile) GetType() *PassportType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *PassportElementErrorFile) Clone() *PassportElementErrorFile {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *PassportElementErrorFile) Equal(other *PassportElementErrorFile) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.FileHash == other.FileHash &&
+		t.Message == other.Message &&
+		t.Source == other.Source &&
+		t.Type == other.Type
+}
+
 // PassportElementErrorFiles
 // Represents an issue with a list of scans. The error is considered resolved when the list of
 // files containing the scans changes.
@@ -9466,6 +15338,16 @@ type PassportElementErrorFiles struct {
 	Type PassportType `json:"type"`
 }
 
+// NewPassportElementErrorFiles creates PassportElementErrorFiles with the required fields.
+func NewPassportElementErrorFiles(fileHashes []string, message string, source string, typ PassportType) *PassportElementErrorFiles {
+	return &PassportElementErrorFiles{
+		FileHashes: fileHashes,
+		Message:    message,
+		Source:     source,
+		Type:       typ,
+	}
+}
+
 func (t *PassportElementErrorFiles) GetMessage() string {
 	var res string
 	if t == nil {
@@ -9489,6 +15371,27 @@ func (t *PassportElementErrorFiles) GetType() *PassportType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *PassportElementErrorFiles) Clone() *PassportElementErrorFiles {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.FileHashes = cloneStringSlice(t.FileHashes)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *PassportElementErrorFiles) Equal(other *PassportElementErrorFiles) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringSlice(t.FileHashes, other.FileHashes) &&
+		t.Message == other.Message &&
+		t.Source == other.Source &&
+		t.Type == other.Type
+}
+
 // PassportElementErrorFrontSide
 // Represents an issue with the front side of a document. The error is considered resolved when the
 // file with the front side of the document changes.
@@ -9508,6 +15411,16 @@ type PassportElementErrorFrontSide struct {
 	Type PassportType `json:"type"`
 }
 
+// NewPassportElementErrorFrontSide creates PassportElementErrorFrontSide with the required fields.
+func NewPassportElementErrorFrontSide(fileHash string, message string, source string, typ PassportType) *PassportElementErrorFrontSide {
+	return &PassportElementErrorFrontSide{
+		FileHash: fileHash,
+		Message:  message,
+		Source:   source,
+		Type:     typ,
+	}
+}
+
 func (t *PassportElementErrorFrontSide) GetFileHash() string {
 	var res string
 	if t == nil {
@@ -9539,6 +15452,26 @@ func (t *PassportElementErrorFrontSide) GetType() *PassportType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *PassportElementErrorFrontSide) Clone() *PassportElementErrorFrontSide {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *PassportElementErrorFrontSide) Equal(other *PassportElementErrorFrontSide) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.FileHash == other.FileHash &&
+		t.Message == other.Message &&
+		t.Source == other.Source &&
+		t.Type == other.Type
+}
+
 // PassportElementErrorReverseSide
 // Represents an issue with the reverse side of a document. The error is considered resolved when
 // the file with reverse side of the document changes.
@@ -9558,6 +15491,16 @@ type PassportElementErrorReverseSide struct {
 	Type PassportType `json:"type"`
 }
 
+// NewPassportElementErrorReverseSide creates PassportElementErrorReverseSide with the required fields.
+func NewPassportElementErrorReverseSide(fileHash string, message string, source string, typ PassportType) *PassportElementErrorReverseSide {
+	return &PassportElementErrorReverseSide{
+		FileHash: fileHash,
+		Message:  message,
+		Source:   source,
+		Type:     typ,
+	}
+}
+
 func (t *PassportElementErrorReverseSide) GetFileHash() string {
 	var res string
 	if t == nil {
@@ -9589,6 +15532,26 @@ func (t *PassportElementErrorReverseSide) GetType() *PassportType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *PassportElementErrorReverseSide) Clone() *PassportElementErrorReverseSide {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *PassportElementErrorReverseSide) Equal(other *PassportElementErrorReverseSide) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.FileHash == other.FileHash &&
+		t.Message == other.Message &&
+		t.Source == other.Source &&
+		t.Type == other.Type
+}
+
 // PassportElementErrorSelfie
 // Represents an issue with the selfie with a document. The error is considered resolved when the
 // file with the selfie changes.
@@ -9608,6 +15571,16 @@ type PassportElementErrorSelfie struct {
 	Type PassportType `json:"type"`
 }
 
+// NewPassportElementErrorSelfie creates PassportElementErrorSelfie with the required fields.
+func NewPassportElementErrorSelfie(fileHash string, message string, source string, typ PassportType) *PassportElementErrorSelfie {
+	return &PassportElementErrorSelfie{
+		FileHash: fileHash,
+		Message:  message,
+		Source:   source,
+		Type:     typ,
+	}
+}
+
 func (t *PassportElementErrorSelfie) GetFileHash() string {
 	var res string
 	if t == nil {
@@ -9639,6 +15612,26 @@ func (t *PassportElementErrorSelfie) GetType() *PassportType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *PassportElementErrorSelfie) Clone() *PassportElementErrorSelfie {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *PassportElementErrorSelfie) Equal(other *PassportElementErrorSelfie) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.FileHash == other.FileHash &&
+		t.Message == other.Message &&
+		t.Source == other.Source &&
+		t.Type == other.Type
+}
+
 // PassportElementErrorTranslationFile
 // Represents an issue with one of the files that constitute the translation of a document. The
 // error is considered resolved when the file changes.
@@ -9659,6 +15652,16 @@ type PassportElementErrorTranslationFile struct {
 	Type PassportType `json:"type"`
 }
 
+// NewPassportElementErrorTranslationFile creates PassportElementErrorTranslationFile with the required fields.
+func NewPassportElementErrorTranslationFile(fileHash string, message string, source string, typ PassportType) *PassportElementErrorTranslationFile {
+	return &PassportElementErrorTranslationFile{
+		FileHash: fileHash,
+		Message:  message,
+		Source:   source,
+		Type:     typ,
+	}
+}
+
 func (t *PassportElementErrorTranslationFile) GetFileHash() string {
 	var res string
 	if t == nil {
@@ -9690,6 +15693,26 @@ func (t *PassportElementErrorTranslationFile) GetType() *PassportType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *PassportElementErrorTranslationFile) Clone() *PassportElementErrorTranslationFile {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *PassportElementErrorTranslationFile) Equal(other *PassportElementErrorTranslationFile) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.FileHash == other.FileHash &&
+		t.Message == other.Message &&
+		t.Source == other.Source &&
+		t.Type == other.Type
+}
+
 // PassportElementErrorTranslationFiles
 // Represents an issue with the translated version of a document. The error is considered resolved
 // when a file with the document translation change.
@@ -9710,6 +15733,16 @@ type PassportElementErrorTranslationFiles struct {
 	Type PassportType `json:"type"`
 }
 
+// NewPassportElementErrorTranslationFiles creates PassportElementErrorTranslationFiles with the required fields.
+func NewPassportElementErrorTranslationFiles(fileHashes []string, message string, source string, typ PassportType) *PassportElementErrorTranslationFiles {
+	return &PassportElementErrorTranslationFiles{
+		FileHashes: fileHashes,
+		Message:    message,
+		Source:     source,
+		Type:       typ,
+	}
+}
+
 func (t *PassportElementErrorTranslationFiles) GetMessage() string {
 	var res string
 	if t == nil {
@@ -9733,6 +15766,27 @@ func (t *PassportElementErrorTranslationFiles) GetType() *PassportType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *PassportElementErrorTranslationFiles) Clone() *PassportElementErrorTranslationFiles {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.FileHashes = cloneStringSlice(t.FileHashes)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *PassportElementErrorTranslationFiles) Equal(other *PassportElementErrorTranslationFiles) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringSlice(t.FileHashes, other.FileHashes) &&
+		t.Message == other.Message &&
+		t.Source == other.Source &&
+		t.Type == other.Type
+}
+
 // PassportElementErrorUnspecified
 // Represents an issue in an unspecified place. The error is considered resolved when new data is
 // added.
@@ -9751,6 +15805,16 @@ type PassportElementErrorUnspecified struct {
 	Type PassportType `json:"type"`
 }
 
+// NewPassportElementErrorUnspecified creates PassportElementErrorUnspecified with the required fields.
+func NewPassportElementErrorUnspecified(elementHash string, message string, source string, typ PassportType) *PassportElementErrorUnspecified {
+	return &PassportElementErrorUnspecified{
+		ElementHash: elementHash,
+		Message:     message,
+		Source:      source,
+		Type:        typ,
+	}
+}
+
 func (t *PassportElementErrorUnspecified) GetElementHash() string {
 	var res string
 	if t == nil {
@@ -9782,6 +15846,26 @@ func (t *PassportElementErrorUnspecified) GetType() *PassportType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *PassportElementErrorUnspecified) Clone() *PassportElementErrorUnspecified {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *PassportElementErrorUnspecified) Equal(other *PassportElementErrorUnspecified) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.ElementHash == other.ElementHash &&
+		t.Message == other.Message &&
+		t.Source == other.Source &&
+		t.Type == other.Type
+}
+
 // PassportFile
 // This object represents a file uploaded to Telegram Passport. Currently all Telegram Passport
 // files are in JPEG format when decrypted and don't exceed 10MB.
@@ -9801,6 +15885,16 @@ type PassportFile struct {
 	FileUniqueID string `json:"file_unique_id"`
 }
 
+// NewPassportFile creates PassportFile with the required fields.
+func NewPassportFile(fileDate int64, fileID string, fileSize int64, fileUniqueID string) *PassportFile {
+	return &PassportFile{
+		FileDate:     fileDate,
+		FileID:       fileID,
+		FileSize:     fileSize,
+		FileUniqueID: fileUniqueID,
+	}
+}
+
 func (t *PassportFile) GetFileDate() int64 {
 	var res int64
 	if t == nil {
@@ -9833,6 +15927,26 @@ func (t *PassportFile) GetFileUniqueID() string {
 	return t.FileUniqueID
 }
 
+// Clone returns a deep copy of t.
+func (t *PassportFile) Clone() *PassportFile {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *PassportFile) Equal(other *PassportFile) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.FileDate == other.FileDate &&
+		t.FileID == other.FileID &&
+		t.FileSize == other.FileSize &&
+		t.FileUniqueID == other.FileUniqueID
+}
+
 // Payments
 // Your bot can accept payments from Telegram users. Please see the introduction to payments for
 // more details on the process and how to set up payments for your bot. Please note that users will
@@ -9941,6 +16055,124 @@ type Payments struct {
 	SuggestedTipAmounts []int64 `json:"suggested_tip_amounts,omitempty"`
 }
 
+// NewPayments creates Payments with the required fields.
+func NewPayments(chatID IntStr, currency string, description string, payload string, prices []LabeledPrice, providerToken string, title string) *Payments {
+	return &Payments{
+		ChatID:        chatID,
+		Currency:      currency,
+		Description:   description,
+		Payload:       payload,
+		Prices:        prices,
+		ProviderToken: providerToken,
+		Title:         title,
+	}
+}
+
+func (t *Payments) SetAllowSendingWithoutReply(allowSendingWithoutReply bool) *Payments {
+	t.AllowSendingWithoutReply = &allowSendingWithoutReply
+	return t
+}
+
+func (t *Payments) SetDisableNotification(disableNotification bool) *Payments {
+	t.DisableNotification = &disableNotification
+	return t
+}
+
+func (t *Payments) SetIsFlexible(isFlexible bool) *Payments {
+	t.IsFlexible = &isFlexible
+	return t
+}
+
+func (t *Payments) SetMaxTipAmount(maxTipAmount int64) *Payments {
+	t.MaxTipAmount = &maxTipAmount
+	return t
+}
+
+func (t *Payments) SetMessageThreadID(messageThreadID int64) *Payments {
+	t.MessageThreadID = &messageThreadID
+	return t
+}
+
+func (t *Payments) SetNeedEmail(needEmail bool) *Payments {
+	t.NeedEmail = &needEmail
+	return t
+}
+
+func (t *Payments) SetNeedName(needName bool) *Payments {
+	t.NeedName = &needName
+	return t
+}
+
+func (t *Payments) SetNeedPhoneNumber(needPhoneNumber bool) *Payments {
+	t.NeedPhoneNumber = &needPhoneNumber
+	return t
+}
+
+func (t *Payments) SetNeedShippingAddress(needShippingAddress bool) *Payments {
+	t.NeedShippingAddress = &needShippingAddress
+	return t
+}
+
+func (t *Payments) SetPhotoHeight(photoHeight int64) *Payments {
+	t.PhotoHeight = &photoHeight
+	return t
+}
+
+func (t *Payments) SetPhotoSize(photoSize int64) *Payments {
+	t.PhotoSize = &photoSize
+	return t
+}
+
+func (t *Payments) SetPhotoURL(photoURL string) *Payments {
+	t.PhotoURL = &photoURL
+	return t
+}
+
+func (t *Payments) SetPhotoWidth(photoWidth int64) *Payments {
+	t.PhotoWidth = &photoWidth
+	return t
+}
+
+func (t *Payments) SetProtectContent(protectContent bool) *Payments {
+	t.ProtectContent = &protectContent
+	return t
+}
+
+func (t *Payments) SetProviderData(providerData string) *Payments {
+	t.ProviderData = &providerData
+	return t
+}
+
+func (t *Payments) SetReplyMarkup(replyMarkup InlineKeyboardMarkup) *Payments {
+	t.ReplyMarkup = &replyMarkup
+	return t
+}
+
+func (t *Payments) SetReplyToMessageID(replyToMessageID int64) *Payments {
+	t.ReplyToMessageID = &replyToMessageID
+	return t
+}
+
+func (t *Payments) SetSendEmailToProvider(sendEmailToProvider bool) *Payments {
+	t.SendEmailToProvider = &sendEmailToProvider
+	return t
+}
+
+func (t *Payments) SetSendPhoneNumberToProvider(sendPhoneNumberToProvider bool) *Payments {
+	t.SendPhoneNumberToProvider = &sendPhoneNumberToProvider
+	return t
+}
+
+func (t *Payments) SetStartParameter(startParameter string) *Payments {
+	t.StartParameter = &startParameter
+	return t
+}
+
+func (t *Payments) SetSuggestedTipAmounts(suggestedTipAmounts []int64) *Payments {
+	t.SuggestedTipAmounts = suggestedTipAmounts
+	return t
+}
+
 func (t *Payments) GetAllowSendingWithoutReply() bool {
 	var res bool
 	if t == nil {
@@ -10205,6 +16437,72 @@ func (t *Payments) GetTitle() string {
 	return t.Title
 }
 
+// Clone returns a deep copy of t.
+func (t *Payments) Clone() *Payments {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.AllowSendingWithoutReply = cloneBoolPtr(t.AllowSendingWithoutReply)
+	res.DisableNotification = cloneBoolPtr(t.DisableNotification)
+	res.IsFlexible = cloneBoolPtr(t.IsFlexible)
+	res.MaxTipAmount = cloneInt64Ptr(t.MaxTipAmount)
+	res.MessageThreadID = cloneInt64Ptr(t.MessageThreadID)
+	res.NeedEmail = cloneBoolPtr(t.NeedEmail)
+	res.NeedName = cloneBoolPtr(t.NeedName)
+	res.NeedPhoneNumber = cloneBoolPtr(t.NeedPhoneNumber)
+	res.NeedShippingAddress = cloneBoolPtr(t.NeedShippingAddress)
+	res.PhotoHeight = cloneInt64Ptr(t.PhotoHeight)
+	res.PhotoSize = cloneInt64Ptr(t.PhotoSize)
+	res.PhotoURL = cloneStringPtr(t.PhotoURL)
+	res.PhotoWidth = cloneInt64Ptr(t.PhotoWidth)
+	res.Prices = cloneLabeledPriceSlice(t.Prices)
+	res.ProtectContent = cloneBoolPtr(t.ProtectContent)
+	res.ProviderData = cloneStringPtr(t.ProviderData)
+	res.ReplyMarkup = t.ReplyMarkup.Clone()
+	res.ReplyToMessageID = cloneInt64Ptr(t.ReplyToMessageID)
+	res.SendEmailToProvider = cloneBoolPtr(t.SendEmailToProvider)
+	res.SendPhoneNumberToProvider = cloneBoolPtr(t.SendPhoneNumberToProvider)
+	res.StartParameter = cloneStringPtr(t.StartParameter)
+	res.SuggestedTipAmounts = cloneInt64Slice(t.SuggestedTipAmounts)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *Payments) Equal(other *Payments) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalBoolPtr(t.AllowSendingWithoutReply, other.AllowSendingWithoutReply) &&
+		t.ChatID == other.ChatID &&
+		t.Currency == other.Currency &&
+		t.Description == other.Description &&
+		equalBoolPtr(t.DisableNotification, other.DisableNotification) &&
+		equalBoolPtr(t.IsFlexible, other.IsFlexible) &&
+		equalInt64Ptr(t.MaxTipAmount, other.MaxTipAmount) &&
+		equalInt64Ptr(t.MessageThreadID, other.MessageThreadID) &&
+		equalBoolPtr(t.NeedEmail, other.NeedEmail) &&
+		equalBoolPtr(t.NeedName, other.NeedName) &&
+		equalBoolPtr(t.NeedPhoneNumber, other.NeedPhoneNumber) &&
+		equalBoolPtr(t.NeedShippingAddress, other.NeedShippingAddress) &&
+		t.Payload == other.Payload &&
+		equalInt64Ptr(t.PhotoHeight, other.PhotoHeight) &&
+		equalInt64Ptr(t.PhotoSize, other.PhotoSize) &&
+		equalStringPtr(t.PhotoURL, other.PhotoURL) &&
+		equalInt64Ptr(t.PhotoWidth, other.PhotoWidth) &&
+		equalLabeledPriceSlice(t.Prices, other.Prices) &&
+		equalBoolPtr(t.ProtectContent, other.ProtectContent) &&
+		equalStringPtr(t.ProviderData, other.ProviderData) &&
+		t.ProviderToken == other.ProviderToken &&
+		t.ReplyMarkup.Equal(other.ReplyMarkup) &&
+		equalInt64Ptr(t.ReplyToMessageID, other.ReplyToMessageID) &&
+		equalBoolPtr(t.SendEmailToProvider, other.SendEmailToProvider) &&
+		equalBoolPtr(t.SendPhoneNumberToProvider, other.SendPhoneNumberToProvider) &&
+		equalStringPtr(t.StartParameter, other.StartParameter) &&
+		equalInt64Slice(t.SuggestedTipAmounts, other.SuggestedTipAmounts) &&
+		t.Title == other.Title
+}
+
 // PhotoSize
 // This object represents one size of a photo or a file / sticker thumbnail.
 type PhotoSize struct {
@@ -10226,6 +16524,21 @@ type PhotoSize struct {
 	FileSize *int64 `json:"file_size,omitempty"`
 }
 
+// NewPhotoSize creates PhotoSize with the required fields.
+func NewPhotoSize(fileID string, fileUniqueID string, height int64, width int64) *PhotoSize {
+	return &PhotoSize{
+		FileID:       fileID,
+		FileUniqueID: fileUniqueID,
+		Height:       height,
+		Width:        width,
+	}
+}
+
+func (t *PhotoSize) SetFileSize(fileSize int64) *PhotoSize {
+	t.FileSize = &fileSize
+	return t
+}
+
 func (t *PhotoSize) GetFileID() string {
 	var res string
 	if t == nil {
@@ -10269,6 +16582,28 @@ func (t *PhotoSize) GetWidth() int64 {
 	return t.Width
 }
 
+// Clone returns a deep copy of t.
+func (t *PhotoSize) Clone() *PhotoSize {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.FileSize = cloneInt64Ptr(t.FileSize)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *PhotoSize) Equal(other *PhotoSize) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.FileID == other.FileID &&
+		equalInt64Ptr(t.FileSize, other.FileSize) &&
+		t.FileUniqueID == other.FileUniqueID &&
+		t.Height == other.Height &&
+		t.Width == other.Width
+}
+
 // Poll
 // This object contains information about a poll.
 type Poll struct {
@@ -10316,6 +16651,45 @@ type Poll struct {
 	OpenPeriod *int64 `json:"open_period,omitempty"`
 }
 
+// NewPoll creates Poll with the required fields.
+func NewPoll(allowsMultipleAnswers bool, id string, isAnonymous bool, isClosed bool, options []PollOption, question string, totalVoterCount int64, typ PollType) *Poll {
+	return &Poll{
+		AllowsMultipleAnswers: allowsMultipleAnswers,
+		ID:                    id,
+		IsAnonymous:           isAnonymous,
+		IsClosed:              isClosed,
+		Options:               options,
+		Question:              question,
+		TotalVoterCount:       totalVoterCount,
+		Type:                  typ,
+	}
+}
+
+func (t *Poll) SetCloseDate(closeDate int64) *Poll {
+	t.CloseDate = &closeDate
+	return t
+}
+
+func (t *Poll) SetCorrectOptionID(correctOptionID int64) *Poll {
+	t.CorrectOptionID = &correctOptionID
+	return t
+}
+
+func (t *Poll) SetExplanation(explanation string) *Poll {
+	t.Explanation = &explanation
+	return t
+}
+
+func (t *Poll) SetExplanationEntities(explanationEntities []MessageEntity) *Poll {
+	t.ExplanationEntities = explanationEntities
+	return t
+}
+
+func (t *Poll) SetOpenPeriod(openPeriod int64) *Poll {
+	t.OpenPeriod = &openPeriod
+	return t
+}
+
 func (t *Poll) GetAllowsMultipleAnswers() bool {
 	var res bool
 	if t == nil {
@@ -10415,6 +16789,41 @@ func (t *Poll) GetType() *PollType {
 	return &t.Type
 }
 
+// Clone returns a deep copy of t.
+func (t *Poll) Clone() *Poll {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.CloseDate = cloneInt64Ptr(t.CloseDate)
+	res.CorrectOptionID = cloneInt64Ptr(t.CorrectOptionID)
+	res.Explanation = cloneStringPtr(t.Explanation)
+	res.ExplanationEntities = cloneMessageEntitySlice(t.ExplanationEntities)
+	res.OpenPeriod = cloneInt64Ptr(t.OpenPeriod)
+	res.Options = clonePollOptionSlice(t.Options)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *Poll) Equal(other *Poll) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.AllowsMultipleAnswers == other.AllowsMultipleAnswers &&
+		equalInt64Ptr(t.CloseDate, other.CloseDate) &&
+		equalInt64Ptr(t.CorrectOptionID, other.CorrectOptionID) &&
+		equalStringPtr(t.Explanation, other.Explanation) &&
+		equalMessageEntitySlice(t.ExplanationEntities, other.ExplanationEntities) &&
+		t.ID == other.ID &&
+		t.IsAnonymous == other.IsAnonymous &&
+		t.IsClosed == other.IsClosed &&
+		equalInt64Ptr(t.OpenPeriod, other.OpenPeriod) &&
+		equalPollOptionSlice(t.Options, other.Options) &&
+		t.Question == other.Question &&
+		t.TotalVoterCount == other.TotalVoterCount &&
+		t.Type == other.Type
+}
+
 // PollAnswer
 // This object represents an answer of a user in a non-anonymous poll.
 type PollAnswer struct {
@@ -10430,6 +16839,15 @@ type PollAnswer struct {
 	User User `json:"user"`
 }
 
+// NewPollAnswer creates PollAnswer with the required fields.
+func NewPollAnswer(optionIDs []int64, pollID string, user User) *PollAnswer {
+	return &PollAnswer{
+		OptionIDs: optionIDs,
+		PollID:    pollID,
+		User:      user,
+	}
+}
+
 func (t *PollAnswer) GetPollID() string {
 	var res string
 	if t == nil {
@@ -10445,6 +16863,27 @@ func (t *PollAnswer) GetUser() *User {
 	return &t.User
 }
 
+// Clone returns a deep copy of t.
+func (t *PollAnswer) Clone() *PollAnswer {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.OptionIDs = cloneInt64Slice(t.OptionIDs)
+	res.User = *t.User.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *PollAnswer) Equal(other *PollAnswer) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalInt64Slice(t.OptionIDs, other.OptionIDs) &&
+		t.PollID == other.PollID &&
+		t.User.Equal(&other.User)
+}
+
 // PollOption
 // This object contains information about one answer option in a poll.
 type PollOption struct {
@@ -10456,6 +16895,14 @@ type PollOption struct {
 	VoterCount int64 `json:"voter_count"`
 }
 
+// NewPollOption creates PollOption with the required fields.
+func NewPollOption(text string, voterCount int64) *PollOption {
+	return &PollOption{
+		Text:       text,
+		VoterCount: voterCount,
+	}
+}
+
 func (t *PollOption) GetText() string {
 	var res string
 	if t == nil {
@@ -10472,6 +16919,24 @@ func (t *PollOption) GetVoterCount() int64 {
 	return t.VoterCount
 }
 
+// Clone returns a deep copy of t.
+func (t *PollOption) Clone() *PollOption {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *PollOption) Equal(other *PollOption) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Text == other.Text &&
+		t.VoterCount == other.VoterCount
+}
+
 // PreCheckoutQuery
 // This object contains information about an incoming pre-checkout query.
 type PreCheckoutQuery struct {
@@ -10501,6 +16966,27 @@ type PreCheckoutQuery struct {
 	ShippingOptionID *string `json:"shipping_option_id,omitempty"`
 }
 
+// NewPreCheckoutQuery creates PreCheckoutQuery with the required fields.
+func NewPreCheckoutQuery(currency string, from User, id string, invoicePayload string, totalAmount int64) *PreCheckoutQuery {
+	return &PreCheckoutQuery{
+		Currency:       currency,
+		From:           from,
+		ID:             id,
+		InvoicePayload: invoicePayload,
+		TotalAmount:    totalAmount,
+	}
+}
+
+func (t *PreCheckoutQuery) SetOrderInfo(orderInfo OrderInfo) *PreCheckoutQuery {
+	t.OrderInfo = &orderInfo
+	return t
+}
+
+func (t *PreCheckoutQuery) SetShippingOptionID(shippingOptionID string) *PreCheckoutQuery {
+	t.ShippingOptionID = &shippingOptionID
+	return t
+}
+
 func (t *PreCheckoutQuery) GetCurrency() string {
 	var res string
 	if t == nil {
@@ -10558,6 +17044,32 @@ func (t *PreCheckoutQuery) GetTotalAmount() int64 {
 	return t.TotalAmount
 }
 
+// Clone returns a deep copy of t.
+func (t *PreCheckoutQuery) Clone() *PreCheckoutQuery {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.From = *t.From.Clone()
+	res.OrderInfo = t.OrderInfo.Clone()
+	res.ShippingOptionID = cloneStringPtr(t.ShippingOptionID)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *PreCheckoutQuery) Equal(other *PreCheckoutQuery) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Currency == other.Currency &&
+		t.From.Equal(&other.From) &&
+		t.ID == other.ID &&
+		t.InvoicePayload == other.InvoicePayload &&
+		t.OrderInfo.Equal(other.OrderInfo) &&
+		equalStringPtr(t.ShippingOptionID, other.ShippingOptionID) &&
+		t.TotalAmount == other.TotalAmount
+}
+
 // ProximityAlertTriggered
 // This object represents the content of a service message, sent whenever a user in the chat
 // triggers a proximity alert set by another user.
@@ -10573,6 +17085,15 @@ type ProximityAlertTriggered struct {
 	Watcher User `json:"watcher"`
 }
 
+// NewProximityAlertTriggered creates ProximityAlertTriggered with the required fields.
+func NewProximityAlertTriggered(distance int64, traveler User, watcher User) *ProximityAlertTriggered {
+	return &ProximityAlertTriggered{
+		Distance: distance,
+		Traveler: traveler,
+		Watcher:  watcher,
+	}
+}
+
 func (t *ProximityAlertTriggered) GetDistance() int64 {
 	var res int64
 	if t == nil {
@@ -10595,6 +17116,27 @@ func (t *ProximityAlertTriggered) GetWatcher() *User {
 	return &t.Watcher
 }
 
+// Clone returns a deep copy of t.
+func (t *ProximityAlertTriggered) Clone() *ProximityAlertTriggered {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Traveler = *t.Traveler.Clone()
+	res.Watcher = *t.Watcher.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *ProximityAlertTriggered) Equal(other *ProximityAlertTriggered) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Distance == other.Distance &&
+		t.Traveler.Equal(&other.Traveler) &&
+		t.Watcher.Equal(&other.Watcher)
+}
+
 // ReplyKeyboardMarkup
 // This object represents a custom keyboard with reply options (see Introduction to bots for
 // details and examples).
@@ -10629,6 +17171,38 @@ type ReplyKeyboardMarkup struct {
 	Selective *bool `json:"selective,omitempty"`
 }
 
+// NewReplyKeyboardMarkup creates ReplyKeyboardMarkup with the required fields.
+func NewReplyKeyboardMarkup(keyboard [][]KeyboardButton) *ReplyKeyboardMarkup {
+	return &ReplyKeyboardMarkup{
+		Keyboard: keyboard,
+	}
+}
+
+func (t *ReplyKeyboardMarkup) SetInputFieldPlaceholder(inputFieldPlaceholder string) *ReplyKeyboardMarkup {
+	t.InputFieldPlaceholder = &inputFieldPlaceholder
+	return t
+}
+
+func (t *ReplyKeyboardMarkup) SetIsPersistent(isPersistent bool) *ReplyKeyboardMarkup {
+	t.IsPersistent = &isPersistent
+	return t
+}
+
+func (t *ReplyKeyboardMarkup) SetOneTimeKeyboard(oneTimeKeyboard bool) *ReplyKeyboardMarkup {
+	t.OneTimeKeyboard = &oneTimeKeyboard
+	return t
+}
+
+func (t *ReplyKeyboardMarkup) SetResizeKeyboard(resizeKeyboard bool) *ReplyKeyboardMarkup {
+	t.ResizeKeyboard = &resizeKeyboard
+	return t
+}
+
+func (t *ReplyKeyboardMarkup) SetSelective(selective bool) *ReplyKeyboardMarkup {
+	t.Selective = &selective
+	return t
+}
+
 func (t *ReplyKeyboardMarkup) GetInputFieldPlaceholder() string {
 	var res string
 	if t == nil {
@@ -10684,6 +17258,34 @@ func (t *ReplyKeyboardMarkup) GetSelective() bool {
 	return res
 }
 
+// Clone returns a deep copy of t.
+func (t *ReplyKeyboardMarkup) Clone() *ReplyKeyboardMarkup {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.InputFieldPlaceholder = cloneStringPtr(t.InputFieldPlaceholder)
+	res.IsPersistent = cloneBoolPtr(t.IsPersistent)
+	res.Keyboard = cloneKeyboardButtonSliceSlice(t.Keyboard)
+	res.OneTimeKeyboard = cloneBoolPtr(t.OneTimeKeyboard)
+	res.ResizeKeyboard = cloneBoolPtr(t.ResizeKeyboard)
+	res.Selective = cloneBoolPtr(t.Selective)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *ReplyKeyboardMarkup) Equal(other *ReplyKeyboardMarkup) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.InputFieldPlaceholder, other.InputFieldPlaceholder) &&
+		equalBoolPtr(t.IsPersistent, other.IsPersistent) &&
+		equalKeyboardButtonSliceSlice(t.Keyboard, other.Keyboard) &&
+		equalBoolPtr(t.OneTimeKeyboard, other.OneTimeKeyboard) &&
+		equalBoolPtr(t.ResizeKeyboard, other.ResizeKeyboard) &&
+		equalBoolPtr(t.Selective, other.Selective)
+}
+
 // ReplyKeyboardRemove
 // Upon receiving a message with this object, Telegram clients will remove the current custom
 // keyboard and display the default letter-keyboard. By default, custom keyboards are displayed
@@ -10705,6 +17307,18 @@ type ReplyKeyboardRemove struct {
 	Selective *bool `json:"selective,omitempty"`
 }
 
+// NewReplyKeyboardRemove creates ReplyKeyboardRemove with the required fields.
+func NewReplyKeyboardRemove(removeKeyboard True) *ReplyKeyboardRemove {
+	return &ReplyKeyboardRemove{
+		RemoveKeyboard: removeKeyboard,
+	}
+}
+
+func (t *ReplyKeyboardRemove) SetSelective(selective bool) *ReplyKeyboardRemove {
+	t.Selective = &selective
+	return t
+}
+
 func (t *ReplyKeyboardRemove) GetRemoveKeyboard() *True {
 	if t == nil {
 		return nil
@@ -10723,6 +17337,25 @@ func (t *ReplyKeyboardRemove) GetSelective() bool {
 	return res
 }
 
+// Clone returns a deep copy of t.
+func (t *ReplyKeyboardRemove) Clone() *ReplyKeyboardRemove {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Selective = cloneBoolPtr(t.Selective)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *ReplyKeyboardRemove) Equal(other *ReplyKeyboardRemove) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.RemoveKeyboard == other.RemoveKeyboard &&
+		equalBoolPtr(t.Selective, other.Selective)
+}
+
 // ResponseParameters
 // Describes why a request was unsuccessful.
 type ResponseParameters struct {
@@ -10760,11 +17393,31 @@ func (t *ResponseParameters) GetRetryAfter() int64 {
 	return res
 }
 
-// SentWebAppMessage
-// Describes an inline message sent by a Web App on behalf of a user.
-type SentWebAppMessage struct {
-	// InlineMessageID
-	// Identifier of the sent inline message. Available only if there is an inline keyboard
+// Clone returns a deep copy of t.
+func (t *ResponseParameters) Clone() *ResponseParameters {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.MigrateToChatID = cloneInt64Ptr(t.MigrateToChatID)
+	res.RetryAfter = cloneInt64Ptr(t.RetryAfter)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *ResponseParameters) Equal(other *ResponseParameters) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalInt64Ptr(t.MigrateToChatID, other.MigrateToChatID) &&
+		equalInt64Ptr(t.RetryAfter, other.RetryAfter)
+}
+
+// SentWebAppMessage
+// Describes an inline message sent by a Web App on behalf of a user.
+type SentWebAppMessage struct {
+	// InlineMessageID
+	// Identifier of the sent inline message. Available only if there is an inline keyboard
 	// attached to the message.
 	InlineMessageID *string `json:"inline_message_id,omitempty"`
 }
@@ -10780,6 +17433,24 @@ func (t *SentWebAppMessage) GetInlineMessageID() string {
 	return res
 }
 
+// Clone returns a deep copy of t.
+func (t *SentWebAppMessage) Clone() *SentWebAppMessage {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.InlineMessageID = cloneStringPtr(t.InlineMessageID)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *SentWebAppMessage) Equal(other *SentWebAppMessage) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.InlineMessageID, other.InlineMessageID)
+}
+
 // ShippingAddress
 // This object represents a shipping address.
 type ShippingAddress struct {
@@ -10803,6 +17474,18 @@ type ShippingAddress struct {
 	StreetLine2 string `json:"street_line2"`
 }
 
+// NewShippingAddress creates ShippingAddress with the required fields.
+func NewShippingAddress(city string, countryCode string, postCode string, state string, streetLine1 string, streetLine2 string) *ShippingAddress {
+	return &ShippingAddress{
+		City:        city,
+		CountryCode: countryCode,
+		PostCode:    postCode,
+		State:       state,
+		StreetLine1: streetLine1,
+		StreetLine2: streetLine2,
+	}
+}
+
 func (t *ShippingAddress) GetCity() string {
 	var res string
 	if t == nil {
@@ -10851,6 +17534,28 @@ func (t *ShippingAddress) GetStreetLine2() string {
 	return t.StreetLine2
 }
 
+// Clone returns a deep copy of t.
+func (t *ShippingAddress) Clone() *ShippingAddress {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *ShippingAddress) Equal(other *ShippingAddress) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.City == other.City &&
+		t.CountryCode == other.CountryCode &&
+		t.PostCode == other.PostCode &&
+		t.State == other.State &&
+		t.StreetLine1 == other.StreetLine1 &&
+		t.StreetLine2 == other.StreetLine2
+}
+
 // ShippingOption
 // This object represents one shipping option.
 type ShippingOption struct {
@@ -10865,6 +17570,15 @@ type ShippingOption struct {
 	Title string `json:"title"`
 }
 
+// NewShippingOption creates ShippingOption with the required fields.
+func NewShippingOption(id string, prices []LabeledPrice, title string) *ShippingOption {
+	return &ShippingOption{
+		ID:     id,
+		Prices: prices,
+		Title:  title,
+	}
+}
+
 func (t *ShippingOption) GetID() string {
 	var res string
 	if t == nil {
@@ -10881,6 +17595,26 @@ func (t *ShippingOption) GetTitle() string {
 	return t.Title
 }
 
+// Clone returns a deep copy of t.
+func (t *ShippingOption) Clone() *ShippingOption {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Prices = cloneLabeledPriceSlice(t.Prices)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *ShippingOption) Equal(other *ShippingOption) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.ID == other.ID &&
+		equalLabeledPriceSlice(t.Prices, other.Prices) &&
+		t.Title == other.Title
+}
+
 // ShippingQuery
 // This object contains information about an incoming shipping query.
 type ShippingQuery struct {
@@ -10898,6 +17632,16 @@ type ShippingQuery struct {
 	ShippingAddress ShippingAddress `json:"shipping_address"`
 }
 
+// NewShippingQuery creates ShippingQuery with the required fields.
+func NewShippingQuery(from User, id string, invoicePayload string, shippingAddress ShippingAddress) *ShippingQuery {
+	return &ShippingQuery{
+		From:            from,
+		ID:              id,
+		InvoicePayload:  invoicePayload,
+		ShippingAddress: shippingAddress,
+	}
+}
+
 func (t *ShippingQuery) GetFrom() *User {
 	if t == nil {
 		return nil
@@ -10928,6 +17672,28 @@ func (t *ShippingQuery) GetShippingAddress() *ShippingAddress {
 	return &t.ShippingAddress
 }
 
+// Clone returns a deep copy of t.
+func (t *ShippingQuery) Clone() *ShippingQuery {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.From = *t.From.Clone()
+	res.ShippingAddress = *t.ShippingAddress.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *ShippingQuery) Equal(other *ShippingQuery) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.From.Equal(&other.From) &&
+		t.ID == other.ID &&
+		t.InvoicePayload == other.InvoicePayload &&
+		t.ShippingAddress.Equal(&other.ShippingAddress)
+}
+
 // Sticker
 // This object represents a sticker.
 type Sticker struct {
@@ -10983,6 +17749,59 @@ type Sticker struct {
 	Thumbnail *PhotoSize `json:"thumbnail,omitempty"`
 }
 
+// NewSticker creates Sticker with the required fields.
+func NewSticker(fileID string, fileUniqueID string, height int64, isAnimated bool, isVideo bool, typ StickerType, width int64) *Sticker {
+	return &Sticker{
+		FileID:       fileID,
+		FileUniqueID: fileUniqueID,
+		Height:       height,
+		IsAnimated:   isAnimated,
+		IsVideo:      isVideo,
+		Type:         typ,
+		Width:        width,
+	}
+}
+
+func (t *Sticker) SetCustomEmojiID(customEmojiID string) *Sticker {
+	t.CustomEmojiID = &customEmojiID
+	return t
+}
+
+func (t *Sticker) SetEmoji(emoji string) *Sticker {
+	t.Emoji = &emoji
+	return t
+}
+
+func (t *Sticker) SetFileSize(fileSize int64) *Sticker {
+	t.FileSize = &fileSize
+	return t
+}
+
+func (t *Sticker) SetMaskPosition(maskPosition MaskPosition) *Sticker {
+	t.MaskPosition = &maskPosition
+	return t
+}
+
+func (t *Sticker) SetNeedsRepainting(needsRepainting True) *Sticker {
+	t.NeedsRepainting = &needsRepainting
+	return t
+}
+
+func (t *Sticker) SetPremiumAnimation(premiumAnimation File) *Sticker {
+	t.PremiumAnimation = &premiumAnimation
+	return t
+}
+
+func (t *Sticker) SetSetName(setName string) *Sticker {
+	t.SetName = &setName
+	return t
+}
+
+func (t *Sticker) SetThumbnail(thumbnail PhotoSize) *Sticker {
+	t.Thumbnail = &thumbnail
+	return t
+}
+
 func (t *Sticker) GetCustomEmojiID() string {
 	var res string
 	if t == nil {
@@ -11110,6 +17929,45 @@ func (t *Sticker) GetWidth() int64 {
 	return t.Width
 }
 
+// Clone returns a deep copy of t.
+func (t *Sticker) Clone() *Sticker {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.CustomEmojiID = cloneStringPtr(t.CustomEmojiID)
+	res.Emoji = cloneStringPtr(t.Emoji)
+	res.FileSize = cloneInt64Ptr(t.FileSize)
+	res.MaskPosition = t.MaskPosition.Clone()
+	res.NeedsRepainting = cloneTruePtr(t.NeedsRepainting)
+	res.PremiumAnimation = t.PremiumAnimation.Clone()
+	res.SetName = cloneStringPtr(t.SetName)
+	res.Thumbnail = t.Thumbnail.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *Sticker) Equal(other *Sticker) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.CustomEmojiID, other.CustomEmojiID) &&
+		equalStringPtr(t.Emoji, other.Emoji) &&
+		t.FileID == other.FileID &&
+		equalInt64Ptr(t.FileSize, other.FileSize) &&
+		t.FileUniqueID == other.FileUniqueID &&
+		t.Height == other.Height &&
+		t.IsAnimated == other.IsAnimated &&
+		t.IsVideo == other.IsVideo &&
+		t.MaskPosition.Equal(other.MaskPosition) &&
+		equalTruePtr(t.NeedsRepainting, other.NeedsRepainting) &&
+		t.PremiumAnimation.Equal(other.PremiumAnimation) &&
+		equalStringPtr(t.SetName, other.SetName) &&
+		t.Thumbnail.Equal(other.Thumbnail) &&
+		t.Type == other.Type &&
+		t.Width == other.Width
+}
+
 // StickerSet
 // This object represents a sticker set.
 type StickerSet struct {
@@ -11124,7 +17982,7 @@ type StickerSet struct {
 	Name string `json:"name"`
 	// StickerType
 	// Type of stickers in the set, currently one of "regular", "mask", "custom_emoji"
-	StickerType string `json:"sticker_type"`
+	StickerType StickerType `json:"sticker_type"`
 	// Stickers
 	// List of all set stickers
 	Stickers []Sticker `json:"stickers"`
@@ -11136,6 +17994,23 @@ type StickerSet struct {
 	Thumbnail *PhotoSize `json:"thumbnail,omitempty"`
 }
 
+// NewStickerSet creates StickerSet with the required fields.
+func NewStickerSet(isAnimated bool, isVideo bool, name string, stickerType StickerType, stickers []Sticker, title string) *StickerSet {
+	return &StickerSet{
+		IsAnimated:  isAnimated,
+		IsVideo:     isVideo,
+		Name:        name,
+		StickerType: stickerType,
+		Stickers:    stickers,
+		Title:       title,
+	}
+}
+
+func (t *StickerSet) SetThumbnail(thumbnail PhotoSize) *StickerSet {
+	t.Thumbnail = &thumbnail
+	return t
+}
+
 func (t *StickerSet) GetIsAnimated() bool {
 	var res bool
 	if t == nil {
@@ -11160,12 +18035,11 @@ func (t *StickerSet) GetName() string {
 	return t.Name
 }
 
-func (t *StickerSet) GetStickerType() string {
-	var res string
+func (t *StickerSet) GetStickerType() *StickerType {
 	if t == nil {
-		return res
+		return nil
 	}
-	return t.StickerType
+	return &t.StickerType
 }
 
 func (t *StickerSet) GetThumbnail() *PhotoSize {
@@ -11183,6 +18057,31 @@ func (t *StickerSet) GetTitle() string {
 	return t.Title
 }
 
+// Clone returns a deep copy of t.
+func (t *StickerSet) Clone() *StickerSet {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Stickers = cloneStickerSlice(t.Stickers)
+	res.Thumbnail = t.Thumbnail.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *StickerSet) Equal(other *StickerSet) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.IsAnimated == other.IsAnimated &&
+		t.IsVideo == other.IsVideo &&
+		t.Name == other.Name &&
+		t.StickerType == other.StickerType &&
+		equalStickerSlice(t.Stickers, other.Stickers) &&
+		t.Thumbnail.Equal(other.Thumbnail) &&
+		t.Title == other.Title
+}
+
 // Stickers
 // The following methods and objects allow your bot to handle stickers and sticker sets.
 type Stickers struct {
@@ -11238,6 +18137,59 @@ type Stickers struct {
 	Thumbnail *PhotoSize `json:"thumbnail,omitempty"`
 }
 
+// NewStickers creates Stickers with the required fields.
+func NewStickers(fileID string, fileUniqueID string, height int64, isAnimated bool, isVideo bool, typ StickersType, width int64) *Stickers {
+	return &Stickers{
+		FileID:       fileID,
+		FileUniqueID: fileUniqueID,
+		Height:       height,
+		IsAnimated:   isAnimated,
+		IsVideo:      isVideo,
+		Type:         typ,
+		Width:        width,
+	}
+}
+
+func (t *Stickers) SetCustomEmojiID(customEmojiID string) *Stickers {
+	t.CustomEmojiID = &customEmojiID
+	return t
+}
+
+func (t *Stickers) SetEmoji(emoji string) *Stickers {
+	t.Emoji = &emoji
+	return t
+}
+
+func (t *Stickers) SetFileSize(fileSize int64) *Stickers {
+	t.FileSize = &fileSize
+	return t
+}
+
+func (t *Stickers) SetMaskPosition(maskPosition MaskPosition) *Stickers {
+	t.MaskPosition = &maskPosition
+	return t
+}
+
+func (t *Stickers) SetNeedsRepainting(needsRepainting True) *Stickers {
+	t.NeedsRepainting = &needsRepainting
+	return t
+}
+
+func (t *Stickers) SetPremiumAnimation(premiumAnimation File) *Stickers {
+	t.PremiumAnimation = &premiumAnimation
+	return t
+}
+
+func (t *Stickers) SetSetName(setName string) *Stickers {
+	t.SetName = &setName
+	return t
+}
+
+func (t *Stickers) SetThumbnail(thumbnail PhotoSize) *Stickers {
+	t.Thumbnail = &thumbnail
+	return t
+}
+
 func (t *Stickers) GetCustomEmojiID() string {
 	var res string
 	if t == nil {
@@ -11365,6 +18317,45 @@ func (t *Stickers) GetWidth() int64 {
 	return t.Width
 }
 
+// Clone returns a deep copy of t.
+func (t *Stickers) Clone() *Stickers {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.CustomEmojiID = cloneStringPtr(t.CustomEmojiID)
+	res.Emoji = cloneStringPtr(t.Emoji)
+	res.FileSize = cloneInt64Ptr(t.FileSize)
+	res.MaskPosition = t.MaskPosition.Clone()
+	res.NeedsRepainting = cloneTruePtr(t.NeedsRepainting)
+	res.PremiumAnimation = t.PremiumAnimation.Clone()
+	res.SetName = cloneStringPtr(t.SetName)
+	res.Thumbnail = t.Thumbnail.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *Stickers) Equal(other *Stickers) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.CustomEmojiID, other.CustomEmojiID) &&
+		equalStringPtr(t.Emoji, other.Emoji) &&
+		t.FileID == other.FileID &&
+		equalInt64Ptr(t.FileSize, other.FileSize) &&
+		t.FileUniqueID == other.FileUniqueID &&
+		t.Height == other.Height &&
+		t.IsAnimated == other.IsAnimated &&
+		t.IsVideo == other.IsVideo &&
+		t.MaskPosition.Equal(other.MaskPosition) &&
+		equalTruePtr(t.NeedsRepainting, other.NeedsRepainting) &&
+		t.PremiumAnimation.Equal(other.PremiumAnimation) &&
+		equalStringPtr(t.SetName, other.SetName) &&
+		t.Thumbnail.Equal(other.Thumbnail) &&
+		t.Type == other.Type &&
+		t.Width == other.Width
+}
+
 // SuccessfulPayment
 // This object contains basic information about a successful payment.
 type SuccessfulPayment struct {
@@ -11394,6 +18385,27 @@ type SuccessfulPayment struct {
 	ShippingOptionID *string `json:"shipping_option_id,omitempty"`
 }
 
+// NewSuccessfulPayment creates SuccessfulPayment with the required fields.
+func NewSuccessfulPayment(currency string, invoicePayload string, providerPaymentChargeID string, telegramPaymentChargeID string, totalAmount int64) *SuccessfulPayment {
+	return &SuccessfulPayment{
+		Currency:                currency,
+		InvoicePayload:          invoicePayload,
+		ProviderPaymentChargeID: providerPaymentChargeID,
+		TelegramPaymentChargeID: telegramPaymentChargeID,
+		TotalAmount:             totalAmount,
+	}
+}
+
+func (t *SuccessfulPayment) SetOrderInfo(orderInfo OrderInfo) *SuccessfulPayment {
+	t.OrderInfo = &orderInfo
+	return t
+}
+
+func (t *SuccessfulPayment) SetShippingOptionID(shippingOptionID string) *SuccessfulPayment {
+	t.ShippingOptionID = &shippingOptionID
+	return t
+}
+
 func (t *SuccessfulPayment) GetCurrency() string {
 	var res string
 	if t == nil {
@@ -11452,6 +18464,31 @@ func (t *SuccessfulPayment) GetTotalAmount() int64 {
 	return t.TotalAmount
 }
 
+// Clone returns a deep copy of t.
+func (t *SuccessfulPayment) Clone() *SuccessfulPayment {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.OrderInfo = t.OrderInfo.Clone()
+	res.ShippingOptionID = cloneStringPtr(t.ShippingOptionID)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *SuccessfulPayment) Equal(other *SuccessfulPayment) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Currency == other.Currency &&
+		t.InvoicePayload == other.InvoicePayload &&
+		t.OrderInfo.Equal(other.OrderInfo) &&
+		t.ProviderPaymentChargeID == other.ProviderPaymentChargeID &&
+		equalStringPtr(t.ShippingOptionID, other.ShippingOptionID) &&
+		t.TelegramPaymentChargeID == other.TelegramPaymentChargeID &&
+		t.TotalAmount == other.TotalAmount
+}
+
 // SwitchInlineQueryChosenChat
 // This object represents an inline button that switches the current user to inline mode in a
 // chosen chat, with an optional default inline query.
@@ -11529,6 +18566,32 @@ func (t *SwitchInlineQueryChosenChat) GetQuery() string {
 	return res
 }
 
+// Clone returns a deep copy of t.
+func (t *SwitchInlineQueryChosenChat) Clone() *SwitchInlineQueryChosenChat {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.AllowBotChats = cloneBoolPtr(t.AllowBotChats)
+	res.AllowChannelChats = cloneBoolPtr(t.AllowChannelChats)
+	res.AllowGroupChats = cloneBoolPtr(t.AllowGroupChats)
+	res.AllowUserChats = cloneBoolPtr(t.AllowUserChats)
+	res.Query = cloneStringPtr(t.Query)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *SwitchInlineQueryChosenChat) Equal(other *SwitchInlineQueryChosenChat) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalBoolPtr(t.AllowBotChats, other.AllowBotChats) &&
+		equalBoolPtr(t.AllowChannelChats, other.AllowChannelChats) &&
+		equalBoolPtr(t.AllowGroupChats, other.AllowGroupChats) &&
+		equalBoolPtr(t.AllowUserChats, other.AllowUserChats) &&
+		equalStringPtr(t.Query, other.Query)
+}
+
 // Update
 // This object represents an incoming update.At most one of the optional parameters can be present
 // in any given update.
@@ -11592,6 +18655,83 @@ type Update struct {
 	ShippingQuery *ShippingQuery `json:"shipping_query,omitempty"`
 }
 
+// NewUpdate creates Update with the required fields.
+func NewUpdate(updateID int64) *Update {
+	return &Update{
+		UpdateID: updateID,
+	}
+}
+
+func (t *Update) SetCallbackQuery(callbackQuery CallbackQuery) *Update {
+	t.CallbackQuery = &callbackQuery
+	return t
+}
+
+func (t *Update) SetChannelPost(channelPost Message) *Update {
+	t.ChannelPost = &channelPost
+	return t
+}
+
+func (t *Update) SetChatJoinRequest(chatJoinRequest ChatJoinRequest) *Update {
+	t.ChatJoinRequest = &chatJoinRequest
+	return t
+}
+
+func (t *Update) SetChatMember(chatMember ChatMemberUpdated) *Update {
+	t.ChatMember = &chatMember
+	return t
+}
+
+func (t *Update) SetChosenInlineResult(chosenInlineResult ChosenInlineResult) *Update {
+	t.ChosenInlineResult = &chosenInlineResult
+	return t
+}
+
+func (t *Update) SetEditedChannelPost(editedChannelPost Message) *Update {
+	t.EditedChannelPost = &editedChannelPost
+	return t
+}
+
+func (t *Update) SetEditedMessage(editedMessage Message) *Update {
+	t.EditedMessage = &editedMessage
+	return t
+}
+
+func (t *Update) SetInlineQuery(inlineQuery InlineQuery) *Update {
+	t.InlineQuery = &inlineQuery
+	return t
+}
+
+func (t *Update) SetMessage(message Message) *Update {
+	t.Message = &message
+	return t
+}
+
+func (t *Update) SetMyChatMember(myChatMember ChatMemberUpdated) *Update {
+	t.MyChatMember = &myChatMember
+	return t
+}
+
+func (t *Update) SetPoll(poll Poll) *Update {
+	t.Poll = &poll
+	return t
+}
+
+func (t *Update) SetPollAnswer(pollAnswer PollAnswer) *Update {
+	t.PollAnswer = &pollAnswer
+	return t
+}
+
+func (t *Update) SetPreCheckoutQuery(preCheckoutQuery PreCheckoutQuery) *Update {
+	t.PreCheckoutQuery = &preCheckoutQuery
+	return t
+}
+
+func (t *Update) SetShippingQuery(shippingQuery ShippingQuery) *Update {
+	t.ShippingQuery = &shippingQuery
+	return t
+}
+
 func (t *Update) GetCallbackQuery() *CallbackQuery {
 	if t == nil {
 		return nil
@@ -11698,6 +18838,51 @@ func (t *Update) GetUpdateID() int64 {
 	return t.UpdateID
 }
 
+// Clone returns a deep copy of t.
+func (t *Update) Clone() *Update {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.CallbackQuery = t.CallbackQuery.Clone()
+	res.ChannelPost = t.ChannelPost.Clone()
+	res.ChatJoinRequest = t.ChatJoinRequest.Clone()
+	res.ChatMember = t.ChatMember.Clone()
+	res.ChosenInlineResult = t.ChosenInlineResult.Clone()
+	res.EditedChannelPost = t.EditedChannelPost.Clone()
+	res.EditedMessage = t.EditedMessage.Clone()
+	res.InlineQuery = t.InlineQuery.Clone()
+	res.Message = t.Message.Clone()
+	res.MyChatMember = t.MyChatMember.Clone()
+	res.Poll = t.Poll.Clone()
+	res.PollAnswer = t.PollAnswer.Clone()
+	res.PreCheckoutQuery = t.PreCheckoutQuery.Clone()
+	res.ShippingQuery = t.ShippingQuery.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *Update) Equal(other *Update) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.CallbackQuery.Equal(other.CallbackQuery) &&
+		t.ChannelPost.Equal(other.ChannelPost) &&
+		t.ChatJoinRequest.Equal(other.ChatJoinRequest) &&
+		t.ChatMember.Equal(other.ChatMember) &&
+		t.ChosenInlineResult.Equal(other.ChosenInlineResult) &&
+		t.EditedChannelPost.Equal(other.EditedChannelPost) &&
+		t.EditedMessage.Equal(other.EditedMessage) &&
+		t.InlineQuery.Equal(other.InlineQuery) &&
+		t.Message.Equal(other.Message) &&
+		t.MyChatMember.Equal(other.MyChatMember) &&
+		t.Poll.Equal(other.Poll) &&
+		t.PollAnswer.Equal(other.PollAnswer) &&
+		t.PreCheckoutQuery.Equal(other.PreCheckoutQuery) &&
+		t.ShippingQuery.Equal(other.ShippingQuery) &&
+		t.UpdateID == other.UpdateID
+}
+
 // User
 // This object represents a Telegram user or bot.
 type User struct {
@@ -11739,6 +18924,55 @@ type User struct {
 	Username *string `json:"username,omitempty"`
 }
 
+// NewUser creates User with the required fields.
+func NewUser(firstName string, id int64, isBot bool) *User {
+	return &User{
+		FirstName: firstName,
+		ID:        id,
+		IsBot:     isBot,
+	}
+}
+
+func (t *User) SetAddedToAttachmentMenu(addedToAttachmentMenu True) *User {
+	t.AddedToAttachmentMenu = &addedToAttachmentMenu
+	return t
+}
+
+func (t *User) SetCanJoinGroups(canJoinGroups bool) *User {
+	t.CanJoinGroups = &canJoinGroups
+	return t
+}
+
+func (t *User) SetCanReadAllGroupMessages(canReadAllGroupMessages bool) *User {
+	t.CanReadAllGroupMessages = &canReadAllGroupMessages
+	return t
+}
+
+func (t *User) SetIsPremium(isPremium True) *User {
+	t.IsPremium = &isPremium
+	return t
+}
+
+func (t *User) SetLanguageCode(languageCode string) *User {
+	t.LanguageCode = &languageCode
+	return t
+}
+
+func (t *User) SetLastName(lastName string) *User {
+	t.LastName = &lastName
+	return t
+}
+
+func (t *User) SetSupportsInlineQueries(supportsInlineQueries bool) *User {
+	t.SupportsInlineQueries = &supportsInlineQueries
+	return t
+}
+
+func (t *User) SetUsername(username string) *User {
+	t.Username = &username
+	return t
+}
+
 func (t *User) GetAddedToAttachmentMenu() *True {
 	if t == nil {
 		return nil
@@ -11843,6 +19077,41 @@ func (t *User) GetUsername() string {
 	return res
 }
 
+// Clone returns a deep copy of t.
+func (t *User) Clone() *User {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.AddedToAttachmentMenu = cloneTruePtr(t.AddedToAttachmentMenu)
+	res.CanJoinGroups = cloneBoolPtr(t.CanJoinGroups)
+	res.CanReadAllGroupMessages = cloneBoolPtr(t.CanReadAllGroupMessages)
+	res.IsPremium = cloneTruePtr(t.IsPremium)
+	res.LanguageCode = cloneStringPtr(t.LanguageCode)
+	res.LastName = cloneStringPtr(t.LastName)
+	res.SupportsInlineQueries = cloneBoolPtr(t.SupportsInlineQueries)
+	res.Username = cloneStringPtr(t.Username)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *User) Equal(other *User) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalTruePtr(t.AddedToAttachmentMenu, other.AddedToAttachmentMenu) &&
+		equalBoolPtr(t.CanJoinGroups, other.CanJoinGroups) &&
+		equalBoolPtr(t.CanReadAllGroupMessages, other.CanReadAllGroupMessages) &&
+		t.FirstName == other.FirstName &&
+		t.ID == other.ID &&
+		t.IsBot == other.IsBot &&
+		equalTruePtr(t.IsPremium, other.IsPremium) &&
+		equalStringPtr(t.LanguageCode, other.LanguageCode) &&
+		equalStringPtr(t.LastName, other.LastName) &&
+		equalBoolPtr(t.SupportsInlineQueries, other.SupportsInlineQueries) &&
+		equalStringPtr(t.Username, other.Username)
+}
+
 // UserProfilePhotos
 // This object represent a user's profile pictures.
 type UserProfilePhotos struct {
@@ -11854,6 +19123,14 @@ type UserProfilePhotos struct {
 	TotalCount int64 `json:"total_count"`
 }
 
+// NewUserProfilePhotos creates UserProfilePhotos with the required fields.
+func NewUserProfilePhotos(photos [][]PhotoSize, totalCount int64) *UserProfilePhotos {
+	return &UserProfilePhotos{
+		Photos:     photos,
+		TotalCount: totalCount,
+	}
+}
+
 func (t *UserProfilePhotos) GetTotalCount() int64 {
 	var res int64
 	if t == nil {
@@ -11862,6 +19139,25 @@ func (t *UserProfilePhotos) GetTotalCount() int64 {
 	return t.TotalCount
 }
 
+// Clone returns a deep copy of t.
+func (t *UserProfilePhotos) Clone() *UserProfilePhotos {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Photos = clonePhotoSizeSliceSlice(t.Photos)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *UserProfilePhotos) Equal(other *UserProfilePhotos) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalPhotoSizeSliceSlice(t.Photos, other.Photos) &&
+		t.TotalCount == other.TotalCount
+}
+
 // UserShared
 // This object contains information about the user whose identifier was shared with the bot using a
 // KeyboardButtonRequestUser button.
@@ -11878,6 +19174,14 @@ type UserShared struct {
 	UserID int64 `json:"user_id"`
 }
 
+// NewUserShared creates UserShared with the required fields.
+func NewUserShared(requestID int64, userID int64) *UserShared {
+	return &UserShared{
+		RequestID: requestID,
+		UserID:    userID,
+	}
+}
+
 func (t *UserShared) GetRequestID() int64 {
 	var res int64
 	if t == nil {
@@ -11894,6 +19198,24 @@ func (t *UserShared) GetUserID() int64 {
 	return t.UserID
 }
 
+// Clone returns a deep copy of t.
+func (t *UserShared) Clone() *UserShared {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *UserShared) Equal(other *UserShared) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.RequestID == other.RequestID &&
+		t.UserID == other.UserID
+}
+
 // Venue
 // This object represents a venue.
 type Venue struct {
@@ -11921,6 +19243,35 @@ type Venue struct {
 	GooglePlaceType *string `json:"google_place_type,omitempty"`
 }
 
+// NewVenue creates Venue with the required fields.
+func NewVenue(address string, location Location, title string) *Venue {
+	return &Venue{
+		Address:  address,
+		Location: location,
+		Title:    title,
+	}
+}
+
+func (t *Venue) SetFoursquareID(foursquareID string) *Venue {
+	t.FoursquareID = &foursquareID
+	return t
+}
+
+func (t *Venue) SetFoursquareType(foursquareType string) *Venue {
+	t.FoursquareType = &foursquareType
+	return t
+}
+
+func (t *Venue) SetGooglePlaceID(googlePlaceID string) *Venue {
+	t.GooglePlaceID = &googlePlaceID
+	return t
+}
+
+func (t *Venue) SetGooglePlaceType(googlePlaceType string) *Venue {
+	t.GooglePlaceType = &googlePlaceType
+	return t
+}
+
 func (t *Venue) GetAddress() string {
 	var res string
 	if t == nil {
@@ -11988,6 +19339,34 @@ func (t *Venue) GetTitle() string {
 	return t.Title
 }
 
+// Clone returns a deep copy of t.
+func (t *Venue) Clone() *Venue {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.FoursquareID = cloneStringPtr(t.FoursquareID)
+	res.FoursquareType = cloneStringPtr(t.FoursquareType)
+	res.GooglePlaceID = cloneStringPtr(t.GooglePlaceID)
+	res.GooglePlaceType = cloneStringPtr(t.GooglePlaceType)
+	res.Location = *t.Location.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *Venue) Equal(other *Venue) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Address == other.Address &&
+		equalStringPtr(t.FoursquareID, other.FoursquareID) &&
+		equalStringPtr(t.FoursquareType, other.FoursquareType) &&
+		equalStringPtr(t.GooglePlaceID, other.GooglePlaceID) &&
+		equalStringPtr(t.GooglePlaceType, other.GooglePlaceType) &&
+		t.Location.Equal(&other.Location) &&
+		t.Title == other.Title
+}
+
 // Video
 // This object represents a video file.
 type Video struct {
@@ -12023,17 +19402,48 @@ type Video struct {
 	Thumbnail *PhotoSize `json:"thumbnail,omitempty"`
 }
 
-func (t *Video) GetDuration() int64 {
-	var res int64
-	if t == nil {
-		return res
+// NewVideo creates Video with the required fields.
+func NewVideo(duration int64, fileID string, fileUniqueID string, height int64, width int64) *Video {
+	return &Video{
+		Duration:     duration,
+		FileID:       fileID,
+		FileUniqueID: fileUniqueID,
+		Height:       height,
+		Width:        width,
 	}
-	return t.Duration
 }
 
-func (t *Video) GetFileID() string {
-	var res string
-	if t == nil {
+func (t *Video) SetFileName(fileName string) *Video {
+	t.FileName = &fileName
+	return t
+}
+
+func (t *Video) SetFileSize(fileSize int64) *Video {
+	t.FileSize = &fileSize
+	return t
+}
+
+func (t *Video) SetMimeType(mimeType string) *Video {
+	t.MimeType = &mimeType
+	return t
+}
+
+func (t *Video) SetThumbnail(thumbnail PhotoSize) *Video {
+	t.Thumbnail = &thumbnail
+	return t
+}
+
+func (t *Video) GetDuration() int64 {
+	var res int64
+	if t == nil {
+		return res
+	}
+	return t.Duration
+}
+
+func (t *Video) GetFileID() string {
+	var res string
+	if t == nil {
 		return res
 	}
 	return t.FileID
@@ -12103,6 +19513,35 @@ func (t *Video) GetWidth() int64 {
 	return t.Width
 }
 
+// Clone returns a deep copy of t.
+func (t *Video) Clone() *Video {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.FileName = cloneStringPtr(t.FileName)
+	res.FileSize = cloneInt64Ptr(t.FileSize)
+	res.MimeType = cloneStringPtr(t.MimeType)
+	res.Thumbnail = t.Thumbnail.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *Video) Equal(other *Video) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Duration == other.Duration &&
+		t.FileID == other.FileID &&
+		equalStringPtr(t.FileName, other.FileName) &&
+		equalInt64Ptr(t.FileSize, other.FileSize) &&
+		t.FileUniqueID == other.FileUniqueID &&
+		t.Height == other.Height &&
+		equalStringPtr(t.MimeType, other.MimeType) &&
+		t.Thumbnail.Equal(other.Thumbnail) &&
+		t.Width == other.Width
+}
+
 // VideoChatEnded
 // This object represents a service message about a video chat ended in the chat.
 type VideoChatEnded struct {
@@ -12111,6 +19550,13 @@ type VideoChatEnded struct {
 	Duration int64 `json:"duration"`
 }
 
+// NewVideoChatEnded creates VideoChatEnded with the required fields.
+func NewVideoChatEnded(duration int64) *VideoChatEnded {
+	return &VideoChatEnded{
+		Duration: duration,
+	}
+}
+
 func (t *VideoChatEnded) GetDuration() int64 {
 	var res int64
 	if t == nil {
@@ -12119,6 +19565,23 @@ func (t *VideoChatEnded) GetDuration() int64 {
 	return t.Duration
 }
 
+// Clone returns a deep copy of t.
+func (t *VideoChatEnded) Clone() *VideoChatEnded {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *VideoChatEnded) Equal(other *VideoChatEnded) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Duration == other.Duration
+}
+
 // VideoChatParticipantsInvited
 // This object represents a service message about new members invited to a video chat.
 type VideoChatParticipantsInvited struct {
@@ -12127,6 +19590,31 @@ type VideoChatParticipantsInvited struct {
 	Users []User `json:"users"`
 }
 
+// NewVideoChatParticipantsInvited creates VideoChatParticipantsInvited with the required fields.
+func NewVideoChatParticipantsInvited(users []User) *VideoChatParticipantsInvited {
+	return &VideoChatParticipantsInvited{
+		Users: users,
+	}
+}
+
+// Clone returns a deep copy of t.
+func (t *VideoChatParticipantsInvited) Clone() *VideoChatParticipantsInvited {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.Users = cloneUserSlice(t.Users)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *VideoChatParticipantsInvited) Equal(other *VideoChatParticipantsInvited) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalUserSlice(t.Users, other.Users)
+}
+
 // VideoChatScheduled
 // This object represents a service message about a video chat scheduled in the chat.
 type VideoChatScheduled struct {
@@ -12136,6 +19624,13 @@ type VideoChatScheduled struct {
 	StartDate int64 `json:"start_date"`
 }
 
+// NewVideoChatScheduled creates VideoChatScheduled with the required fields.
+func NewVideoChatScheduled(startDate int64) *VideoChatScheduled {
+	return &VideoChatScheduled{
+		StartDate: startDate,
+	}
+}
+
 func (t *VideoChatScheduled) GetStartDate() int64 {
 	var res int64
 	if t == nil {
@@ -12144,6 +19639,23 @@ func (t *VideoChatScheduled) GetStartDate() int64 {
 	return t.StartDate
 }
 
+// Clone returns a deep copy of t.
+func (t *VideoChatScheduled) Clone() *VideoChatScheduled {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *VideoChatScheduled) Equal(other *VideoChatScheduled) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.StartDate == other.StartDate
+}
+
 // VideoChatStarted
 // This object represents a service message about a video chat started in the chat. Currently holds
 // no information.
@@ -12153,6 +19665,13 @@ type VideoChatStarted struct {
 	Duration int64 `json:"duration"`
 }
 
+// NewVideoChatStarted creates VideoChatStarted with the required fields.
+func NewVideoChatStarted(duration int64) *VideoChatStarted {
+	return &VideoChatStarted{
+		Duration: duration,
+	}
+}
+
 func (t *VideoChatStarted) GetDuration() int64 {
 	var res int64
 	if t == nil {
@@ -12161,6 +19680,23 @@ func (t *VideoChatStarted) GetDuration() int64 {
 	return t.Duration
 }
 
+// Clone returns a deep copy of t.
+func (t *VideoChatStarted) Clone() *VideoChatStarted {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *VideoChatStarted) Equal(other *VideoChatStarted) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Duration == other.Duration
+}
+
 // VideoNote
 // This object represents a video message (available in Telegram apps as of v.4.0).
 type VideoNote struct {
@@ -12185,6 +19721,26 @@ type VideoNote struct {
 	Thumbnail *PhotoSize `json:"thumbnail,omitempty"`
 }
 
+// NewVideoNote creates VideoNote with the required fields.
+func NewVideoNote(duration int64, fileID string, fileUniqueID string, length int64) *VideoNote {
+	return &VideoNote{
+		Duration:     duration,
+		FileID:       fileID,
+		FileUniqueID: fileUniqueID,
+		Length:       length,
+	}
+}
+
+func (t *VideoNote) SetFileSize(fileSize int64) *VideoNote {
+	t.FileSize = &fileSize
+	return t
+}
+
+func (t *VideoNote) SetThumbnail(thumbnail PhotoSize) *VideoNote {
+	t.Thumbnail = &thumbnail
+	return t
+}
+
 func (t *VideoNote) GetDuration() int64 {
 	var res int64
 	if t == nil {
@@ -12235,6 +19791,30 @@ func (t *VideoNote) GetThumbnail() *PhotoSize {
 	return t.Thumbnail
 }
 
+// Clone returns a deep copy of t.
+func (t *VideoNote) Clone() *VideoNote {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.FileSize = cloneInt64Ptr(t.FileSize)
+	res.Thumbnail = t.Thumbnail.Clone()
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *VideoNote) Equal(other *VideoNote) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Duration == other.Duration &&
+		t.FileID == other.FileID &&
+		equalInt64Ptr(t.FileSize, other.FileSize) &&
+		t.FileUniqueID == other.FileUniqueID &&
+		t.Length == other.Length &&
+		t.Thumbnail.Equal(other.Thumbnail)
+}
+
 // Voice
 // This object represents a voice note.
 type Voice struct {
@@ -12258,6 +19838,25 @@ type Voice struct {
 	MimeType *string `json:"mime_type,omitempty"`
 }
 
+// NewVoice creates Voice with the required fields.
+func NewVoice(duration int64, fileID string, fileUniqueID string) *Voice {
+	return &Voice{
+		Duration:     duration,
+		FileID:       fileID,
+		FileUniqueID: fileUniqueID,
+	}
+}
+
+func (t *Voice) SetFileSize(fileSize int64) *Voice {
+	t.FileSize = &fileSize
+	return t
+}
+
+func (t *Voice) SetMimeType(mimeType string) *Voice {
+	t.MimeType = &mimeType
+	return t
+}
+
 func (t *Voice) GetDuration() int64 {
 	var res int64
 	if t == nil {
@@ -12304,6 +19903,29 @@ func (t *Voice) GetMimeType() string {
 	return res
 }
 
+// Clone returns a deep copy of t.
+func (t *Voice) Clone() *Voice {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.FileSize = cloneInt64Ptr(t.FileSize)
+	res.MimeType = cloneStringPtr(t.MimeType)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *Voice) Equal(other *Voice) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.Duration == other.Duration &&
+		t.FileID == other.FileID &&
+		equalInt64Ptr(t.FileSize, other.FileSize) &&
+		t.FileUniqueID == other.FileUniqueID &&
+		equalStringPtr(t.MimeType, other.MimeType)
+}
+
 // WebAppData
 // Describes data sent from a Web App to the bot.
 type WebAppData struct {
@@ -12316,6 +19938,14 @@ type WebAppData struct {
 	Data string `json:"data"`
 }
 
+// NewWebAppData creates WebAppData with the required fields.
+func NewWebAppData(buttonText string, data string) *WebAppData {
+	return &WebAppData{
+		ButtonText: buttonText,
+		Data:       data,
+	}
+}
+
 func (t *WebAppData) GetButtonText() string {
 	var res string
 	if t == nil {
@@ -12332,6 +19962,24 @@ func (t *WebAppData) GetData() string {
 	return t.Data
 }
 
+// Clone returns a deep copy of t.
+func (t *WebAppData) Clone() *WebAppData {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *WebAppData) Equal(other *WebAppData) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.ButtonText == other.ButtonText &&
+		t.Data == other.Data
+}
+
 // WebAppInfo
 // Describes a Web App.
 type WebAppInfo struct {
@@ -12341,6 +19989,13 @@ type WebAppInfo struct {
 	URL string `json:"url"`
 }
 
+// NewWebAppInfo creates WebAppInfo with the required fields.
+func NewWebAppInfo(url string) *WebAppInfo {
+	return &WebAppInfo{
+		URL: url,
+	}
+}
+
 func (t *WebAppInfo) GetURL() string {
 	var res string
 	if t == nil {
@@ -12349,6 +20004,23 @@ func (t *WebAppInfo) GetURL() string {
 	return t.URL
 }
 
+// Clone returns a deep copy of t.
+func (t *WebAppInfo) Clone() *WebAppInfo {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *WebAppInfo) Equal(other *WebAppInfo) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.URL == other.URL
+}
+
 // WebhookInfo
 // Describes the current status of a webhook.
 type WebhookInfo struct {
@@ -12364,7 +20036,7 @@ type WebhookInfo struct {
 	// AllowedUpdates
 	// A list of update types the bot is subscribed to. Defaults to all update types except
 	// chat_member
-	AllowedUpdates []string `json:"allowed_updates,omitempty"`
+	AllowedUpdates []UpdateKind `json:"allowed_updates,omitempty"`
 	// IPAddress
 	// Currently used webhook IP address
 	IPAddress *string `json:"ip_address,omitempty"`
@@ -12386,6 +20058,45 @@ type WebhookInfo struct {
 	MaxConnections *int64 `json:"max_connections,omitempty"`
 }
 
+// NewWebhookInfo creates WebhookInfo with the required fields.
+func NewWebhookInfo(hasCustomCertificate bool, pendingUpdateCount int64, url string) *WebhookInfo {
+	return &WebhookInfo{
+		HasCustomCertificate: hasCustomCertificate,
+		PendingUpdateCount:   pendingUpdateCount,
+		URL:                  url,
+	}
+}
+
+func (t *WebhookInfo) SetAllowedUpdates(allowedUpdates []UpdateKind) *WebhookInfo {
+	t.AllowedUpdates = allowedUpdates
+	return t
+}
+
+func (t *WebhookInfo) SetIPAddress(ipAddress string) *WebhookInfo {
+	t.IPAddress = &ipAddress
+	return t
+}
+
+func (t *WebhookInfo) SetLastErrorDate(lastErrorDate int64) *WebhookInfo {
+	t.LastErrorDate = &lastErrorDate
+	return t
+}
+
+func (t *WebhookInfo) SetLastErrorMessage(lastErrorMessage string) *WebhookInfo {
+	t.LastErrorMessage = &lastErrorMessage
+	return t
+}
+
+func (t *WebhookInfo) SetLastSynchronizationErrorDate(lastSynchronizationErrorDate int64) *WebhookInfo {
+	t.LastSynchronizationErrorDate = &lastSynchronizationErrorDate
+	return t
+}
+
+func (t *WebhookInfo) SetMaxConnections(maxConnections int64) *WebhookInfo {
+	t.MaxConnections = &maxConnections
+	return t
+}
+
 func (t *WebhookInfo) GetHasCustomCertificate() bool {
 	var res bool
 	if t == nil {
@@ -12465,6 +20176,37 @@ func (t *WebhookInfo) GetURL() string {
 	return t.URL
 }
 
+// Clone returns a deep copy of t.
+func (t *WebhookInfo) Clone() *WebhookInfo {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.AllowedUpdates = cloneUpdateKindSlice(t.AllowedUpdates)
+	res.IPAddress = cloneStringPtr(t.IPAddress)
+	res.LastErrorDate = cloneInt64Ptr(t.LastErrorDate)
+	res.LastErrorMessage = cloneStringPtr(t.LastErrorMessage)
+	res.LastSynchronizationErrorDate = cloneInt64Ptr(t.LastSynchronizationErrorDate)
+	res.MaxConnections = cloneInt64Ptr(t.MaxConnections)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *WebhookInfo) Equal(other *WebhookInfo) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalUpdateKindSlice(t.AllowedUpdates, other.AllowedUpdates) &&
+		t.HasCustomCertificate == other.HasCustomCertificate &&
+		equalStringPtr(t.IPAddress, other.IPAddress) &&
+		equalInt64Ptr(t.LastErrorDate, other.LastErrorDate) &&
+		equalStringPtr(t.LastErrorMessage, other.LastErrorMessage) &&
+		equalInt64Ptr(t.LastSynchronizationErrorDate, other.LastSynchronizationErrorDate) &&
+		equalInt64Ptr(t.MaxConnections, other.MaxConnections) &&
+		t.PendingUpdateCount == other.PendingUpdateCount &&
+		t.URL == other.URL
+}
+
 // WriteAccessAllowed
 // This object represents a service message about a user allowing a bot to write messages after
 // adding the bot to the attachment menu or launching a Web App from a link.
@@ -12484,3 +20226,533 @@ func (t *WriteAccessAllowed) GetWebAppName() string {
 	}
 	return res
 }
+
+// Clone returns a deep copy of t.
+func (t *WriteAccessAllowed) Clone() *WriteAccessAllowed {
+	if t == nil {
+		return nil
+	}
+	res := *t
+	res.WebAppName = cloneStringPtr(t.WebAppName)
+	return &res
+}
+
+// Equal reports whether t and other are deeply equal.
+func (t *WriteAccessAllowed) Equal(other *WriteAccessAllowed) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return equalStringPtr(t.WebAppName, other.WebAppName)
+}
+
+func cloneEncryptedPassportElementSlice(s []EncryptedPassportElement) []EncryptedPassportElement {
+	if s == nil {
+		return nil
+	}
+	res := make([]EncryptedPassportElement, len(s))
+	for i := range s {
+		res[i] = *s[i].Clone()
+	}
+	return res
+}
+
+func equalEncryptedPassportElementSlice(a, b []EncryptedPassportElement) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(&b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func cloneInlineKeyboardButtonSlice(s []InlineKeyboardButton) []InlineKeyboardButton {
+	if s == nil {
+		return nil
+	}
+	res := make([]InlineKeyboardButton, len(s))
+	for i := range s {
+		res[i] = *s[i].Clone()
+	}
+	return res
+}
+
+func equalInlineKeyboardButtonSlice(a, b []InlineKeyboardButton) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(&b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func cloneKeyboardButtonSlice(s []KeyboardButton) []KeyboardButton {
+	if s == nil {
+		return nil
+	}
+	res := make([]KeyboardButton, len(s))
+	for i := range s {
+		res[i] = *s[i].Clone()
+	}
+	return res
+}
+
+func equalKeyboardButtonSlice(a, b []KeyboardButton) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(&b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func cloneLabeledPriceSlice(s []LabeledPrice) []LabeledPrice {
+	if s == nil {
+		return nil
+	}
+	res := make([]LabeledPrice, len(s))
+	for i := range s {
+		res[i] = *s[i].Clone()
+	}
+	return res
+}
+
+func equalLabeledPriceSlice(a, b []LabeledPrice) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(&b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func cloneMessageEntitySlice(s []MessageEntity) []MessageEntity {
+	if s == nil {
+		return nil
+	}
+	res := make([]MessageEntity, len(s))
+	for i := range s {
+		res[i] = *s[i].Clone()
+	}
+	return res
+}
+
+func equalMessageEntitySlice(a, b []MessageEntity) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(&b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func clonePassportFileSlice(s []PassportFile) []PassportFile {
+	if s == nil {
+		return nil
+	}
+	res := make([]PassportFile, len(s))
+	for i := range s {
+		res[i] = *s[i].Clone()
+	}
+	return res
+}
+
+func equalPassportFileSlice(a, b []PassportFile) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(&b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func clonePhotoSizeSlice(s []PhotoSize) []PhotoSize {
+	if s == nil {
+		return nil
+	}
+	res := make([]PhotoSize, len(s))
+	for i := range s {
+		res[i] = *s[i].Clone()
+	}
+	return res
+}
+
+func equalPhotoSizeSlice(a, b []PhotoSize) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(&b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func clonePollOptionSlice(s []PollOption) []PollOption {
+	if s == nil {
+		return nil
+	}
+	res := make([]PollOption, len(s))
+	for i := range s {
+		res[i] = *s[i].Clone()
+	}
+	return res
+}
+
+func equalPollOptionSlice(a, b []PollOption) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(&b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func cloneStickerSlice(s []Sticker) []Sticker {
+	if s == nil {
+		return nil
+	}
+	res := make([]Sticker, len(s))
+	for i := range s {
+		res[i] = *s[i].Clone()
+	}
+	return res
+}
+
+func equalStickerSlice(a, b []Sticker) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(&b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func cloneUpdateKindSlice(s []UpdateKind) []UpdateKind {
+	if s == nil {
+		return nil
+	}
+	res := make([]UpdateKind, len(s))
+	copy(res, s)
+	return res
+}
+
+func equalUpdateKindSlice(a, b []UpdateKind) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func cloneUserSlice(s []User) []User {
+	if s == nil {
+		return nil
+	}
+	res := make([]User, len(s))
+	for i := range s {
+		res[i] = *s[i].Clone()
+	}
+	return res
+}
+
+func equalUserSlice(a, b []User) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(&b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func cloneInlineKeyboardButtonSliceSlice(s [][]InlineKeyboardButton) [][]InlineKeyboardButton {
+	if s == nil {
+		return nil
+	}
+	res := make([][]InlineKeyboardButton, len(s))
+	for i := range s {
+		res[i] = cloneInlineKeyboardButtonSlice(s[i])
+	}
+	return res
+}
+
+func equalInlineKeyboardButtonSliceSlice(a, b [][]InlineKeyboardButton) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !equalInlineKeyboardButtonSlice(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func cloneKeyboardButtonSliceSlice(s [][]KeyboardButton) [][]KeyboardButton {
+	if s == nil {
+		return nil
+	}
+	res := make([][]KeyboardButton, len(s))
+	for i := range s {
+		res[i] = cloneKeyboardButtonSlice(s[i])
+	}
+	return res
+}
+
+func equalKeyboardButtonSliceSlice(a, b [][]KeyboardButton) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !equalKeyboardButtonSlice(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func clonePhotoSizeSliceSlice(s [][]PhotoSize) [][]PhotoSize {
+	if s == nil {
+		return nil
+	}
+	res := make([][]PhotoSize, len(s))
+	for i := range s {
+		res[i] = clonePhotoSizeSlice(s[i])
+	}
+	return res
+}
+
+func equalPhotoSizeSliceSlice(a, b [][]PhotoSize) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !equalPhotoSizeSlice(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func cloneInt64Slice(s []int64) []int64 {
+	if s == nil {
+		return nil
+	}
+	res := make([]int64, len(s))
+	copy(res, s)
+	return res
+}
+
+func equalInt64Slice(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func cloneStringSlice(s []string) []string {
+	if s == nil {
+		return nil
+	}
+	res := make([]string, len(s))
+	copy(res, s)
+	return res
+}
+
+func equalStringSlice(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func cloneChatTypePtr(p *ChatType) *ChatType {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+func equalChatTypePtr(a, b *ChatType) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func cloneFileIDPtr(p *FileID) *FileID {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+func equalFileIDPtr(a, b *FileID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func cloneKeyboardButtonTypePtr(p *KeyboardButtonType) *KeyboardButtonType {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+func equalKeyboardButtonTypePtr(a, b *KeyboardButtonType) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func cloneParseModePtr(p *ParseMode) *ParseMode {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+func equalParseModePtr(a, b *ParseMode) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func cloneThumbnailMimeTypePtr(p *ThumbnailMimeType) *ThumbnailMimeType {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+func equalThumbnailMimeTypePtr(a, b *ThumbnailMimeType) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func cloneTruePtr(p *True) *True {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+func equalTruePtr(a, b *True) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func cloneBoolPtr(p *bool) *bool {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+func equalBoolPtr(a, b *bool) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func cloneFloat64Ptr(p *float64) *float64 {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+func equalFloat64Ptr(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func cloneInt64Ptr(p *int64) *int64 {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+func equalInt64Ptr(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func cloneStringPtr(p *string) *string {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+func equalStringPtr(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
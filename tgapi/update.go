@@ -0,0 +1,185 @@
+package tgapi
+
+import "encoding/json"
+
+// UpdateKind is the kind of an incoming Update.
+// It is also used as the list of allowed updates in GetUpdatesConfig and SetWebhookConfig.
+// UpdateKind keeps the raw text, so the kinds unknown for the library are decoded as is.
+type UpdateKind string
+
+const (
+	UpdateKindUnknown            UpdateKind = ""
+	UpdateKindMessage            UpdateKind = "message"
+	UpdateKindEditedMessage      UpdateKind = "edited_message"
+	UpdateKindChannelPost        UpdateKind = "channel_post"
+	UpdateKindEditedChannelPost  UpdateKind = "edited_channel_post"
+	UpdateKindInlineQuery        UpdateKind = "inline_query"
+	UpdateKindChosenInlineResult UpdateKind = "chosen_inline_result"
+	UpdateKindCallbackQuery      UpdateKind = "callback_query"
+	UpdateKindShippingQuery      UpdateKind = "shipping_query"
+	UpdateKindPreCheckoutQuery   UpdateKind = "pre_checkout_query"
+	UpdateKindPoll               UpdateKind = "poll"
+	UpdateKindPollAnswer         UpdateKind = "poll_answer"
+	UpdateKindMyChatMember       UpdateKind = "my_chat_member"
+	UpdateKindChatMember         UpdateKind = "chat_member"
+	UpdateKindChatJoinRequest    UpdateKind = "chat_join_request"
+)
+
+var allUpdateKinds = []UpdateKind{
+	UpdateKindMessage,
+	UpdateKindEditedMessage,
+	UpdateKindChannelPost,
+	UpdateKindEditedChannelPost,
+	UpdateKindInlineQuery,
+	UpdateKindChosenInlineResult,
+	UpdateKindCallbackQuery,
+	UpdateKindShippingQuery,
+	UpdateKindPreCheckoutQuery,
+	UpdateKindPoll,
+	UpdateKindPollAnswer,
+	UpdateKindMyChatMember,
+	UpdateKindChatMember,
+	UpdateKindChatJoinRequest,
+}
+
+func (enum UpdateKind) String() string {
+	return string(enum)
+}
+
+// IsKnown reports whether the value is listed in the API documentation.
+func (enum UpdateKind) IsKnown() bool {
+	for _, kind := range allUpdateKinds {
+		if enum == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// UnmarshalJSON returns ErrIncorrectEnum for the unknown value in the strict mode.
+func (enum *UpdateKind) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*enum = UpdateKind(value)
+	if isStrictEnums() && !enum.IsKnown() {
+		return ErrIncorrectEnum{value}
+	}
+	return nil
+}
+
+// AllUpdateKinds returns every known update kind, including chat_member,
+// which is not delivered unless requested explicitly.
+func AllUpdateKinds() []UpdateKind {
+	return append([]UpdateKind(nil), allUpdateKinds...)
+}
+
+// Kind returns the kind of the update, i.e. which of the optional fields is set.
+func (t *Update) Kind() UpdateKind {
+	switch {
+	case t == nil:
+		return UpdateKindUnknown
+	case t.Message != nil:
+		return UpdateKindMessage
+	case t.EditedMessage != nil:
+		return UpdateKindEditedMessage
+	case t.ChannelPost != nil:
+		return UpdateKindChannelPost
+	case t.EditedChannelPost != nil:
+		return UpdateKindEditedChannelPost
+	case t.InlineQuery != nil:
+		return UpdateKindInlineQuery
+	case t.ChosenInlineResult != nil:
+		return UpdateKindChosenInlineResult
+	case t.CallbackQuery != nil:
+		return UpdateKindCallbackQuery
+	case t.ShippingQuery != nil:
+		return UpdateKindShippingQuery
+	case t.PreCheckoutQuery != nil:
+		return UpdateKindPreCheckoutQuery
+	case t.Poll != nil:
+		return UpdateKindPoll
+	case t.PollAnswer != nil:
+		return UpdateKindPollAnswer
+	case t.MyChatMember != nil:
+		return UpdateKindMyChatMember
+	case t.ChatMember != nil:
+		return UpdateKindChatMember
+	case t.ChatJoinRequest != nil:
+		return UpdateKindChatJoinRequest
+	}
+	return UpdateKindUnknown
+}
+
+// EffectiveMessage returns the message of the update: new or edited message, channel post
+// or the message with the callback button.
+// Returns nil for the updates without a message.
+func (t *Update) EffectiveMessage() *Message {
+	switch t.Kind() {
+	case UpdateKindMessage:
+		return t.Message
+	case UpdateKindEditedMessage:
+		return t.EditedMessage
+	case UpdateKindChannelPost:
+		return t.ChannelPost
+	case UpdateKindEditedChannelPost:
+		return t.EditedChannelPost
+	case UpdateKindCallbackQuery:
+		return t.CallbackQuery.Message
+	}
+	return nil
+}
+
+// EffectiveChat returns the chat where the update happened.
+// Returns nil for the updates not bound to a chat (inline queries, payments, polls).
+func (t *Update) EffectiveChat() *Chat {
+	switch t.Kind() {
+	case UpdateKindMyChatMember:
+		return &t.MyChatMember.Chat
+	case UpdateKindChatMember:
+		return &t.ChatMember.Chat
+	case UpdateKindChatJoinRequest:
+		return &t.ChatJoinRequest.Chat
+	}
+	if msg := t.EffectiveMessage(); msg != nil {
+		return &msg.Chat
+	}
+	return nil
+}
+
+// EffectiveUser returns the user who caused the update.
+// Returns nil for channel posts and polls.
+func (t *Update) EffectiveUser() *User {
+	switch t.Kind() {
+	case UpdateKindInlineQuery:
+		return &t.InlineQuery.From
+	case UpdateKindChosenInlineResult:
+		return &t.ChosenInlineResult.From
+	case UpdateKindCallbackQuery:
+		return &t.CallbackQuery.From
+	case UpdateKindShippingQuery:
+		return &t.ShippingQuery.From
+	case UpdateKindPreCheckoutQuery:
+		return &t.PreCheckoutQuery.From
+	case UpdateKindPollAnswer:
+		return &t.PollAnswer.User
+	case UpdateKindMyChatMember:
+		return &t.MyChatMember.From
+	case UpdateKindChatMember:
+		return &t.ChatMember.From
+	case UpdateKindChatJoinRequest:
+		return &t.ChatJoinRequest.From
+	}
+	return t.EffectiveMessage().GetFrom()
+}
+
+// EffectiveThreadID returns the forum topic of the effective message.
+// Returns 0 if the message does not belong to a topic.
+func (t *Update) EffectiveThreadID() int64 {
+	msg := t.EffectiveMessage()
+	if msg.GetIsTopicMessage() == nil {
+		return 0
+	}
+	return msg.GetMessageThreadID()
+}
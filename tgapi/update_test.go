@@ -0,0 +1,85 @@
+package tgapi
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateKind(t *testing.T) {
+	tests := []struct {
+		raw      string
+		kind     UpdateKind
+		chatID   int64
+		userID   int64
+		threadID int64
+	}{
+		{
+			raw: `{"update_id":1,"message":{"message_id":1,"date":0,"chat":{"id":10,"type":"supergroup"},` +
+				`"from":{"id":20,"is_bot":false,"first_name":"a"},"message_thread_id":3,"is_topic_message":true}}`,
+			kind:     UpdateKindMessage,
+			chatID:   10,
+			userID:   20,
+			threadID: 3,
+		},
+		{
+			raw:    `{"update_id":2,"channel_post":{"message_id":1,"date":0,"chat":{"id":-100,"type":"channel"}}}`,
+			kind:   UpdateKindChannelPost,
+			chatID: -100,
+		},
+		{
+			raw: `{"update_id":3,"callback_query":{"id":"q","chat_instance":"i",` +
+				`"from":{"id":20,"is_bot":false,"first_name":"a"},` +
+				`"message":{"message_id":1,"date":0,"chat":{"id":10,"type":"private"},"message_thread_id":3}}}`,
+			kind:   UpdateKindCallbackQuery,
+			chatID: 10,
+			userID: 20,
+		},
+		{
+			raw: `{"update_id":4,"inline_query":{"id":"q","query":"","offset":"",` +
+				`"from":{"id":20,"is_bot":false,"first_name":"a"}}}`,
+			kind:   UpdateKindInlineQuery,
+			userID: 20,
+		},
+		{
+			raw: `{"update_id":5,"poll":{"id":"p","question":"?","options":[],"total_voter_count":0,` +
+				`"is_closed":false,"is_anonymous":true,"type":"regular","allows_multiple_answers":false}}`,
+			kind: UpdateKindPoll,
+		},
+		{
+			raw:  `{"update_id":6}`,
+			kind: UpdateKindUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		var upd Update
+		require.NoError(t, json.Unmarshal([]byte(tt.raw), &upd))
+		require.Equal(t, tt.kind, upd.Kind())
+		require.Equal(t, tt.chatID, upd.EffectiveChat().GetID())
+		require.Equal(t, tt.userID, upd.EffectiveUser().GetID())
+		require.Equal(t, tt.threadID, upd.EffectiveThreadID())
+	}
+}
+
+func TestUpdateKindAllowedUpdates(t *testing.T) {
+	raw, err := json.Marshal(&GetUpdatesConfig{
+		AllowedUpdates: []UpdateKind{UpdateKindMessage, UpdateKindChatMember},
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"allowed_updates":["message","chat_member"]}`, string(raw))
+
+	require.Len(t, AllUpdateKinds(), len(allUpdateKinds))
+}
+
+func TestTrueJSON(t *testing.T) {
+	var msg Message
+	require.NoError(t, json.Unmarshal(
+		[]byte(`{"message_id":1,"date":0,"chat":{"id":10,"type":"group"},"group_chat_created":true}`), &msg))
+	require.NotNil(t, msg.GroupChatCreated)
+
+	data, err := json.Marshal(ForceReply{})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"force_reply":true}`, string(data))
+}
@@ -0,0 +1,158 @@
+package tgapi
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"unicode/utf16"
+)
+
+// ValidationError is returned when the arguments break a constraint from the API documentation.
+type ValidationError struct {
+	// Method is set when the error is returned by the API call.
+	Method string
+	// Field is the name of the argument as in the API documentation.
+	Field string
+	Rule  string
+}
+
+func (e ValidationError) Error() string {
+	if e.Method != "" {
+		return fmt.Sprintf("validate %s: %s: %s", e.Method, e.Field, e.Rule)
+	}
+	return fmt.Sprintf("validate: %s: %s", e.Field, e.Rule)
+}
+
+// Validator is implemented by the generated *Config types.
+type Validator interface {
+	Validate() error
+}
+
+// APIValidation enables client-side validation of the *Config arguments before sending them,
+// so the broken arguments are reported without the network round trip.
+func APIValidation(enabled bool) APIOption {
+	return func(api *API) {
+		api.validation = enabled
+	}
+}
+
+func (api *API) validateRequest(method string, data interface{}) error {
+	if !api.validation {
+		return nil
+	}
+	validator, ok := data.(Validator)
+	if !ok {
+		return nil
+	}
+
+	err := validator.Validate()
+	var validationErr ValidationError
+	if errors.As(err, &validationErr) {
+		validationErr.Method = method
+		return validationErr
+	}
+	return err
+}
+
+func firstError(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isZero(value interface{}) bool {
+	return value == nil || reflect.ValueOf(value).IsZero()
+}
+
+// indirect dereferences the pointers. ok is false for nil.
+func indirect(value interface{}) (res reflect.Value, ok bool) {
+	res = reflect.ValueOf(value)
+	for res.Kind() == reflect.Ptr {
+		if res.IsNil() {
+			return res, false
+		}
+		res = res.Elem()
+	}
+	return res, res.IsValid()
+}
+
+func lengthUTF16(s string) int {
+	return len(utf16.Encode([]rune(s)))
+}
+
+func validateLength(when bool, field string, value interface{}, min, max int) error {
+	v, ok := indirect(value)
+	if !when || !ok {
+		return nil
+	}
+	if n := lengthUTF16(v.String()); n < min || n > max {
+		return ValidationError{Field: field, Rule: fmt.Sprintf("must be %d-%d characters, got %d", min, max, n)}
+	}
+	return nil
+}
+
+func validateEachLength(when bool, field string, value interface{}, min, max int) error {
+	v, ok := indirect(value)
+	if !when || !ok {
+		return nil
+	}
+	for idx := 0; idx < v.Len(); idx++ {
+		if n := lengthUTF16(v.Index(idx).String()); n < min || n > max {
+			return ValidationError{
+				Field: fmt.Sprintf("%s[%d]", field, idx),
+				Rule:  fmt.Sprintf("must be %d-%d characters, got %d", min, max, n),
+			}
+		}
+	}
+	return nil
+}
+
+func validateBytes(when bool, field string, value interface{}, min, max int) error {
+	v, ok := indirect(value)
+	if !when || !ok {
+		return nil
+	}
+	if n := len(v.String()); n < min || n > max {
+		return ValidationError{Field: field, Rule: fmt.Sprintf("must be %d-%d bytes, got %d", min, max, n)}
+	}
+	return nil
+}
+
+func validateCount(when bool, field string, value interface{}, min, max int) error {
+	v, ok := indirect(value)
+	if !when || !ok {
+		return nil
+	}
+	if n := v.Len(); n < min || n > max {
+		return ValidationError{Field: field, Rule: fmt.Sprintf("must contain %d-%d items, got %d", min, max, n)}
+	}
+	return nil
+}
+
+func validateRange(when bool, field string, value interface{}, min, max float64) error {
+	v, ok := indirect(value)
+	if !when || !ok {
+		return nil
+	}
+	var n float64
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		n = v.Float()
+	default:
+		n = float64(v.Int())
+	}
+	if n < min || n > max {
+		return ValidationError{Field: field, Rule: fmt.Sprintf("must be between %v and %v, got %v", min, max, n)}
+	}
+	return nil
+}
+
+func validateRequired(when bool, field string, value interface{}, rule string) error {
+	if when && isZero(value) {
+		return ValidationError{Field: field, Rule: rule}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package tgapi
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		args  Validator
+		field string
+	}{
+		{
+			name: "ok",
+			args: &SendMessageConfig{ChatID: NewInt(1), Text: "hello"},
+		},
+		{
+			name:  "empty text",
+			args:  &SendMessageConfig{ChatID: NewInt(1)},
+			field: "text",
+		},
+		{
+			name:  "long text",
+			args:  &SendMessageConfig{ChatID: NewInt(1), Text: strings.Repeat("a", 4097)},
+			field: "text",
+		},
+		{
+			name: "text length in utf-16",
+			args: &SendMessageConfig{ChatID: NewInt(1), Text: strings.Repeat("ы", 4096)},
+		},
+		{
+			name: "formatted text is not checked",
+			args: &SendMessageConfig{ChatID: NewInt(1), Text: strings.Repeat("*", 4097), ParseMode: ParseModeMarkdownV2},
+		},
+		{
+			name:  "required if not specified",
+			args:  &EditMessageTextConfig{Text: "hello"},
+			field: "chat_id",
+		},
+		{
+			name: "inline message",
+			args: &EditMessageTextConfig{Text: "hello", InlineMessageID: "id"},
+		},
+		{
+			name:  "too few options",
+			args:  &SendPollConfig{ChatID: NewInt(1), Question: "?", Options: []string{"yes"}},
+			field: "options",
+		},
+		{
+			name:  "empty option",
+			args:  &SendPollConfig{ChatID: NewInt(1), Question: "?", Options: []string{"yes", ""}},
+			field: "options[1]",
+		},
+		{
+			name: "optional range",
+			args: &SendPollConfig{ChatID: NewInt(1), Question: "?", Options: []string{"yes", "no"}},
+		},
+		{
+			name:  "range",
+			args:  &SendPollConfig{ChatID: NewInt(1), Question: "?", Options: []string{"yes", "no"}, OpenPeriod: 1},
+			field: "open_period",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.Validate()
+			if tt.field == "" {
+				require.NoError(t, err)
+				return
+			}
+			var validationErr ValidationError
+			require.True(t, errors.As(err, &validationErr), err)
+			require.Equal(t, tt.field, validationErr.Field)
+		})
+	}
+}
+
+func TestAPIValidation(t *testing.T) {
+	cli := &http.Client{
+		Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+			t.Fatal("request must not be sent")
+			return nil, nil
+		}),
+	}
+	vapi := NewWithEndpointAndClient("token", APIEndpoint, FileEndpoint, cli, APIValidation(true))
+
+	_, err := vapi.SendMessage(ctx, &SendMessageConfig{ChatID: NewInt(1)})
+	var validationErr ValidationError
+	require.True(t, errors.As(err, &validationErr), err)
+	require.Equal(t, ValidationError{Method: "sendMessage", Field: "text", Rule: "must be 1-4096 characters, got 0"}, validationErr)
+}
@@ -0,0 +1,43 @@
+package webapp
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// AuthScheme is the scheme of the Authorization header with the initData:
+//
+//	Authorization: tma <Telegram.WebApp.initData>
+const AuthScheme = "tma"
+
+type initDataKey struct{}
+
+// FromContext returns the initData verified by the Middleware.
+func FromContext(ctx context.Context) (*InitData, bool) {
+	data, ok := ctx.Value(initDataKey{}).(*InitData)
+	return data, ok
+}
+
+// Middleware authenticates the requests of the Mini App with the initData in the Authorization header.
+// The requests without the valid initData are answered with 401 Unauthorized,
+// the handler gets the initData with FromContext, e.g. to call AnswerWebAppQuery with InitData.QueryID.
+func (v *Validator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		prefix := AuthScheme + " "
+		if len(header) < len(prefix) || !strings.EqualFold(header[:len(prefix)], prefix) {
+			w.Header().Set("WWW-Authenticate", AuthScheme)
+			http.Error(w, "no init data", http.StatusUnauthorized)
+			return
+		}
+
+		data, err := v.InitData(strings.TrimSpace(header[len(prefix):]))
+		if err != nil {
+			w.Header().Set("WWW-Authenticate", AuthScheme)
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), initDataKey{}, data)))
+	})
+}
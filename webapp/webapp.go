@@ -0,0 +1,198 @@
+// Package webapp verifies the data passed by Telegram to the web pages of the bot:
+// the initData of the Mini Apps opened with WebAppInfo and the Login Widget authorization.
+package webapp
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Feresey/tgbotapi/tgapi"
+)
+
+// DefaultMaxAge is the maximum age of the data by default.
+const DefaultMaxAge = 24 * time.Hour
+
+var (
+	// ErrNoHash is returned when the data is not signed.
+	ErrNoHash = errors.New("no hash")
+	// ErrInvalidHash is returned when the data is not signed with the bot token.
+	ErrInvalidHash = errors.New("invalid hash")
+	// ErrExpired is returned when the data is older than the max age.
+	ErrExpired = errors.New("data is expired")
+)
+
+// InitData is the verified Mini App initData.
+type InitData struct {
+	// QueryID is used to send the message with AnswerWebAppQuery.
+	QueryID string
+	User    *tgapi.User
+	// Receiver is the chat partner in the private chat opened the app from the attachment menu.
+	Receiver *tgapi.User
+	// Chat is the group or channel opened the app from the attachment menu.
+	Chat         *tgapi.Chat
+	ChatType     string
+	ChatInstance string
+	// StartParam is the startapp parameter of the link.
+	StartParam string
+	// CanSendAfter is the time after which the message can be sent with AnswerWebAppQuery.
+	CanSendAfter time.Duration
+	AuthDate     time.Time
+	// Raw are all the fields, including the ones unknown for the library.
+	Raw url.Values
+}
+
+// LoginData is the verified Login Widget authorization.
+type LoginData struct {
+	ID        int64
+	FirstName string
+	LastName  string
+	Username  string
+	PhotoURL  string
+	AuthDate  time.Time
+}
+
+// Validator verifies the signatures of the data with the bot token.
+type Validator struct {
+	webAppKey []byte
+	loginKey  []byte
+	maxAge    time.Duration
+	now       func() time.Time
+}
+
+// Option is used to customize the Validator.
+type Option func(*Validator)
+
+// MaxAge sets the maximum age of the data, DefaultMaxAge by default. Zero disables the check.
+func MaxAge(maxAge time.Duration) Option {
+	return func(v *Validator) {
+		v.maxAge = maxAge
+	}
+}
+
+// New creates the Validator of the data signed with the bot token.
+func New(token string, options ...Option) *Validator {
+	webAppKey := hmac.New(sha256.New, []byte("WebAppData"))
+	webAppKey.Write([]byte(token))
+	loginKey := sha256.Sum256([]byte(token))
+
+	v := &Validator{
+		webAppKey: webAppKey.Sum(nil),
+		loginKey:  loginKey[:],
+		maxAge:    DefaultMaxAge,
+		now:       time.Now,
+	}
+	for _, option := range options {
+		option(v)
+	}
+	return v
+}
+
+// InitData verifies and parses the initData query string, Telegram.WebApp.initData.
+func (v *Validator) InitData(initData string) (*InitData, error) {
+	values, err := url.ParseQuery(initData)
+	if err != nil {
+		return nil, fmt.Errorf("parse init data: %w", err)
+	}
+	authDate, err := v.verify(values, v.webAppKey)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &InitData{
+		QueryID:      values.Get("query_id"),
+		ChatType:     values.Get("chat_type"),
+		ChatInstance: values.Get("chat_instance"),
+		StartParam:   values.Get("start_param"),
+		AuthDate:     authDate,
+		Raw:          values,
+	}
+	for field, dst := range map[string]interface{}{
+		"user":     &res.User,
+		"receiver": &res.Receiver,
+		"chat":     &res.Chat,
+	} {
+		if raw := values.Get(field); raw != "" {
+			if err := json.Unmarshal([]byte(raw), dst); err != nil {
+				return nil, fmt.Errorf("decode %s: %w", field, err)
+			}
+		}
+	}
+	if raw := values.Get("can_send_after"); raw != "" {
+		seconds, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("decode can_send_after: %w", err)
+		}
+		res.CanSendAfter = time.Duration(seconds) * time.Second
+	}
+	return res, nil
+}
+
+// Login verifies and parses the Login Widget authorization,
+// e.g. the query of the redirect to the URL of LoginURL or data-auth-url.
+func (v *Validator) Login(values url.Values) (*LoginData, error) {
+	authDate, err := v.verify(values, v.loginKey)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := strconv.ParseInt(values.Get("id"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("decode id: %w", err)
+	}
+	return &LoginData{
+		ID:        id,
+		FirstName: values.Get("first_name"),
+		LastName:  values.Get("last_name"),
+		Username:  values.Get("username"),
+		PhotoURL:  values.Get("photo_url"),
+		AuthDate:  authDate,
+	}, nil
+}
+
+// verify checks the hash and the age of the values and returns the auth date.
+func (v *Validator) verify(values url.Values, key []byte) (time.Time, error) {
+	hash := values.Get("hash")
+	if hash == "" {
+		return time.Time{}, ErrNoHash
+	}
+	expected, err := hex.DecodeString(hash)
+	if err != nil {
+		return time.Time{}, ErrInvalidHash
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(dataCheckString(values)))
+	if !hmac.Equal(mac.Sum(nil), expected) {
+		return time.Time{}, ErrInvalidHash
+	}
+
+	seconds, err := strconv.ParseInt(values.Get("auth_date"), 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("decode auth_date: %w", err)
+	}
+	authDate := time.Unix(seconds, 0)
+	if v.maxAge > 0 && v.now().Sub(authDate) > v.maxAge {
+		return time.Time{}, fmt.Errorf("%w: authorized at %s", ErrExpired, authDate.UTC().Format(time.RFC3339))
+	}
+	return authDate, nil
+}
+
+// dataCheckString joins the sorted fields except the hash as key=value with line feeds.
+func dataCheckString(values url.Values) string {
+	lines := make([]string, 0, len(values))
+	for key := range values {
+		if key != "hash" {
+			lines = append(lines, key+"="+values.Get(key))
+		}
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "\n")
+}
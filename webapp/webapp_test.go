@@ -0,0 +1,133 @@
+package webapp
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testToken = "123456:secret"
+
+var testNow = time.Unix(1700000000, 0)
+
+func newTestValidator(options ...Option) *Validator {
+	v := New(testToken, options...)
+	v.now = func() time.Time { return testNow }
+	return v
+}
+
+// sign adds the hash as Telegram does.
+func sign(key []byte, values url.Values) url.Values {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(dataCheckString(values)))
+	values.Set("hash", hex.EncodeToString(mac.Sum(nil)))
+	return values
+}
+
+func testInitData(authDate time.Time) url.Values {
+	return url.Values{
+		"query_id":       {"AAHdF6IQAAAAAN0XohDhrOrc"},
+		"user":           {`{"id":279058397,"first_name":"Jane","username":"jane","language_code":"en"}`},
+		"chat":           {`{"id":-100123,"type":"supergroup","title":"Group"}`},
+		"start_param":    {"ref"},
+		"can_send_after": {"10"},
+		"auth_date":      {strconv.FormatInt(authDate.Unix(), 10)},
+	}
+}
+
+func TestInitData(t *testing.T) {
+	v := newTestValidator()
+	raw := sign(v.webAppKey, testInitData(testNow.Add(-time.Hour))).Encode()
+
+	data, err := v.InitData(raw)
+	require.NoError(t, err)
+	require.Equal(t, "AAHdF6IQAAAAAN0XohDhrOrc", data.QueryID)
+	require.Equal(t, int64(279058397), data.User.ID)
+	require.Equal(t, "jane", data.User.GetUsername())
+	require.Equal(t, int64(-100123), data.Chat.ID)
+	require.Nil(t, data.Receiver)
+	require.Equal(t, "ref", data.StartParam)
+	require.Equal(t, 10*time.Second, data.CanSendAfter)
+	require.Equal(t, testNow.Add(-time.Hour).Unix(), data.AuthDate.Unix())
+
+	// the data signed for the Login Widget is rejected.
+	_, err = v.InitData(sign(v.loginKey, testInitData(testNow)).Encode())
+	require.True(t, errors.Is(err, ErrInvalidHash))
+
+	tampered := sign(v.webAppKey, testInitData(testNow))
+	tampered.Set("start_param", "other")
+	_, err = v.InitData(tampered.Encode())
+	require.True(t, errors.Is(err, ErrInvalidHash))
+
+	_, err = v.InitData(testInitData(testNow).Encode())
+	require.True(t, errors.Is(err, ErrNoHash))
+
+	_, err = v.InitData(sign(v.webAppKey, testInitData(testNow.Add(-25*time.Hour))).Encode())
+	require.True(t, errors.Is(err, ErrExpired))
+	_, err = newTestValidator(MaxAge(0)).InitData(sign(v.webAppKey, testInitData(testNow.Add(-25*time.Hour))).Encode())
+	require.NoError(t, err)
+}
+
+func TestLogin(t *testing.T) {
+	v := newTestValidator(MaxAge(time.Hour))
+	values := sign(v.loginKey, url.Values{
+		"id":         {"42"},
+		"first_name": {"John"},
+		"username":   {"john"},
+		"photo_url":  {"https://t.me/i/userpic/320/john.jpg"},
+		"auth_date":  {strconv.FormatInt(testNow.Unix(), 10)},
+	})
+
+	data, err := v.Login(values)
+	require.NoError(t, err)
+	require.Equal(t, &LoginData{
+		ID:        42,
+		FirstName: "John",
+		Username:  "john",
+		PhotoURL:  "https://t.me/i/userpic/320/john.jpg",
+		AuthDate:  time.Unix(testNow.Unix(), 0),
+	}, data)
+
+	_, err = New("654321:other").Login(values)
+	require.True(t, errors.Is(err, ErrInvalidHash))
+}
+
+func TestMiddleware(t *testing.T) {
+	v := newTestValidator()
+	handler := v.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, ok := FromContext(r.Context())
+		require.True(t, ok)
+		_, _ = w.Write([]byte(data.QueryID))
+	}))
+
+	serve := func(header string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/order", nil)
+		if header != "" {
+			req.Header.Set("Authorization", header)
+		}
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+		return resp
+	}
+
+	resp := serve("tma " + sign(v.webAppKey, testInitData(testNow)).Encode())
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, "AAHdF6IQAAAAAN0XohDhrOrc", resp.Body.String())
+
+	resp = serve("")
+	require.Equal(t, http.StatusUnauthorized, resp.Code)
+	require.Equal(t, AuthScheme, resp.Header().Get("WWW-Authenticate"))
+
+	resp = serve("tma " + testInitData(testNow).Encode())
+	require.Equal(t, http.StatusUnauthorized, resp.Code)
+	require.Contains(t, resp.Body.String(), ErrNoHash.Error())
+}